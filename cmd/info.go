@@ -33,6 +33,7 @@ func printInfo(cmd *cobra.Command, args []string) error {
 	printTuple(fmat, "Version", version, color.Cyan)
 	printTuple(fmat, "Config", config.AppConfigFile, color.Cyan)
 	printTuple(fmat, "Custom Views", config.AppViewsFile, color.Cyan)
+	printTuple(fmat, "Custom Views Dir", config.AppViewsDir, color.Cyan)
 	printTuple(fmat, "Plugins", config.AppPluginsFile, color.Cyan)
 	printTuple(fmat, "Hotkeys", config.AppHotKeysFile, color.Cyan)
 	printTuple(fmat, "Aliases", config.AppAliasesFile, color.Cyan)
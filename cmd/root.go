@@ -60,7 +60,7 @@ func init() {
 		return flagError{err: err}
 	})
 
-	rootCmd.AddCommand(versionCmd(), infoCmd())
+	rootCmd.AddCommand(versionCmd(), infoCmd(), schemaCmd(), viewsCmd())
 	initK9sFlags()
 	initK8sFlags()
 }
@@ -0,0 +1,32 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/derailed/k9s/internal/config/data"
+	"github.com/spf13/cobra"
+)
+
+func schemaCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:       "schema views",
+		Short:     "Print K9s configuration JSON schemas",
+		Long:      "Print K9s configuration JSON schemas. Point your editor YAML language server at it for completions",
+		Args:      cobra.MatchAll(cobra.ExactArgs(1), cobra.OnlyValidArgs),
+		ValidArgs: []string{"views"},
+		RunE:      printSchema,
+	}
+}
+
+func printSchema(cmd *cobra.Command, args []string) error {
+	switch args[0] {
+	case "views":
+		_, err := fmt.Fprintln(out, string(data.ViewsJSONSchema()))
+		return err
+	default:
+		return fmt.Errorf("unsupported schema %q", args[0])
+	}
+}
@@ -0,0 +1,107 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/color"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v2"
+)
+
+func viewsCmd() *cobra.Command {
+	cmd := cobra.Command{
+		Use:   "views",
+		Short: "Inspect K9s custom views configurations",
+	}
+	var kctx string
+	explain := cobra.Command{
+		Use:   "explain GVR [NAMESPACE]",
+		Short: "Print the custom view matching a resource and the resulting view setting",
+		Long:  "Print which views.yaml key matches a given resource and namespace. ie k9s views explain v1/pods default",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return explainView(kctx, args)
+		},
+	}
+	explain.Flags().StringVar(&kctx, "context", "", "The kubeconfig context views are gated on. Defaults to the current context")
+	cmd.AddCommand(&explain)
+	cmd.AddCommand(&cobra.Command{
+		Use:   "lint FILE",
+		Short: "Check a custom views file for issues",
+		Long:  "Check a custom views file for schema, sort, pattern and duplicate key issues. Exits non zero on errors. ie k9s views lint views.yaml",
+		Args:  cobra.ExactArgs(1),
+		Run:   lintViews,
+	})
+
+	return &cmd
+}
+
+func explainView(kctx string, args []string) error {
+	if err := config.InitLocs(); err != nil {
+		return err
+	}
+	if err := config.LoadViewProfiles(config.AppViewProfilesDir); err != nil {
+		return err
+	}
+	if kctx == "" {
+		var err error
+		if kctx, err = client.NewConfig(k8sFlags).CurrentContextName(); err != nil {
+			fmt.Fprintln(out, color.Colorize("Unable to locate the current context. Ignoring views contexts.", color.Yellow))
+		}
+	}
+	cv := config.NewCustomView()
+	cv.SetContext(kctx)
+	if err := cv.Load(config.AppViewsFile); err != nil {
+		return err
+	}
+	if err := cv.LoadDir(config.AppViewsDir); err != nil {
+		return err
+	}
+
+	var ns string
+	if len(args) > 1 {
+		ns = args[1]
+	}
+	key, vs := cv.Explain(args[0], ns)
+	if vs == nil {
+		fmt.Fprintln(out, color.Colorize("No custom view matched. Using defaults.", color.Yellow))
+		return nil
+	}
+	fmt.Fprintf(out, "%s %s\n", color.Colorize("Matched:", color.Cyan), key)
+	if pp := cv.ProfileOrder(); len(pp) > 0 {
+		fmt.Fprintf(out, "%s %s\n", color.Colorize("Profiles:", color.Cyan), strings.Join(pp, " -> "))
+	}
+	bb, err := yaml.Marshal(vs)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprint(out, string(bb))
+
+	return err
+}
+
+func lintViews(cmd *cobra.Command, args []string) {
+	if err := config.InitLocs(); err == nil {
+		if err := config.LoadViewProfiles(config.AppViewProfilesDir); err != nil {
+			fmt.Fprintln(out, color.Colorize(err.Error(), color.Yellow))
+		}
+	}
+	ii := config.LintViews(args[0])
+	for _, i := range ii {
+		c := color.Yellow
+		if i.Severity == config.LintError {
+			c = color.Red
+		}
+		fmt.Fprintln(out, color.Colorize(i.String(), c))
+	}
+	if config.HasLintErrors(ii) {
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,124 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+)
+
+var breakpointRX = regexp.MustCompile(`\A\s*(<=|>=|<|>|=)\s*(\d+)\s*\z`)
+
+// Breakpoint represents a view columns set selected by terminal width.
+type Breakpoint struct {
+	Columns []string `yaml:"columns"`
+}
+
+// breakpointExpr represents a parsed breakpoint width expression ie <120.
+type breakpointExpr struct {
+	op    string
+	width int
+}
+
+func parseBreakpoint(s string) (breakpointExpr, error) {
+	mm := breakpointRX.FindStringSubmatch(s)
+	if mm == nil {
+		return breakpointExpr{}, fmt.Errorf("invalid breakpoint %q. must read <op><width> with op one of <, <=, >, >= or =", s)
+	}
+	w, err := strconv.Atoi(mm[2])
+	if err != nil {
+		return breakpointExpr{}, fmt.Errorf("invalid breakpoint %q: %w", s, err)
+	}
+
+	return breakpointExpr{op: mm[1], width: w}, nil
+}
+
+func (b breakpointExpr) matches(w int) bool {
+	switch b.op {
+	case "<":
+		return w < b.width
+	case "<=":
+		return w <= b.width
+	case ">":
+		return w > b.width
+	case ">=":
+		return w >= b.width
+	default:
+		return w == b.width
+	}
+}
+
+// BreakpointFor returns the breakpoint expression matching a given width or
+// blank if none. Overlapping breakpoints resolve to the one whose bound is
+// closest to the width, ties going to the lexically first expression.
+func (v *ViewSetting) BreakpointFor(width int) string {
+	if v == nil || width <= 0 {
+		return ""
+	}
+	ee := make([]string, 0, len(v.Breakpoints))
+	for e := range v.Breakpoints {
+		ee = append(ee, e)
+	}
+	slices.Sort(ee)
+
+	match, gap := "", -1
+	for _, e := range ee {
+		b, err := parseBreakpoint(e)
+		if err != nil || !b.matches(width) {
+			continue
+		}
+		if d := abs(width - b.width); gap < 0 || d < gap {
+			match, gap = e, d
+		}
+	}
+
+	return match
+}
+
+// ForWidth returns the view setting with its columns set from the breakpoint
+// matching a given width. The base columns apply when no breakpoint matches.
+func (v *ViewSetting) ForWidth(width int) ViewSetting {
+	out := v.Clone()
+	if e := v.BreakpointFor(width); e != "" {
+		out.Columns = slices.Clone(v.Breakpoints[e].Columns)
+	}
+
+	return out
+}
+
+// validateBreakpoints checks breakpoints expressions and columns.
+func (v *ViewSetting) validateBreakpoints() error {
+	ee := make([]string, 0, len(v.Breakpoints))
+	for e := range v.Breakpoints {
+		ee = append(ee, e)
+	}
+	slices.Sort(ee)
+	for _, e := range ee {
+		if _, err := parseBreakpoint(e); err != nil {
+			return err
+		}
+		b := ViewSetting{Columns: v.Breakpoints[e].Columns}
+		if len(b.Columns) == 0 {
+			return fmt.Errorf("breakpoint %q must list columns", e)
+		}
+		if _, err := b.JSONPathCols(); err != nil {
+			return fmt.Errorf("breakpoint %q: %w", e, err)
+		}
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+
+	return n
+}
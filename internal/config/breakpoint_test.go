@@ -0,0 +1,80 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestViewSettingBreakpointFor(t *testing.T) {
+	uu := map[string]struct {
+		bb    []string
+		width int
+		e     string
+	}{
+		"none": {
+			width: 100,
+		},
+		"no-width": {
+			bb: []string{"<120"},
+		},
+		"lt": {
+			bb:    []string{"<120", ">=120"},
+			width: 119,
+			e:     "<120",
+		},
+		"ge": {
+			bb:    []string{"<120", ">=120"},
+			width: 120,
+			e:     ">=120",
+		},
+		"le": {
+			bb:    []string{"<=80"},
+			width: 80,
+			e:     "<=80",
+		},
+		"eq": {
+			bb:    []string{"=80", "<100"},
+			width: 80,
+			e:     "=80",
+		},
+		"no-match": {
+			bb:    []string{"<80", ">200"},
+			width: 100,
+		},
+		"overlap-closest": {
+			bb:    []string{"<120", "<100", "<200"},
+			width: 90,
+			e:     "<100",
+		},
+		"overlap-tie": {
+			bb:    []string{">=80", "<120"},
+			width: 100,
+			e:     "<120",
+		},
+		"spaces": {
+			bb:    []string{" >= 80 "},
+			width: 80,
+			e:     " >= 80 ",
+		},
+		"invalid": {
+			bb:    []string{"~100"},
+			width: 100,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			vs := config.ViewSetting{Breakpoints: make(map[string]config.Breakpoint, len(u.bb))}
+			for _, b := range u.bb {
+				vs.Breakpoints[b] = config.Breakpoint{Columns: []string{"NAME"}}
+			}
+			assert.Equal(t, u.e, vs.BreakpointFor(u.width))
+		})
+	}
+}
@@ -13,6 +13,11 @@ import (
 // JSONValidator validate yaml configurations.
 var JSONValidator = json.NewValidator()
 
+// ViewsJSONSchema returns the views configuration JSON schema.
+func ViewsJSONSchema() []byte {
+	return json.ViewsSchemaDoc()
+}
+
 const (
 	// DefaultDirMod default unix perms for k9s directory.
 	DefaultDirMod os.FileMode = 0744
@@ -72,6 +72,12 @@ var (
 	// AppViewsFile tracks custom views config file.
 	AppViewsFile string
 
+	// AppViewsDir tracks custom views config directory.
+	AppViewsDir string
+
+	// AppViewProfilesDir tracks custom views profiles directory.
+	AppViewProfilesDir string
+
 	// AppAliasesFile tracks aliases config file.
 	AppAliasesFile string
 
@@ -146,6 +152,8 @@ func initK9sEnvLocs() error {
 	AppAliasesFile = filepath.Join(AppConfigDir, "aliases.yaml")
 	AppPluginsFile = filepath.Join(AppConfigDir, "plugins.yaml")
 	AppViewsFile = filepath.Join(AppConfigDir, "views.yaml")
+	AppViewsDir = filepath.Join(AppConfigDir, "views.d")
+	AppViewProfilesDir = filepath.Join(AppConfigDir, "profiles")
 
 	return nil
 }
@@ -167,6 +175,8 @@ func initXDGLocs() error {
 	AppAliasesFile = filepath.Join(AppConfigDir, "aliases.yaml")
 	AppPluginsFile = filepath.Join(AppConfigDir, "plugins.yaml")
 	AppViewsFile = filepath.Join(AppConfigDir, "views.yaml")
+	AppViewsDir = filepath.Join(AppConfigDir, "views.d")
+	AppViewProfilesDir = filepath.Join(AppConfigDir, "profiles")
 
 	AppSkinsDir = filepath.Join(AppConfigDir, "skins")
 	if err := data.EnsureFullPath(AppSkinsDir, data.DefaultDirMod); err != nil {
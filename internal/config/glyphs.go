@@ -0,0 +1,13 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config
+
+// XrayGlyphs tracks the glyphs rendering xray nodes statuses.
+type XrayGlyphs struct {
+	// ASCII swaps the default unicode glyphs for ascii only ones.
+	ASCII bool `json:"ascii" yaml:"ascii"`
+
+	// Statuses overrides glyphs keyed by status ie ok, toast, noref, completed, terminating or expiring.
+	Statuses map[string]string `json:"statuses" yaml:"statuses,omitempty"`
+}
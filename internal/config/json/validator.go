@@ -61,6 +61,11 @@ var (
 	skinSchema string
 )
 
+// ViewsSchemaDoc returns the raw views schema document.
+func ViewsSchemaDoc() []byte {
+	return []byte(viewsSchema)
+}
+
 // Validator tracks schemas validation.
 type Validator struct {
 	schemas map[string]gojsonschema.JSONLoader
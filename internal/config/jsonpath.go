@@ -0,0 +1,172 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config
+
+import (
+	"bytes"
+	"fmt"
+	"path"
+	"strings"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/client-go/util/jsonpath"
+)
+
+const (
+	// JSONPathPrefix designates a column computed from the raw resource.
+	JSONPathPrefix = "jsonpath:"
+
+	// LabelPrefix designates a column computed from the resource labels.
+	LabelPrefix = "label:"
+
+	labelFallback = "||"
+)
+
+// jsonPaths caches parsed expressions. A jsonpath carries its evaluation
+// state so each expression hands out pooled parsers instead of a shared one.
+var jsonPaths = struct {
+	cache map[string]*sync.Pool
+	mx    sync.Mutex
+}{
+	cache: make(map[string]*sync.Pool),
+}
+
+// JSONPathCol represents a column computed via a jsonpath expression or
+// from the first non blank value of a list of labels.
+type JSONPathCol struct {
+	Name, Expr string
+	Labels     []string
+}
+
+// IsJSONPathCol returns true if the column spec is a jsonpath column.
+func IsJSONPathCol(spec string) bool {
+	return strings.HasPrefix(spec, JSONPathPrefix)
+}
+
+// ParseJSONPathCol parses a column spec of the form jsonpath:{.spec.replicas}[|NAME].
+// The column name defaults to the last path segment when omitted.
+func ParseJSONPathCol(spec string) (JSONPathCol, error) {
+	expr, name, _ := strings.Cut(strings.TrimSuffix(strings.TrimPrefix(spec, JSONPathPrefix), LockedColumn), "|")
+	if !strings.HasPrefix(expr, "{") || !strings.HasSuffix(expr, "}") {
+		return JSONPathCol{}, fmt.Errorf("invalid jsonpath column %q. must be jsonpath:{expr}|NAME", spec)
+	}
+	if name == "" {
+		tt := strings.Split(strings.Trim(expr, "{}"), ".")
+		name = strings.ToUpper(tt[len(tt)-1])
+	}
+	_, err := compileJSONPath(expr)
+	if err != nil {
+		return JSONPathCol{}, fmt.Errorf("invalid jsonpath column %q: %w", spec, err)
+	}
+
+	return JSONPathCol{Name: name, Expr: expr}, nil
+}
+
+// IsLabelCol returns true if the column spec is a label column.
+func IsLabelCol(spec string) bool {
+	return strings.HasPrefix(spec, LabelPrefix)
+}
+
+// ParseLabelCol parses a column spec of the form label:key[||key...][|NAME].
+// Keys are listed in fallback order. The column name defaults to the first
+// key name.
+func ParseLabelCol(spec string) (JSONPathCol, error) {
+	kk := strings.Split(strings.TrimSuffix(strings.TrimPrefix(spec, LabelPrefix), LockedColumn), labelFallback)
+	last, name, _ := strings.Cut(kk[len(kk)-1], "|")
+	kk[len(kk)-1] = last
+	for _, k := range kk {
+		if k == "" || strings.Contains(k, "|") {
+			return JSONPathCol{}, fmt.Errorf("invalid label column %q. must be label:key[||key...]|NAME", spec)
+		}
+		if ee := validation.IsQualifiedName(k); len(ee) > 0 {
+			return JSONPathCol{}, fmt.Errorf("invalid label column %q: label %q %s", spec, k, ee[0])
+		}
+	}
+	if name == "" {
+		name = strings.ToUpper(path.Base(kk[0]))
+	}
+
+	return JSONPathCol{Name: name, Labels: kk}, nil
+}
+
+// IsComputedCol returns true if the column spec is a jsonpath or label column.
+func IsComputedCol(spec string) bool {
+	return IsJSONPathCol(spec) || IsLabelCol(spec)
+}
+
+// ParseComputedCol parses a jsonpath or label column spec.
+func ParseComputedCol(spec string) (JSONPathCol, error) {
+	if IsLabelCol(spec) {
+		return ParseLabelCol(spec)
+	}
+
+	return ParseJSONPathCol(spec)
+}
+
+// Eval evaluates the column expression against a raw resource.
+// Missing paths evaluate to blank.
+func (c JSONPathCol) Eval(o interface{}) (string, error) {
+	if len(c.Labels) > 0 {
+		return labelValue(o, c.Labels), nil
+	}
+	pool, err := compileJSONPath(c.Expr)
+	if err != nil {
+		return "", err
+	}
+	jp := pool.Get().(*jsonpath.JSONPath)
+	defer pool.Put(jp)
+
+	var buff bytes.Buffer
+	if err := jp.Execute(&buff, o); err != nil {
+		return "", err
+	}
+
+	return buff.String(), nil
+}
+
+// compileJSONPath returns a cached pool of parsers for a jsonpath expression.
+func compileJSONPath(expr string) (*sync.Pool, error) {
+	jsonPaths.mx.Lock()
+	defer jsonPaths.mx.Unlock()
+
+	if pool, ok := jsonPaths.cache[expr]; ok {
+		return pool, nil
+	}
+	jp, err := parseJSONPath(expr)
+	if err != nil {
+		return nil, err
+	}
+	pool := sync.Pool{New: func() any {
+		jp, _ := parseJSONPath(expr)
+		return jp
+	}}
+	pool.Put(jp)
+	jsonPaths.cache[expr] = &pool
+
+	return &pool, nil
+}
+
+func parseJSONPath(expr string) (*jsonpath.JSONPath, error) {
+	jp := jsonpath.New(expr).AllowMissingKeys(true)
+	if err := jp.Parse(expr); err != nil {
+		return nil, err
+	}
+
+	return jp, nil
+}
+
+// labelValue returns the first non blank label value of a raw resource.
+func labelValue(o interface{}, kk []string) string {
+	m, _ := o.(map[string]interface{})
+	md, _ := m["metadata"].(map[string]interface{})
+	ll, _ := md["labels"].(map[string]interface{})
+	for _, k := range kk {
+		if v, _ := ll[k].(string); v != "" {
+			return v
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,198 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config_test
+
+import (
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseJSONPathCol(t *testing.T) {
+	uu := map[string]struct {
+		spec string
+		e    config.JSONPathCol
+		err  string
+	}{
+		"named": {
+			spec: "jsonpath:{.spec.replicas}|REPS",
+			e:    config.JSONPathCol{Name: "REPS", Expr: "{.spec.replicas}"},
+		},
+		"unnamed": {
+			spec: "jsonpath:{.spec.replicas}",
+			e:    config.JSONPathCol{Name: "REPLICAS", Expr: "{.spec.replicas}"},
+		},
+		"no-braces": {
+			spec: "jsonpath:.spec.replicas|REPS",
+			err:  `invalid jsonpath column "jsonpath:.spec.replicas|REPS". must be jsonpath:{expr}|NAME`,
+		},
+		"bad-expr": {
+			spec: "jsonpath:{.spec[}|REPS",
+			err:  `invalid jsonpath column "jsonpath:{.spec[}|REPS": unterminated array`,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			c, err := config.ParseJSONPathCol(u.spec)
+			if u.err != "" {
+				assert.EqualError(t, err, u.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, u.e, c)
+		})
+	}
+}
+
+func TestJSONPathColEval(t *testing.T) {
+	o := map[string]interface{}{
+		"spec": map[string]interface{}{"replicas": int64(3)},
+	}
+	uu := map[string]struct {
+		expr, e string
+	}{
+		"found":   {expr: "{.spec.replicas}", e: "3"},
+		"missing": {expr: "{.spec.bozo}"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			v, err := config.JSONPathCol{Name: "A", Expr: u.expr}.Eval(o)
+			assert.NoError(t, err)
+			assert.Equal(t, u.e, v)
+		})
+	}
+}
+
+func TestJSONPathColEvalConcurrent(t *testing.T) {
+	c := config.JSONPathCol{Name: "REPS", Expr: "{.spec.replicas}"}
+
+	var wg sync.WaitGroup
+	for i := range 20 {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			o := map[string]interface{}{
+				"spec": map[string]interface{}{"replicas": int64(i)},
+			}
+			v, err := c.Eval(o)
+			assert.NoError(t, err)
+			assert.Equal(t, strconv.Itoa(i), v)
+		}(i)
+	}
+	wg.Wait()
+}
+
+func TestViewSettingColNames(t *testing.T) {
+	vs := config.ViewSetting{Columns: []string{"NAME", "jsonpath:{.spec.replicas}|REPS"}}
+
+	assert.Equal(t, []string{"NAME", "REPS"}, vs.ColNames())
+	cc, err := vs.JSONPathCols()
+	assert.NoError(t, err)
+	assert.Equal(t, []config.JSONPathCol{{Name: "REPS", Expr: "{.spec.replicas}"}}, cc)
+}
+
+func TestParseLabelCol(t *testing.T) {
+	uu := map[string]struct {
+		spec string
+		e    config.JSONPathCol
+		err  string
+	}{
+		"single": {
+			spec: "label:app|APP",
+			e:    config.JSONPathCol{Name: "APP", Labels: []string{"app"}},
+		},
+		"fallbacks": {
+			spec: "label:app.kubernetes.io/name||app||k8s-app|APP",
+			e:    config.JSONPathCol{Name: "APP", Labels: []string{"app.kubernetes.io/name", "app", "k8s-app"}},
+		},
+		"unnamed": {
+			spec: "label:app.kubernetes.io/name||app",
+			e:    config.JSONPathCol{Name: "NAME", Labels: []string{"app.kubernetes.io/name", "app"}},
+		},
+		"locked": {
+			spec: "label:app||k8s-app|APP!",
+			e:    config.JSONPathCol{Name: "APP", Labels: []string{"app", "k8s-app"}},
+		},
+		"blank-key": {
+			spec: "label:app||||k8s-app|APP",
+			err:  `invalid label column "label:app||||k8s-app|APP". must be label:key[||key...]|NAME`,
+		},
+		"single-bar": {
+			spec: "label:app|k8s-app||tier|APP",
+			err:  `invalid label column "label:app|k8s-app||tier|APP". must be label:key[||key...]|NAME`,
+		},
+		"bad-key": {
+			spec: "label:-app|APP",
+			err:  `invalid label column "label:-app|APP": label "-app" name part must consist of alphanumeric characters`,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			c, err := config.ParseComputedCol(u.spec)
+			if u.err != "" {
+				assert.ErrorContains(t, err, u.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, u.e, c)
+		})
+	}
+}
+
+func TestLabelColEval(t *testing.T) {
+	c := config.JSONPathCol{Name: "APP", Labels: []string{"app.kubernetes.io/name", "app", "k8s-app"}}
+	uu := map[string]struct {
+		labels map[string]interface{}
+		e      string
+	}{
+		"first": {
+			labels: map[string]interface{}{"app.kubernetes.io/name": "fred", "app": "blee", "k8s-app": "zorg"},
+			e:      "fred",
+		},
+		"second": {
+			labels: map[string]interface{}{"app": "blee", "k8s-app": "zorg"},
+			e:      "blee",
+		},
+		"blank-skipped": {
+			labels: map[string]interface{}{"app.kubernetes.io/name": "", "k8s-app": "zorg"},
+			e:      "zorg",
+		},
+		"none": {
+			labels: map[string]interface{}{"tier": "web"},
+		},
+		"no-labels": {},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			o := map[string]interface{}{
+				"metadata": map[string]interface{}{"name": "p1"},
+			}
+			if u.labels != nil {
+				o["metadata"].(map[string]interface{})["labels"] = u.labels
+			}
+			v, err := c.Eval(o)
+			assert.NoError(t, err)
+			assert.Equal(t, u.e, v)
+		})
+	}
+}
+
+func TestViewSettingLabelCols(t *testing.T) {
+	vs := config.ViewSetting{Columns: []string{"NAME", "label:app.kubernetes.io/name||app|APP"}}
+	assert.Equal(t, []string{"NAME", "APP"}, vs.ColNames())
+
+	assert.NoError(t, config.NewCustomView().Load("testdata/views/label-cols.yaml"))
+	assert.ErrorContains(t, config.NewCustomView().Load("testdata/views/label-cols-bad.yaml"), `invalid label column "label:app|||APP"`)
+}
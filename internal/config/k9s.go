@@ -18,19 +18,30 @@ import (
 
 // K9s tracks K9s configuration options.
 type K9s struct {
-	LiveViewAutoRefresh bool       `json:"liveViewAutoRefresh" yaml:"liveViewAutoRefresh"`
-	ScreenDumpDir       string     `json:"screenDumpDir" yaml:"screenDumpDir,omitempty"`
-	RefreshRate         int        `json:"refreshRate" yaml:"refreshRate"`
-	MaxConnRetry        int        `json:"maxConnRetry" yaml:"maxConnRetry"`
-	ReadOnly            bool       `json:"readOnly" yaml:"readOnly"`
-	NoExitOnCtrlC       bool       `json:"noExitOnCtrlC" yaml:"noExitOnCtrlC"`
-	UI                  UI         `json:"ui" yaml:"ui"`
-	SkipLatestRevCheck  bool       `json:"skipLatestRevCheck" yaml:"skipLatestRevCheck"`
-	DisablePodCounting  bool       `json:"disablePodCounting" yaml:"disablePodCounting"`
-	ShellPod            ShellPod   `json:"shellPod" yaml:"shellPod"`
-	ImageScans          ImageScans `json:"imageScans" yaml:"imageScans"`
-	Logger              Logger     `json:"logger" yaml:"logger"`
-	Thresholds          Threshold  `json:"thresholds" yaml:"thresholds"`
+	LiveViewAutoRefresh bool         `json:"liveViewAutoRefresh" yaml:"liveViewAutoRefresh"`
+	ScreenDumpDir       string       `json:"screenDumpDir" yaml:"screenDumpDir,omitempty"`
+	RefreshRate         int          `json:"refreshRate" yaml:"refreshRate"`
+	MaxConnRetry        int          `json:"maxConnRetry" yaml:"maxConnRetry"`
+	ReadOnly            bool         `json:"readOnly" yaml:"readOnly"`
+	NoExitOnCtrlC       bool         `json:"noExitOnCtrlC" yaml:"noExitOnCtrlC"`
+	ReloadViewsOnSignal bool         `json:"reloadViewsOnSignal" yaml:"reloadViewsOnSignal"`
+	ViewsURL            string       `json:"viewsURL" yaml:"viewsURL,omitempty"`
+	UI                  UI           `json:"ui" yaml:"ui"`
+	SkipLatestRevCheck  bool         `json:"skipLatestRevCheck" yaml:"skipLatestRevCheck"`
+	DisablePodCounting  bool         `json:"disablePodCounting" yaml:"disablePodCounting"`
+	StrictRefs          bool         `json:"strictRefs" yaml:"strictRefs"`
+	XrayEvents          bool         `json:"xrayEvents" yaml:"xrayEvents"`
+	XrayQuotas          bool         `json:"xrayQuotas" yaml:"xrayQuotas"`
+	XrayWebhooks        bool         `json:"xrayWebhooks" yaml:"xrayWebhooks"`
+	XrayPolicies        bool         `json:"xrayPolicies" yaml:"xrayPolicies"`
+	XrayCache           bool         `json:"xrayCache" yaml:"xrayCache"`
+	XraySidecars        []string     `json:"xraySidecars" yaml:"xraySidecars,omitempty"`
+	XrayPricing         *XrayPricing `json:"xrayPricing" yaml:"xrayPricing,omitempty"`
+	XrayGlyphs          *XrayGlyphs  `json:"xrayGlyphs" yaml:"xrayGlyphs,omitempty"`
+	ShellPod            ShellPod     `json:"shellPod" yaml:"shellPod"`
+	ImageScans          ImageScans   `json:"imageScans" yaml:"imageScans"`
+	Logger              Logger       `json:"logger" yaml:"logger"`
+	Thresholds          Threshold    `json:"thresholds" yaml:"thresholds"`
 	manualRefreshRate   int
 	manualHeadless      *bool
 	manualLogoless      *bool
@@ -99,9 +110,20 @@ func (k *K9s) Merge(k1 *K9s) {
 	k.MaxConnRetry = k1.MaxConnRetry
 	k.ReadOnly = k1.ReadOnly
 	k.NoExitOnCtrlC = k1.NoExitOnCtrlC
+	k.ReloadViewsOnSignal = k1.ReloadViewsOnSignal
+	k.ViewsURL = k1.ViewsURL
 	k.UI = k1.UI
 	k.SkipLatestRevCheck = k1.SkipLatestRevCheck
 	k.DisablePodCounting = k1.DisablePodCounting
+	k.StrictRefs = k1.StrictRefs
+	k.XrayEvents = k1.XrayEvents
+	k.XrayQuotas = k1.XrayQuotas
+	k.XrayWebhooks = k1.XrayWebhooks
+	k.XrayPolicies = k1.XrayPolicies
+	k.XrayCache = k1.XrayCache
+	k.XraySidecars = k1.XraySidecars
+	k.XrayPricing = k1.XrayPricing
+	k.XrayGlyphs = k1.XrayGlyphs
 	k.ShellPod = k1.ShellPod
 	k.Logger = k1.Logger
 	k.ImageScans = k1.ImageScans
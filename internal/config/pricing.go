@@ -0,0 +1,13 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config
+
+// XrayPricing tracks resources unit prices used to estimate workloads cost.
+type XrayPricing struct {
+	// CPUHour represents the price of one cpu core per hour.
+	CPUHour float64 `json:"cpuHour" yaml:"cpuHour"`
+
+	// MemGiBHour represents the price of one GiB of memory per hour.
+	MemGiBHour float64 `json:"memGiBHour" yaml:"memGiBHour"`
+}
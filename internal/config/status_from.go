@@ -0,0 +1,152 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const (
+	// DefaultStatusColumn names a derived status column.
+	DefaultStatusColumn = "STATUS"
+
+	// DefaultReadyStatus renders a derived status when all conditions are true.
+	DefaultReadyStatus = "Ready"
+)
+
+// StatusDisplay represents a derived status text and color.
+type StatusDisplay struct {
+	Text  string `yaml:"text,omitempty"`
+	Color Color  `yaml:"color,omitempty"`
+}
+
+// StatusFrom derives a status column from a resource status conditions.
+// The status is ready if all conditions are true. Otherwise it renders the
+// first failing condition in priority order.
+type StatusFrom struct {
+	Column     string                   `yaml:"column,omitempty"`
+	Conditions []string                 `yaml:"conditions"`
+	Ready      StatusDisplay            `yaml:"ready,omitempty"`
+	Failing    map[string]StatusDisplay `yaml:"failing,omitempty"`
+	FailColor  Color                    `yaml:"failColor,omitempty"`
+}
+
+// ColName returns the derived status column name.
+func (s *StatusFrom) ColName() string {
+	if s.Column == "" {
+		return DefaultStatusColumn
+	}
+
+	return s.Column
+}
+
+// Eval derives a raw resource status. Failing conditions render their
+// configured text, defaulting to the condition message, reason or type.
+func (s *StatusFrom) Eval(raw interface{}) string {
+	m, _ := raw.(map[string]interface{})
+	cc, _, _ := unstructured.NestedSlice(m, "status", "conditions")
+	conds := make(map[string]map[string]interface{}, len(cc))
+	for _, c := range cc {
+		if cm, ok := c.(map[string]interface{}); ok {
+			if t, _ := cm["type"].(string); t != "" {
+				conds[t] = cm
+			}
+		}
+	}
+	for _, t := range s.Conditions {
+		c := conds[t]
+		if st, _ := c["status"].(string); st == "True" {
+			continue
+		}
+		if d := s.Failing[t]; d.Text != "" {
+			return d.Text
+		}
+		for _, k := range []string{"message", "reason"} {
+			if v, _ := c[k].(string); v != "" {
+				return v
+			}
+		}
+		return t
+	}
+
+	return s.readyText()
+}
+
+// ColorFor returns the color of a derived status or blank if none applies.
+func (s *StatusFrom) ColorFor(status string) Color {
+	if status == s.readyText() {
+		return s.Ready.Color
+	}
+	for _, t := range s.Conditions {
+		if d := s.Failing[t]; d.Text != "" && d.Text == status {
+			return d.Color
+		}
+	}
+
+	return s.FailColor
+}
+
+// Clone returns a deep copy.
+func (s *StatusFrom) Clone() *StatusFrom {
+	if s == nil {
+		return nil
+	}
+	out := *s
+	out.Conditions = slices.Clone(s.Conditions)
+	out.Failing = maps.Clone(s.Failing)
+
+	return &out
+}
+
+// Equals checks if two derived statuses are equal.
+func (s *StatusFrom) Equals(o *StatusFrom) bool {
+	if s == nil || o == nil {
+		return s == o
+	}
+
+	return s.Column == o.Column &&
+		s.Ready == o.Ready &&
+		s.FailColor == o.FailColor &&
+		slices.Equal(s.Conditions, o.Conditions) &&
+		maps.Equal(s.Failing, o.Failing)
+}
+
+func (s *StatusFrom) readyText() string {
+	if s.Ready.Text == "" {
+		return DefaultReadyStatus
+	}
+
+	return s.Ready.Text
+}
+
+func (s *StatusFrom) validate() error {
+	if len(s.Conditions) == 0 {
+		return errors.New("statusFrom requires at least one condition")
+	}
+	for i, t := range s.Conditions {
+		if t == "" {
+			return errors.New("statusFrom conditions must not be blank")
+		}
+		if slices.Contains(s.Conditions[:i], t) {
+			return fmt.Errorf("statusFrom condition %q listed more than once", t)
+		}
+	}
+	tt := make([]string, 0, len(s.Failing))
+	for t := range s.Failing {
+		tt = append(tt, t)
+	}
+	slices.Sort(tt)
+	for _, t := range tt {
+		if !slices.Contains(s.Conditions, t) {
+			return fmt.Errorf("statusFrom failing condition %q is not listed in conditions", t)
+		}
+	}
+
+	return nil
+}
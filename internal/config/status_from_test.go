@@ -0,0 +1,114 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStatusFromEval(t *testing.T) {
+	s := config.StatusFrom{
+		Conditions: []string{"Ready", "Synced", "Healthy"},
+		Ready:      config.StatusDisplay{Text: "OK", Color: "green"},
+		Failing: map[string]config.StatusDisplay{
+			"Synced": {Text: "OutOfSync", Color: "orange"},
+		},
+		FailColor: "red",
+	}
+	uu := map[string]struct {
+		raw   interface{}
+		e     string
+		color config.Color
+	}{
+		"ready": {
+			raw:   makeConditions(cond("Ready", "True"), cond("Synced", "True"), cond("Healthy", "True")),
+			e:     "OK",
+			color: "green",
+		},
+		"message": {
+			raw: makeConditions(
+				cond("Ready", "False", "reason", "Crashed", "message", "pod is crashing"),
+				cond("Synced", "False"),
+			),
+			e:     "pod is crashing",
+			color: "red",
+		},
+		"reason": {
+			raw:   makeConditions(cond("Ready", "Unknown", "reason", "Pending")),
+			e:     "Pending",
+			color: "red",
+		},
+		"mapped": {
+			raw:   makeConditions(cond("Ready", "True"), cond("Synced", "False", "message", "drifted")),
+			e:     "OutOfSync",
+			color: "orange",
+		},
+		"missing": {
+			raw:   makeConditions(cond("Ready", "True"), cond("Synced", "True")),
+			e:     "Healthy",
+			color: "red",
+		},
+		"blank": {
+			e:     "Ready",
+			color: "red",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			v := s.Eval(u.raw)
+			assert.Equal(t, u.e, v)
+			assert.Equal(t, u.color, s.ColorFor(v))
+		})
+	}
+}
+
+func TestStatusFromDefaults(t *testing.T) {
+	s := config.StatusFrom{Conditions: []string{"Ready"}}
+
+	assert.Equal(t, config.DefaultStatusColumn, s.ColName())
+	assert.Equal(t, config.DefaultReadyStatus, s.Eval(makeConditions(cond("Ready", "True"))))
+	assert.Equal(t, config.Color(""), s.ColorFor("Ready"))
+	assert.True(t, s.Equals(s.Clone()))
+	assert.False(t, s.Equals(&config.StatusFrom{Conditions: []string{"Synced"}}))
+	assert.False(t, s.Equals(nil))
+}
+
+func TestCustomViewLoadStatusFrom(t *testing.T) {
+	cfg := config.NewCustomView()
+	assert.NoError(t, cfg.Load("testdata/views/status-from.yaml"))
+	s := cfg.Views["example.com/v1/widgets"].StatusFrom
+	assert.Equal(t, []string{"Ready", "Synced"}, s.Conditions)
+	assert.Equal(t, "HEALTH", s.ColName())
+
+	assert.Error(t, config.NewCustomView().Load("testdata/views/status-from-bad.yaml"))
+	ii := config.LintViews("testdata/views/status-from-bad.yaml")
+	assert.Len(t, ii, 2)
+	assert.Equal(t, `statusFrom condition "Ready" listed more than once`, ii[0].Message)
+	assert.Equal(t, `statusFrom failing condition "Synced" is not listed in conditions`, ii[1].Message)
+}
+
+// Helpers...
+
+func cond(kv ...string) map[string]interface{} {
+	m := map[string]interface{}{"type": kv[0], "status": kv[1]}
+	for i := 2; i+1 < len(kv); i += 2 {
+		m[kv[i]] = kv[i+1]
+	}
+
+	return m
+}
+
+func makeConditions(cc ...map[string]interface{}) map[string]interface{} {
+	ii := make([]interface{}, 0, len(cc))
+	for _, c := range cc {
+		ii = append(ii, c)
+	}
+
+	return map[string]interface{}{"status": map[string]interface{}{"conditions": ii}}
+}
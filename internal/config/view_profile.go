@@ -0,0 +1,206 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/derailed/k9s/internal/config/data"
+	"github.com/derailed/k9s/internal/config/json"
+	"github.com/rs/zerolog/log"
+	"gopkg.in/yaml.v2"
+)
+
+// ViewProfile represents a named base views configuration views files may
+// layer their settings on.
+type ViewProfile struct {
+	// Profile names the profile this profile layers on if any.
+	Profile string
+
+	// Contexts gates the profile to matching contexts.
+	Contexts []string
+
+	// Views tracks the profile views settings.
+	Views map[string]ViewSetting
+}
+
+// viewProfiles tracks the registered profiles and the profiles loaded from
+// the profiles directory. The latter take precedence and are rebuilt on load.
+var viewProfiles = struct {
+	pp, dir map[string]ViewProfile
+	mx      sync.RWMutex
+}{pp: make(map[string]ViewProfile), dir: make(map[string]ViewProfile)}
+
+// RegisterViewProfile registers a named views profile, replacing any
+// profile registered under the same name.
+func RegisterViewProfile(name string, p ViewProfile) {
+	viewProfiles.mx.Lock()
+	defer viewProfiles.mx.Unlock()
+
+	viewProfiles.pp[name] = p.clone()
+}
+
+func (p ViewProfile) clone() ViewProfile {
+	vv := make(map[string]ViewSetting, len(p.Views))
+	for k, vs := range p.Views {
+		vv[k] = vs.Clone()
+	}
+	p.Contexts, p.Views = slices.Clone(p.Contexts), vv
+
+	return p
+}
+
+// lookupViewProfile returns a named profile. Callers must hold the lock.
+func lookupViewProfile(name string) (ViewProfile, bool) {
+	if p, ok := viewProfiles.dir[name]; ok {
+		return p, true
+	}
+	p, ok := viewProfiles.pp[name]
+
+	return p, ok
+}
+
+// LoadViewProfiles loads the views profiles found in a directory, replacing
+// the ones previously loaded. Profiles are named after their file name sans
+// extension. Invalid files are skipped.
+func LoadViewProfiles(dir string) error {
+	pp := make(map[string]ViewProfile)
+	if _, err := os.Stat(dir); errors.Is(err, fs.ErrNotExist) {
+		setDirViewProfiles(pp)
+		return nil
+	}
+	var ff []string
+	for _, ext := range []string{"*.yaml", "*.json"} {
+		mm, err := filepath.Glob(filepath.Join(dir, ext))
+		if err != nil {
+			return err
+		}
+		ff = append(ff, mm...)
+	}
+	slices.Sort(ff)
+	for _, f := range ff {
+		p, err := loadViewProfile(f)
+		if err != nil {
+			log.Warn().Err(err).Msgf("Skipping views profile %q", f)
+			continue
+		}
+		pp[strings.TrimSuffix(filepath.Base(f), filepath.Ext(f))] = p
+	}
+	setDirViewProfiles(pp)
+
+	return nil
+}
+
+func setDirViewProfiles(pp map[string]ViewProfile) {
+	viewProfiles.mx.Lock()
+	defer viewProfiles.mx.Unlock()
+
+	viewProfiles.dir = pp
+}
+
+// ViewProfileOrder returns a profile resolution order, base profiles first.
+func ViewProfileOrder(name string) ([]string, error) {
+	viewProfiles.mx.RLock()
+	defer viewProfiles.mx.RUnlock()
+
+	var nn []string
+	for p := name; p != ""; {
+		if slices.Contains(nn, p) {
+			return nil, fmt.Errorf("views profile cycle detected: %s", strings.Join(append(nn, p), " -> "))
+		}
+		vp, ok := lookupViewProfile(p)
+		if !ok {
+			if p == name {
+				return nil, fmt.Errorf("unknown views profile %q", p)
+			}
+			return nil, fmt.Errorf("unknown views profile %q referenced by %q", p, nn[len(nn)-1])
+		}
+		nn, p = append(nn, p), vp.Profile
+	}
+	slices.Reverse(nn)
+
+	return nn, nil
+}
+
+// resolveProfile merges a profile views over its base profiles. Views gated
+// by contexts not matching the given context are dropped.
+func resolveProfile(name, ct string) (map[string]ViewSetting, []string, error) {
+	order, err := ViewProfileOrder(name)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	viewProfiles.mx.RLock()
+	defer viewProfiles.mx.RUnlock()
+
+	vv := make(map[string]ViewSetting)
+	for _, n := range order {
+		p, _ := lookupViewProfile(n)
+		ok, err := matchContext(p.Contexts, ct)
+		if err != nil {
+			return nil, nil, fmt.Errorf("views profile %q contexts: %w", n, err)
+		}
+		if !ok {
+			continue
+		}
+		for gvr, vs := range p.Views {
+			ok, err := matchContext(vs.Contexts, ct)
+			if err != nil {
+				return nil, nil, fmt.Errorf("views profile %q view %q contexts: %w", n, gvr, err)
+			}
+			if ok {
+				vv[gvr] = vs.Clone()
+			}
+		}
+	}
+
+	return vv, order, nil
+}
+
+// profileViews returns the views of a views file profile along with the
+// profile resolution order.
+func profileViews(in viewsFile, path, ct string) (map[string]ViewSetting, []string, error) {
+	if in.Profile == "" {
+		return nil, nil, nil
+	}
+	vv, order, err := resolveProfile(in.Profile, ct)
+	if err != nil {
+		return nil, nil, newLoadError(LoadValidateError, path, fmt.Errorf("views profile in %q: %w", path, err))
+	}
+	log.Debug().Msgf("Views profiles resolution order for %q: %s", path, strings.Join(order, " -> "))
+
+	return vv, order, nil
+}
+
+// loadViewProfile loads a views profile file.
+func loadViewProfile(path string) (ViewProfile, error) {
+	bb, err := os.ReadFile(path)
+	if err != nil {
+		return ViewProfile{}, newLoadError(LoadIOError, path, err)
+	}
+	if err := data.JSONValidator.Validate(json.ViewsSchema, bb); err != nil {
+		return ViewProfile{}, newLoadError(LoadValidateError, path, fmt.Errorf("validation failed for %q: %w", path, err))
+	}
+	var in viewsFile
+	if err := yaml.Unmarshal(bb, &in); err != nil {
+		return ViewProfile{}, newLoadError(LoadParseError, path, err)
+	}
+	if len(in.Includes) > 0 {
+		log.Warn().Msgf("Skipping views includes for views profile %q", path)
+	}
+	for gvr, vs := range in.Views {
+		if errs := vs.validate(); len(errs) > 0 {
+			return ViewProfile{}, newLoadError(LoadValidateError, path, fmt.Errorf("view %q in %q: %w", gvr, path, errs[0]))
+		}
+	}
+
+	return ViewProfile{Profile: in.Profile, Contexts: in.Contexts, Views: in.Views}, nil
+}
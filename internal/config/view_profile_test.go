@@ -0,0 +1,95 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCustomViewLoadProfile(t *testing.T) {
+	require.NoError(t, config.LoadViewProfiles("testdata/views/profiles"))
+
+	cfg := config.NewCustomView()
+	assert.NoError(t, cfg.Load("testdata/views/profile.yaml"))
+	assert.Equal(t, []string{"base", "team"}, cfg.ProfileOrder())
+	assert.Len(t, cfg.Views, 3)
+	assert.Equal(t, []string{"NAME", "STATUS"}, cfg.Views["v1/pods"].Columns)
+	assert.Equal(t, []string{"NAME", "PORTS"}, cfg.Views["v1/services"].Columns)
+	assert.Equal(t, []string{"NAME"}, cfg.Views["apps/v1/deployments"].Columns)
+
+	err := config.NewCustomView().Load("testdata/views/profile-bad.yaml")
+	var le *config.LoadError
+	assert.ErrorAs(t, err, &le)
+	assert.Equal(t, config.LoadValidateError, le.Kind)
+	assert.EqualError(t, err, `views profile in "testdata/views/profile-bad.yaml": unknown views profile "bozo"`)
+
+	ii := config.LintViews("testdata/views/profile-bad.yaml")
+	assert.Len(t, ii, 1)
+	assert.Equal(t, `unknown views profile "bozo"`, ii[0].Message)
+}
+
+func TestLoadViewProfilesPrunes(t *testing.T) {
+	config.RegisterViewProfile("p-builtin", config.ViewProfile{})
+	require.NoError(t, config.LoadViewProfiles("testdata/views/profiles"))
+	_, err := config.ViewProfileOrder("team")
+	require.NoError(t, err)
+
+	require.NoError(t, config.LoadViewProfiles("testdata/views/profiles-not-there"))
+	_, err = config.ViewProfileOrder("team")
+	assert.EqualError(t, err, `unknown views profile "team"`)
+	_, err = config.ViewProfileOrder("p-builtin")
+	assert.NoError(t, err)
+}
+
+func TestViewProfileOrder(t *testing.T) {
+	config.RegisterViewProfile("p-base", config.ViewProfile{})
+	config.RegisterViewProfile("p-team", config.ViewProfile{Profile: "p-base"})
+	config.RegisterViewProfile("p-orphan", config.ViewProfile{Profile: "p-nope"})
+	config.RegisterViewProfile("p-cycle-a", config.ViewProfile{Profile: "p-cycle-b"})
+	config.RegisterViewProfile("p-cycle-b", config.ViewProfile{Profile: "p-cycle-a"})
+
+	uu := map[string]struct {
+		name string
+		e    []string
+		err  string
+	}{
+		"base": {
+			name: "p-base",
+			e:    []string{"p-base"},
+		},
+		"layered": {
+			name: "p-team",
+			e:    []string{"p-base", "p-team"},
+		},
+		"unknown": {
+			name: "p-bozo",
+			err:  `unknown views profile "p-bozo"`,
+		},
+		"orphan": {
+			name: "p-orphan",
+			err:  `unknown views profile "p-nope" referenced by "p-orphan"`,
+		},
+		"cycle": {
+			name: "p-cycle-a",
+			err:  "views profile cycle detected: p-cycle-a -> p-cycle-b -> p-cycle-a",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			order, err := config.ViewProfileOrder(u.name)
+			if u.err != "" {
+				assert.EqualError(t, err, u.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, u.e, order)
+		})
+	}
+}
@@ -0,0 +1,174 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/derailed/k9s/internal/config/data"
+	"gopkg.in/yaml.v2"
+)
+
+// ViewStore represents a views configuration persistence backend ie a file,
+// a ConfigMap or a remote store.
+type ViewStore interface {
+	// Read returns the raw views configuration. Returns fs.ErrNotExist if
+	// the store holds no configuration.
+	Read() ([]byte, error)
+
+	// Write persists a raw views configuration.
+	Write([]byte) error
+}
+
+// ErrNoViewStore indicates no views store was specified.
+var ErrNoViewStore = errors.New("no views store specified")
+
+// FileViewStore represents a file backed views store.
+type FileViewStore struct {
+	path string
+}
+
+// NewFileViewStore returns a new file store.
+func NewFileViewStore(path string) *FileViewStore {
+	return &FileViewStore{path: filepath.Clean(path)}
+}
+
+// Path returns the store file path.
+func (s *FileViewStore) Path() string {
+	return s.path
+}
+
+// String returns the store location.
+func (s *FileViewStore) String() string {
+	return s.path
+}
+
+// Read returns the file content.
+func (s *FileViewStore) Read() ([]byte, error) {
+	return os.ReadFile(s.path)
+}
+
+// Write writes the file, creating its directory if needed.
+func (s *FileViewStore) Write(bb []byte) error {
+	if err := data.EnsureDirPath(s.path, data.DefaultDirMod); err != nil {
+		return err
+	}
+
+	return os.WriteFile(s.path, bb, data.DefaultFileMod)
+}
+
+// SetStore specifies the store views are loaded from and saved to.
+func (v *CustomView) SetStore(s ViewStore) {
+	v.mx.Lock()
+	defer v.mx.Unlock()
+
+	v.store = s
+}
+
+// Store returns the views store if any.
+func (v *CustomView) Store() ViewStore {
+	v.mx.RLock()
+	defer v.mx.RUnlock()
+
+	return v.store
+}
+
+// LoadStore loads view configurations from the views store.
+func (v *CustomView) LoadStore() error {
+	s := v.Store()
+	if s == nil {
+		return ErrNoViewStore
+	}
+
+	return v.loadFrom(s)
+}
+
+// SaveStore saves view configurations to the views store.
+func (v *CustomView) SaveStore() error {
+	s := v.Store()
+	if s == nil {
+		return ErrNoViewStore
+	}
+
+	return v.saveTo(s)
+}
+
+// loadFrom loads view configurations from a store. File stores resolve their
+// includes relative to the file. Other stores skip includes.
+func (v *CustomView) loadFrom(s ViewStore) error {
+	ct, strict := v.getContext(), v.isStrict()
+	var (
+		in  viewsFile
+		err error
+	)
+	if f, ok := s.(*FileViewStore); ok {
+		if _, err := os.Stat(f.path); errors.Is(err, fs.ErrNotExist) {
+			return nil
+		}
+		in, err = loadViews(f.path, ct, strict)
+	} else {
+		name := storeName(s)
+		bb, rerr := s.Read()
+		if errors.Is(rerr, fs.ErrNotExist) {
+			return nil
+		}
+		if rerr != nil {
+			return newLoadError(LoadIOError, name, fmt.Errorf("views read failed for %q: %w", name, rerr))
+		}
+		in, err = parseRemoteViews(bb, name, ct, strict)
+	}
+	if err != nil {
+		return err
+	}
+	if err := validateInherits(in.Views, storeName(s)); err != nil {
+		return err
+	}
+
+	v.mx.Lock()
+	v.Views, v.ReadOnly, v.profiles = in.Views, in.ReadOnly, in.profiles
+	v.track(in.authored())
+	v.mx.Unlock()
+
+	v.fireConfigChanged()
+
+	return nil
+}
+
+// saveTo saves view configurations to a store. Views pulled in from
+// includes, profiles or views directories are only saved if changed.
+// The loaded views file includes and profile are kept.
+func (v *CustomView) saveTo(s ViewStore) error {
+	v.mx.RLock()
+	if v.ReadOnly {
+		v.mx.RUnlock()
+		return ErrReadOnly
+	}
+	bb, err := yaml.Marshal(viewsFile{
+		Views:    v.ownViews(),
+		Contexts: v.Contexts,
+		Includes: v.own.Includes,
+		Profile:  v.own.Profile,
+	})
+	v.mx.RUnlock()
+	if err != nil {
+		return err
+	}
+
+	return s.Write(bb)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func storeName(s ViewStore) string {
+	if n, ok := s.(fmt.Stringer); ok {
+		return n.String()
+	}
+
+	return fmt.Sprintf("%T", s)
+}
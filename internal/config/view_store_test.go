@@ -0,0 +1,111 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config_test
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCustomViewStore(t *testing.T) {
+	uu := map[string]struct {
+		raw    string
+		err    error
+		e      []string
+		loadOK bool
+	}{
+		"plain": {
+			raw:    "views:\n  v1/pods:\n    columns:\n      - NAME\n      - AGE\n",
+			e:      []string{"NAME", "AGE"},
+			loadOK: true,
+		},
+		"includes": {
+			raw:    "includes:\n  - bozo.yaml\nviews:\n  v1/pods:\n    columns:\n      - NAME\n",
+			e:      []string{"NAME"},
+			loadOK: true,
+		},
+		"missing": {
+			err:    fs.ErrNotExist,
+			loadOK: true,
+		},
+		"toast": {
+			err: errors.New("boom"),
+		},
+		"invalid": {
+			raw: "views:\n  v1/pods:\n    columns: bozo\n",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			s := memStore{bb: []byte(u.raw), err: u.err}
+			cfg := config.NewCustomView()
+			cfg.SetStore(&s)
+			err := cfg.LoadStore()
+			if !u.loadOK {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, u.e, cfg.Views["v1/pods"].Columns)
+		})
+	}
+}
+
+func TestCustomViewSaveStore(t *testing.T) {
+	cfg := config.NewCustomView()
+	assert.ErrorIs(t, cfg.SaveStore(), config.ErrNoViewStore)
+	assert.ErrorIs(t, cfg.LoadStore(), config.ErrNoViewStore)
+
+	var s memStore
+	cfg.SetStore(&s)
+	cfg.Views["v1/pods"] = config.ViewSetting{Columns: []string{"NAME", "AGE"}}
+	require.NoError(t, cfg.SaveStore())
+	assert.Contains(t, string(s.bb), "columns:\n    - NAME\n    - AGE\n")
+
+	cfg.ReadOnly = true
+	assert.ErrorIs(t, cfg.SaveStore(), config.ErrReadOnly)
+}
+
+func TestFileViewStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "fred", "views.yaml")
+	s := config.NewFileViewStore(path)
+	assert.Equal(t, path, s.Path())
+
+	_, err := s.Read()
+	assert.ErrorIs(t, err, fs.ErrNotExist)
+	require.NoError(t, s.Write([]byte("views: {}\n")))
+	bb, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "views: {}\n", string(bb))
+
+	cfg := config.NewCustomView()
+	cfg.SetStore(config.NewFileViewStore("testdata/views/includes.yaml"))
+	require.NoError(t, cfg.LoadStore())
+	assert.Equal(t, "testdata/views/includes.yaml", cfg.Store().(*config.FileViewStore).Path())
+}
+
+// Helpers...
+
+type memStore struct {
+	bb  []byte
+	err error
+}
+
+func (s *memStore) Read() ([]byte, error) {
+	return s.bb, s.err
+}
+
+func (s *memStore) Write(bb []byte) error {
+	s.bb, s.err = bb, nil
+	return nil
+}
@@ -5,121 +5,2052 @@ package config
 
 import (
 	"cmp"
+	"context"
+	"crypto/sha256"
 	"errors"
 	"fmt"
+	"io"
 	"io/fs"
+	"maps"
+	"net/http"
 	"os"
+	"path/filepath"
+	"regexp"
 	"slices"
+	"strconv"
 	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
 
+	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/config/data"
 	"github.com/derailed/k9s/internal/config/json"
+	"github.com/rs/zerolog/log"
 
 	"gopkg.in/yaml.v2"
 )
 
+const (
+	// NoSortColumn disables sorting for a view.
+	NoSortColumn = "-"
+
+	// RelativeTime displays time columns as durations.
+	RelativeTime = "relative"
+
+	// AbsoluteTime displays time columns as RFC3339 timestamps.
+	AbsoluteTime = "absolute"
+
+	// PrinterColumns expands to a CRD server side printer columns.
+	PrinterColumns = "@printer"
+
+	// ConditionColumns expands to one column per observed status condition type.
+	ConditionColumns = "@conditions"
+
+	// DescribeEnterView opens a resource description on enter.
+	DescribeEnterView = "describe"
+
+	// YAMLEnterView opens a resource manifest on enter.
+	YAMLEnterView = "yaml"
+
+	// XrayEnterView opens a resource xray on enter.
+	XrayEnterView = "xray"
+
+	// DefaultEnterView opens the resource view own drill down ie pods containers.
+	DefaultEnterView = "default"
+
+	// LockedColumn suffixes a column name that is never auto hidden ie NAME!.
+	LockedColumn = "!"
+
+	// NumericColumn sorts a column as numbers.
+	NumericColumn = "numeric"
+
+	// DurationColumn sorts a column as durations ie 2d3h.
+	DurationColumn = "duration"
+
+	// IPColumn sorts a column as IP addresses.
+	IPColumn = "ip"
+
+	// SemverColumn sorts a column as semantic versions.
+	SemverColumn = "semver"
+
+	// StringColumn sorts a column lexically.
+	StringColumn = "string"
+
+	// CommaValues joins list valued cells with a comma and a space.
+	CommaValues = "comma"
+
+	// SpaceValues joins list valued cells with a space.
+	SpaceValues = "space"
+
+	// FirstValue renders list valued cells first value along with the remaining count ie a +2.
+	FirstValue = "first"
+
+	// CountValues renders list valued cells values count.
+	CountValues = "count"
+
+	// CompactDensity renders table columns without extra spacing.
+	CompactDensity = "compact"
+
+	// ComfortableDensity renders table columns with extra spacing.
+	ComfortableDensity = "comfortable"
+
+	// DefaultHighlightDuration represents how long changed cells are highlighted.
+	DefaultHighlightDuration = 3 * time.Second
+
+	viewsURLTimeout = 10 * time.Second
+	maxViewsSize    = 1 << 20
+)
+
+var (
+	// NormalizeUnits tracks the valid column normalization units and their scale.
+	NormalizeUnits = map[string]float64{
+		"m":   1e-3,
+		"B":   1,
+		"k":   1e3,
+		"M":   1e6,
+		"G":   1e9,
+		"T":   1e12,
+		"P":   1e15,
+		"E":   1e18,
+		"Ki":  1 << 10,
+		"Mi":  1 << 20,
+		"Gi":  1 << 30,
+		"Ti":  1 << 40,
+		"Pi":  1 << 50,
+		"Ei":  1 << 60,
+		"KiB": 1 << 10,
+		"MiB": 1 << 20,
+		"GiB": 1 << 30,
+		"TiB": 1 << 40,
+		"PiB": 1 << 50,
+		"EiB": 1 << 60,
+	}
+
+	// ErrNoSort indicates sorting is disabled for a view.
+	ErrNoSort = errors.New("sorting disabled")
+
+	// ErrReadOnly indicates views configurations can not be altered.
+	ErrReadOnly = errors.New("views are read only")
+
+	errInheritCycle = errors.New("inheritFrom cycle")
+)
+
+// LoadErrorKind categorizes views load failures.
+type LoadErrorKind int
+
+const (
+	// LoadIOError indicates a views source could not be read.
+	LoadIOError LoadErrorKind = iota
+
+	// LoadParseError indicates a views source could not be decoded.
+	LoadParseError
+
+	// LoadValidateError indicates a views source holds invalid settings.
+	LoadValidateError
+)
+
+// String returns a kind label.
+func (k LoadErrorKind) String() string {
+	switch k {
+	case LoadIOError:
+		return "read"
+	case LoadParseError:
+		return "parse"
+	case LoadValidateError:
+		return "validation"
+	default:
+		return "unknown"
+	}
+}
+
+// LoadError represents a views load failure.
+type LoadError struct {
+	Kind LoadErrorKind
+	Path string
+	Err  error
+}
+
+func newLoadError(k LoadErrorKind, path string, err error) *LoadError {
+	return &LoadError{Kind: k, Path: path, Err: err}
+}
+
+// Error returns the error message.
+func (e *LoadError) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap returns the underlying cause.
+func (e *LoadError) Unwrap() error {
+	return e.Err
+}
+
 // ViewConfigListener represents a view config listener.
 type ViewConfigListener interface {
 	// ViewSettingsChanged notifies listener the view configuration changed.
 	ViewSettingsChanged(ViewSetting)
 }
 
-// ViewSetting represents a view configuration.
-type ViewSetting struct {
-	Columns    []string `yaml:"columns"`
-	SortColumn string   `yaml:"sortColumn"`
+// ViewNamespacer represents a listener scoped to a namespace.
+type ViewNamespacer interface {
+	// ViewNamespace returns the listener active namespace.
+	ViewNamespace() string
+}
+
+// ViewSetting represents a view configuration.
+type ViewSetting struct {
+	Columns            []string               `yaml:"columns"`
+	SortColumn         string                 `yaml:"sortColumn,omitempty"`
+	Theme              string                 `yaml:"theme,omitempty"`
+	PageSize           int                    `yaml:"pageSize,omitempty"`
+	GroupBy            string                 `yaml:"groupBy,omitempty"`
+	GroupSum           []string               `yaml:"groupSum,omitempty"`
+	GroupBadges        []string               `yaml:"groupBadges,omitempty"`
+	GroupsCollapsed    bool                   `yaml:"groupsCollapsed,omitempty"`
+	RememberGroupState bool                   `yaml:"rememberGroupState,omitempty"`
+	Transform          map[string]string      `yaml:"transform,omitempty"`
+	MultiValue         map[string]string      `yaml:"multiValue,omitempty"`
+	Normalize          map[string]string      `yaml:"normalize,omitempty"`
+	GroupDigits        []string               `yaml:"groupDigits,omitempty"`
+	DigitSeparator     string                 `yaml:"digitSeparator,omitempty"`
+	Keys               map[string]string      `yaml:"keys,omitempty"`
+	Mask               []string               `yaml:"mask,omitempty"`
+	EmptyMessage       string                 `yaml:"emptyMessage,omitempty"`
+	DefaultContainer   string                 `yaml:"defaultContainer,omitempty"`
+	TimeFormat         string                 `yaml:"timeFormat,omitempty"`
+	DefaultFilter      string                 `yaml:"defaultFilter,omitempty"`
+	Presets            map[string]ViewSetting `yaml:"presets,omitempty"`
+	Active             string                 `yaml:"active,omitempty"`
+	Contexts           []string               `yaml:"contexts,omitempty"`
+	EnterAction        string                 `yaml:"enterAction,omitempty"`
+	EnterView          string                 `yaml:"enterView,omitempty"`
+	MuteStatuses       []string               `yaml:"muteStatuses,omitempty"`
+	YAMLOptions        YAMLOptions            `yaml:"yamlOptions,omitempty"`
+	ColumnTypes        map[string]string      `yaml:"columnTypes,omitempty"`
+	ExcludeNamespaces  []string               `yaml:"excludeNamespaces,omitempty"`
+	WideColumns        []string               `yaml:"wideColumns,omitempty"`
+	PauseOnSelect      bool                   `yaml:"pauseOnSelect,omitempty"`
+	SavedFilters       map[string]string      `yaml:"savedFilters,omitempty"`
+	ActiveFilter       string                 `yaml:"activeFilter,omitempty"`
+	ZebraStripes       bool                   `yaml:"zebraStripes,omitempty"`
+	Density            string                 `yaml:"density,omitempty"`
+	InheritFrom        string                 `yaml:"inheritFrom,omitempty"`
+	KeepScroll         bool                   `yaml:"keepScroll,omitempty"`
+	AutoHideEmpty      bool                   `yaml:"autoHideEmpty,omitempty"`
+	ConditionalColumns map[string]string      `yaml:"conditionalColumns,omitempty"`
+	KubectlOrder       bool                   `yaml:"kubectlOrder,omitempty"`
+	Links              []string               `yaml:"links,omitempty"`
+	Breakpoints        map[string]Breakpoint  `yaml:"breakpoints,omitempty"`
+	HighlightChanges   bool                   `yaml:"highlightChanges,omitempty"`
+	HighlightDuration  string                 `yaml:"highlightDuration,omitempty"`
+	StatusFrom         *StatusFrom            `yaml:"statusFrom,omitempty"`
+
+	// ScrollOffset tracks the last recorded horizontal scroll position when
+	// the view keeps scroll.
+	ScrollOffset int `yaml:"-"`
+
+	// KubectlColumns tracks the resource server side columns order when the
+	// view uses kubectl order.
+	KubectlColumns []string `yaml:"-"`
+}
+
+// HasPrinterColumns returns true if the view uses a CRD printer columns.
+func (v *ViewSetting) HasPrinterColumns() bool {
+	return v != nil && slices.Contains(v.Columns, PrinterColumns)
+}
+
+// ExpandPrinterColumns returns a view setting with the printer columns
+// placeholder replaced by the given columns. Duplicate columns are dropped.
+func (v *ViewSetting) ExpandPrinterColumns(cc []string) ViewSetting {
+	return v.expandColumns(PrinterColumns, cc)
+}
+
+// HasConditionColumns returns true if the view uses status conditions columns.
+func (v *ViewSetting) HasConditionColumns() bool {
+	return v != nil && slices.Contains(v.Columns, ConditionColumns)
+}
+
+// ExpandConditionColumns returns a view setting with the conditions columns
+// placeholder replaced by the given columns. Duplicate columns are dropped.
+func (v *ViewSetting) ExpandConditionColumns(cc []string) ViewSetting {
+	return v.expandColumns(ConditionColumns, cc)
+}
+
+func (v *ViewSetting) expandColumns(placeholder string, cc []string) ViewSetting {
+	out := v.Clone()
+	out.Columns = make([]string, 0, len(v.Columns)+len(cc))
+	for _, c := range v.Columns {
+		if c == placeholder {
+			for _, pc := range cc {
+				if !slices.Contains(out.Columns, pc) {
+					out.Columns = append(out.Columns, pc)
+				}
+			}
+			continue
+		}
+		if !slices.Contains(out.Columns, c) {
+			out.Columns = append(out.Columns, c)
+		}
+	}
+	if len(out.Columns) == 0 {
+		out.Columns = nil
+	}
+
+	return out
+}
+
+// ColumnType returns the sort type of a given column. Defaults to string.
+func (v *ViewSetting) ColumnType(col string) string {
+	if v == nil {
+		return StringColumn
+	}
+	if t, ok := v.ColumnTypes[col]; ok {
+		return t
+	}
+
+	return StringColumn
+}
+
+// validate returns the setting configuration errors if any.
+func (v *ViewSetting) validate() []error {
+	var errs []error
+	if _, err := v.JSONPathCols(); err != nil {
+		errs = append(errs, err)
+	}
+	nn := make([]string, 0, len(v.Presets))
+	for n := range v.Presets {
+		nn = append(nn, n)
+	}
+	slices.Sort(nn)
+	for _, n := range nn {
+		p := v.Presets[n]
+		if _, err := p.JSONPathCols(); err != nil {
+			errs = append(errs, fmt.Errorf("preset %q: %w", n, err))
+		}
+		for _, f := range p.FilterNames() {
+			if err := validateFilter(p.SavedFilters[f]); err != nil {
+				errs = append(errs, fmt.Errorf("preset %q: saved filter %q: %w", n, f, err))
+			}
+		}
+	}
+	if v.EnterAction != "" {
+		if _, _, err := v.EnterTarget(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if err := v.validateEnterView(); err != nil {
+		errs = append(errs, err)
+	}
+	if err := validateColumnTypes(v.ColumnTypes); err != nil {
+		errs = append(errs, err)
+	}
+	for _, p := range v.ExcludeNamespaces {
+		if _, err := filepath.Match(p, ""); err != nil {
+			errs = append(errs, fmt.Errorf("invalid namespace glob %q: %w", p, err))
+		}
+	}
+	for _, n := range v.FilterNames() {
+		if err := validateFilter(v.SavedFilters[n]); err != nil {
+			errs = append(errs, fmt.Errorf("saved filter %q: %w", n, err))
+		}
+	}
+	if _, ok := v.Preset().SavedFilters[v.ActiveFilter]; v.ActiveFilter != "" && !ok {
+		errs = append(errs, fmt.Errorf("unknown active filter %q", v.ActiveFilter))
+	}
+	switch v.Density {
+	case "", CompactDensity, ComfortableDensity:
+	default:
+		errs = append(errs, fmt.Errorf("invalid density %q. must be compact or comfortable", v.Density))
+	}
+	if err := v.validateGroupBy(); err != nil {
+		errs = append(errs, err)
+	}
+	if err := v.validateGroupBadges(); err != nil {
+		errs = append(errs, err)
+	}
+	if (v.GroupsCollapsed || v.RememberGroupState) && v.GroupBy == "" {
+		errs = append(errs, errors.New("groupsCollapsed and rememberGroupState require groupBy"))
+	}
+	if err := v.validateMultiValue(); err != nil {
+		errs = append(errs, err)
+	}
+	if err := v.validateNormalize(); err != nil {
+		errs = append(errs, err)
+	}
+	if err := v.validateGroupDigits(); err != nil {
+		errs = append(errs, err)
+	}
+	if err := v.validateKeys(); err != nil {
+		errs = append(errs, err)
+	}
+	if _, err := v.MaskSpecs(); err != nil {
+		errs = append(errs, err)
+	}
+	if err := v.validateConditionalColumns(); err != nil {
+		errs = append(errs, err)
+	}
+	if err := v.validateLinks(); err != nil {
+		errs = append(errs, err)
+	}
+	if err := v.validateBreakpoints(); err != nil {
+		errs = append(errs, err)
+	}
+	if _, err := v.HighlightFor(); err != nil {
+		errs = append(errs, err)
+	}
+	if v.StatusFrom != nil {
+		if err := v.StatusFrom.validate(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if v.MuteStatuses != nil && len(v.MuteStatuses) == 0 {
+		errs = append(errs, errors.New("muteStatuses must not be empty"))
+	}
+	if _, ok := v.Presets[v.Active]; v.Active != "" && !ok {
+		errs = append(errs, fmt.Errorf("unknown active preset %q", v.Active))
+	}
+
+	return errs
+}
+
+// validateGroupBy checks grouped and summed columns name view columns.
+func (v *ViewSetting) validateGroupBy() error {
+	if len(v.GroupSum) > 0 && v.GroupBy == "" {
+		return errors.New("groupSum requires groupBy")
+	}
+	if v.GroupBy == "" {
+		return nil
+	}
+	if err := v.validateViewCols("groupBy", []string{v.GroupBy}); err != nil {
+		return err
+	}
+
+	return v.validateViewCols("groupSum", v.GroupSum)
+}
+
+// validateGroupBadges checks group badges are grouped and name view columns.
+func (v *ViewSetting) validateGroupBadges() error {
+	if len(v.GroupBadges) == 0 {
+		return nil
+	}
+	if v.GroupBy == "" {
+		return errors.New("groupBadges requires groupBy")
+	}
+	if len(v.Columns) == 0 || v.HasPrinterColumns() {
+		return nil
+	}
+	cc := v.ColNames()
+	for _, b := range v.GroupBadges {
+		if !slices.Contains(cc, b) {
+			return fmt.Errorf("group badge column %q is not a view column", b)
+		}
+	}
+
+	return nil
+}
+
+// validateMultiValue checks multi values styles are known and name view columns.
+func (v *ViewSetting) validateMultiValue() error {
+	cc := make([]string, 0, len(v.MultiValue))
+	for c := range v.MultiValue {
+		cc = append(cc, c)
+	}
+	slices.Sort(cc)
+	for _, c := range cc {
+		switch s := v.MultiValue[c]; s {
+		case CommaValues, SpaceValues, FirstValue, CountValues:
+		default:
+			return fmt.Errorf("invalid multiValue style %q for column %q. must be one of comma, space, first or count", s, c)
+		}
+	}
+
+	return v.validateViewCols("multiValue", cc)
+}
+
+// validateNormalize checks normalization units are known and name view columns.
+func (v *ViewSetting) validateNormalize() error {
+	cc := make([]string, 0, len(v.Normalize))
+	for c := range v.Normalize {
+		cc = append(cc, c)
+	}
+	slices.Sort(cc)
+	for _, c := range cc {
+		if u := v.Normalize[c]; NormalizeUnits[u] == 0 {
+			return fmt.Errorf("invalid normalize unit %q for column %q", u, c)
+		}
+	}
+
+	return v.validateViewCols("normalize", cc)
+}
+
+// validateGroupDigits checks the digits separator is a single character and
+// grouped columns name view columns.
+func (v *ViewSetting) validateGroupDigits() error {
+	if v.DigitSeparator != "" && utf8.RuneCountInString(v.DigitSeparator) != 1 {
+		return fmt.Errorf("invalid digit separator %q. must be a single character", v.DigitSeparator)
+	}
+
+	return v.validateViewCols("groupDigits", v.GroupDigits)
+}
+
+// DigitSep returns the thousands separator for grouped columns. Defaults to a comma.
+func (v *ViewSetting) DigitSep() string {
+	if v == nil || v.DigitSeparator == "" {
+		return ","
+	}
+
+	return v.DigitSeparator
+}
+
+// validateEnterView checks the enter view is a known view kind.
+func (v *ViewSetting) validateEnterView() error {
+	switch v.EnterView {
+	case "", DescribeEnterView, YAMLEnterView, XrayEnterView, DefaultEnterView:
+	default:
+		return fmt.Errorf("invalid enter view %q. must be one of describe, yaml, xray or default", v.EnterView)
+	}
+	if v.EnterView != "" && v.EnterAction != "" {
+		return errors.New("enterView and enterAction are mutually exclusive")
+	}
+
+	return nil
+}
+
+// validateKeys checks view keys map to named actions or plugins.
+func (v *ViewSetting) validateKeys() error {
+	kk := make([]string, 0, len(v.Keys))
+	for k := range v.Keys {
+		kk = append(kk, k)
+	}
+	slices.Sort(kk)
+	for _, k := range kk {
+		if k == "" {
+			return errors.New("keys must not be blank")
+		}
+		if strings.TrimSpace(v.Keys[k]) == "" {
+			return fmt.Errorf("key %q must map to an action or plugin", k)
+		}
+	}
+
+	return nil
+}
+
+// MaskSpecs returns masked columns and the number of trailing characters
+// left visible. Specs read COL or COL:N.
+func (v *ViewSetting) MaskSpecs() (map[string]int, error) {
+	if v == nil || len(v.Mask) == 0 {
+		return nil, nil
+	}
+	mm := make(map[string]int, len(v.Mask))
+	cc := make([]string, 0, len(v.Mask))
+	for _, spec := range v.Mask {
+		col, n, ok := strings.Cut(spec, ":")
+		col = strings.TrimSpace(col)
+		if col == "" {
+			return nil, fmt.Errorf("invalid mask %q. must be COL or COL:N", spec)
+		}
+		var reveal int
+		if ok {
+			i, err := strconv.Atoi(strings.TrimSpace(n))
+			if err != nil || i < 0 {
+				return nil, fmt.Errorf("invalid mask %q. reveal count must be a non-negative integer", spec)
+			}
+			reveal = i
+		}
+		if _, dup := mm[col]; dup {
+			return nil, fmt.Errorf("mask column %q listed more than once", col)
+		}
+		mm[col] = reveal
+		cc = append(cc, col)
+	}
+
+	return mm, v.validateViewCols("mask", cc)
+}
+
+// validateConditionalColumns checks conditional columns predicates parse as
+// row filters and name view columns.
+func (v *ViewSetting) validateConditionalColumns() error {
+	cc := make([]string, 0, len(v.ConditionalColumns))
+	for c := range v.ConditionalColumns {
+		cc = append(cc, c)
+	}
+	slices.Sort(cc)
+	for _, c := range cc {
+		q := v.ConditionalColumns[c]
+		if internal.IsLabelSelector(q) {
+			return fmt.Errorf("conditional column %q predicate %q must not be a label selector", c, q)
+		}
+		if err := validateFilter(q); err != nil {
+			return fmt.Errorf("conditional column %q: %w", c, err)
+		}
+	}
+
+	return v.validateViewCols("conditional", cc)
+}
+
+// validateLinks checks link columns are listed once and name view columns.
+func (v *ViewSetting) validateLinks() error {
+	for i, c := range v.Links {
+		if slices.Contains(v.Links[:i], c) {
+			return fmt.Errorf("link column %q listed more than once", c)
+		}
+	}
+
+	return v.validateViewCols("link", v.Links)
+}
+
+// validateViewCols checks columns name view columns when the view columns are known.
+func (v *ViewSetting) validateViewCols(key string, cc []string) error {
+	if len(v.Columns) == 0 || v.HasPrinterColumns() || v.HasConditionColumns() {
+		return nil
+	}
+	names := append(v.ColNames(), v.WideColumns...)
+	for _, b := range v.Breakpoints {
+		names = append(names, (&ViewSetting{Columns: b.Columns}).ColNames()...)
+	}
+	for _, c := range cc {
+		if !slices.Contains(names, c) {
+			return fmt.Errorf("%s column %q is not a view column", key, c)
+		}
+	}
+
+	return nil
+}
+
+func validateColumnTypes(tt map[string]string) error {
+	for col, t := range tt {
+		switch t {
+		case NumericColumn, DurationColumn, IPColumn, SemverColumn, StringColumn:
+		default:
+			return fmt.Errorf("invalid column type %q for column %q", t, col)
+		}
+	}
+
+	return nil
+}
+
+// validateFilter checks a filter expression parses. Filters are either label
+// selectors, fuzzy, numeric comparisons or regex expressions.
+func validateFilter(q string) error {
+	if q == "" {
+		return errors.New("filter must not be empty")
+	}
+	if internal.IsLabelSelector(q) {
+		return nil
+	}
+	if _, ok := internal.IsFuzzySelector(q); ok {
+		return nil
+	}
+	if _, _, _, ok := internal.IsCompareSelector(q); ok {
+		return nil
+	}
+	if internal.IsInverseSelector(q) {
+		q = q[1:]
+	}
+	if _, err := regexp.Compile(q); err != nil {
+		return fmt.Errorf("invalid rx filter %q: %w", q, err)
+	}
+
+	return nil
+}
+
+// YAMLOptions represents a view resources YAML rendering options.
+type YAMLOptions struct {
+	StripManagedFields bool `yaml:"stripManagedFields,omitempty"`
+	OmitStatus         bool `yaml:"omitStatus,omitempty"`
+}
+
+// IsMuted returns true if the given status should not be colorized.
+func (v *ViewSetting) IsMuted(status string) bool {
+	if v == nil {
+		return false
+	}
+
+	return slices.Contains(v.MuteStatuses, status)
+}
+
+// EnterTarget returns the gvr and the column holding the resource name to
+// navigate to on enter. Specs are of the form gvr:col-name.
+func (v *ViewSetting) EnterTarget() (string, string, error) {
+	if v == nil || v.EnterAction == "" {
+		return "", "", errors.New("no enter action specified")
+	}
+	idx := strings.LastIndex(v.EnterAction, ":")
+	if idx <= 0 || idx == len(v.EnterAction)-1 {
+		return "", "", fmt.Errorf("invalid enter action %q. must be gvr:col-name", v.EnterAction)
+	}
+
+	return v.EnterAction[:idx], v.EnterAction[idx+1:], nil
+}
+
+// Clone returns a deep copy of the view setting.
+func (v *ViewSetting) Clone() ViewSetting {
+	out := *v
+	out.Columns = slices.Clone(v.Columns)
+	out.GroupSum = slices.Clone(v.GroupSum)
+	out.GroupBadges = slices.Clone(v.GroupBadges)
+	out.Contexts = slices.Clone(v.Contexts)
+	out.MuteStatuses = slices.Clone(v.MuteStatuses)
+	out.ExcludeNamespaces = slices.Clone(v.ExcludeNamespaces)
+	out.WideColumns = slices.Clone(v.WideColumns)
+	out.Mask = slices.Clone(v.Mask)
+	out.Links = slices.Clone(v.Links)
+	out.GroupDigits = slices.Clone(v.GroupDigits)
+	out.KubectlColumns = slices.Clone(v.KubectlColumns)
+	out.Transform = maps.Clone(v.Transform)
+	out.MultiValue = maps.Clone(v.MultiValue)
+	out.Normalize = maps.Clone(v.Normalize)
+	out.Keys = maps.Clone(v.Keys)
+	out.ColumnTypes = maps.Clone(v.ColumnTypes)
+	out.SavedFilters = maps.Clone(v.SavedFilters)
+	out.ConditionalColumns = maps.Clone(v.ConditionalColumns)
+	out.StatusFrom = v.StatusFrom.Clone()
+	if v.Breakpoints != nil {
+		out.Breakpoints = make(map[string]Breakpoint, len(v.Breakpoints))
+		for e, b := range v.Breakpoints {
+			out.Breakpoints[e] = Breakpoint{Columns: slices.Clone(b.Columns)}
+		}
+	}
+	if v.Presets != nil {
+		out.Presets = make(map[string]ViewSetting, len(v.Presets))
+		for k, p := range v.Presets {
+			out.Presets[k] = p.Clone()
+		}
+	}
+
+	return out
+}
+
+// Hash returns a stable fingerprint of the view setting. Columns order matters.
+func (v *ViewSetting) Hash() string {
+	bb, err := yaml.Marshal(v)
+	if err != nil {
+		log.Error().Err(err).Msg("View setting hash failed")
+		return ""
+	}
+
+	return fmt.Sprintf("%x", sha256.Sum256(bb))
+}
+
+// PresetNames returns the sorted view presets names.
+func (v *ViewSetting) PresetNames() []string {
+	if v == nil {
+		return nil
+	}
+	nn := make([]string, 0, len(v.Presets))
+	for n := range v.Presets {
+		nn = append(nn, n)
+	}
+	slices.Sort(nn)
+
+	return nn
+}
+
+// FilterNames returns the sorted saved filters names.
+func (v *ViewSetting) FilterNames() []string {
+	if v == nil {
+		return nil
+	}
+	nn := make([]string, 0, len(v.SavedFilters))
+	for n := range v.SavedFilters {
+		nn = append(nn, n)
+	}
+	slices.Sort(nn)
+
+	return nn
+}
+
+// ActiveFilterText returns the active saved filter expression if any.
+func (v *ViewSetting) ActiveFilterText() string {
+	if v == nil || v.ActiveFilter == "" {
+		return ""
+	}
+
+	return v.SavedFilters[v.ActiveFilter]
+}
+
+// Preset returns the view setting resulting from applying the active preset.
+// Preset settings override the base view settings.
+func (v *ViewSetting) Preset() ViewSetting {
+	out := *v
+	p, ok := v.Presets[v.Active]
+	if !ok {
+		return out
+	}
+	if len(p.Columns) > 0 {
+		out.Columns = p.Columns
+	}
+	if p.SortColumn != "" {
+		out.SortColumn = p.SortColumn
+	}
+	if p.Theme != "" {
+		out.Theme = p.Theme
+	}
+	if p.PageSize > 0 {
+		out.PageSize = p.PageSize
+	}
+	if p.GroupBy != "" {
+		out.GroupBy, out.GroupSum, out.GroupBadges = p.GroupBy, p.GroupSum, p.GroupBadges
+		out.GroupsCollapsed, out.RememberGroupState = p.GroupsCollapsed, p.RememberGroupState
+	}
+	if len(p.Transform) > 0 {
+		out.Transform = p.Transform
+	}
+	if len(p.MultiValue) > 0 {
+		out.MultiValue = p.MultiValue
+	}
+	if len(p.Normalize) > 0 {
+		out.Normalize = p.Normalize
+	}
+	if len(p.Breakpoints) > 0 {
+		out.Breakpoints = p.Breakpoints
+	}
+	if len(p.GroupDigits) > 0 {
+		out.GroupDigits = p.GroupDigits
+	}
+	if p.DigitSeparator != "" {
+		out.DigitSeparator = p.DigitSeparator
+	}
+	if len(p.Keys) > 0 {
+		out.Keys = p.Keys
+	}
+	if len(p.Mask) > 0 {
+		out.Mask = p.Mask
+	}
+	if p.DefaultContainer != "" {
+		out.DefaultContainer = p.DefaultContainer
+	}
+	if p.TimeFormat != "" {
+		out.TimeFormat = p.TimeFormat
+	}
+	if p.DefaultFilter != "" {
+		out.DefaultFilter = p.DefaultFilter
+	}
+	if p.EnterAction != "" {
+		out.EnterAction, out.EnterView = p.EnterAction, ""
+	}
+	if p.EnterView != "" {
+		out.EnterView, out.EnterAction = p.EnterView, ""
+	}
+	if len(p.MuteStatuses) > 0 {
+		out.MuteStatuses = p.MuteStatuses
+	}
+	if p.YAMLOptions != (YAMLOptions{}) {
+		out.YAMLOptions = p.YAMLOptions
+	}
+	if len(p.ColumnTypes) > 0 {
+		out.ColumnTypes = p.ColumnTypes
+	}
+	if len(p.ExcludeNamespaces) > 0 {
+		out.ExcludeNamespaces = p.ExcludeNamespaces
+	}
+	if len(p.WideColumns) > 0 {
+		out.WideColumns = p.WideColumns
+	}
+	if p.PauseOnSelect {
+		out.PauseOnSelect = true
+	}
+	if len(p.SavedFilters) > 0 {
+		out.SavedFilters = p.SavedFilters
+	}
+	if p.ZebraStripes {
+		out.ZebraStripes = true
+	}
+	if p.Density != "" {
+		out.Density = p.Density
+	}
+	if p.KeepScroll {
+		out.KeepScroll = true
+	}
+	if p.AutoHideEmpty {
+		out.AutoHideEmpty = true
+	}
+	if len(p.ConditionalColumns) > 0 {
+		out.ConditionalColumns = p.ConditionalColumns
+	}
+	if p.KubectlOrder {
+		out.KubectlOrder = true
+	}
+	if len(p.Links) > 0 {
+		out.Links = p.Links
+	}
+	if p.HighlightChanges {
+		out.HighlightChanges = true
+	}
+	if p.HighlightDuration != "" {
+		out.HighlightDuration = p.HighlightDuration
+	}
+	if p.EmptyMessage != "" {
+		out.EmptyMessage = p.EmptyMessage
+	}
+	if p.StatusFrom != nil {
+		out.StatusFrom = p.StatusFrom
+	}
+
+	return out
+}
+
+// ColNames returns the view column names.
+func (v *ViewSetting) ColNames() []string {
+	cc := make([]string, 0, len(v.Columns))
+	for _, c := range v.Columns {
+		if IsComputedCol(c) {
+			if jc, err := ParseComputedCol(c); err == nil {
+				c = jc.Name
+			}
+		}
+		cc = append(cc, strings.TrimSuffix(c, LockedColumn))
+	}
+
+	return cc
+}
+
+// LockedCols returns the names of columns exempt from auto hiding.
+func (v *ViewSetting) LockedCols() []string {
+	if v == nil {
+		return nil
+	}
+	var (
+		cc    []string
+		names = v.ColNames()
+	)
+	for i, c := range v.Columns {
+		if strings.HasSuffix(c, LockedColumn) {
+			cc = append(cc, names[i])
+		}
+	}
+
+	return cc
+}
+
+// UsesKubectlOrder returns true if the view columns follow a resolved kubectl
+// columns order.
+func (v *ViewSetting) UsesKubectlOrder() bool {
+	return v != nil && v.KubectlOrder && len(v.KubectlColumns) > 0
+}
+
+// KubectlOrderCols reorders columns to match the kubectl columns order.
+// Columns known to kubectl are rearranged amongst the slots they occupy, other
+// columns keep their position.
+func (v *ViewSetting) KubectlOrderCols(cols []string) []string {
+	if !v.UsesKubectlOrder() {
+		return cols
+	}
+	var known []string
+	for _, c := range v.KubectlColumns {
+		if slices.Contains(cols, c) && !slices.Contains(known, c) {
+			known = append(known, c)
+		}
+	}
+	out, next := make([]string, 0, len(cols)), known
+	for _, c := range cols {
+		if len(next) > 0 && slices.Contains(known, c) {
+			c, next = next[0], next[1:]
+		}
+		out = append(out, c)
+	}
+
+	return out
+}
+
+// JSONPathCols returns the view columns computed from the raw resources ie
+// jsonpath and label columns.
+func (v *ViewSetting) JSONPathCols() ([]JSONPathCol, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var cc []JSONPathCol
+	for _, c := range v.Columns {
+		if !IsComputedCol(c) {
+			continue
+		}
+		jc, err := ParseComputedCol(c)
+		if err != nil {
+			return nil, err
+		}
+		cc = append(cc, jc)
+	}
+
+	return cc, nil
+}
+
+func (v *ViewSetting) HasCols() bool {
+	return len(v.Columns) > 0
+}
+
+func (v *ViewSetting) IsBlank() bool {
+	return v == nil || len(v.Columns) == 0
+}
+
+// SortSpec represents a sort column specification.
+type SortSpec struct {
+	Name string
+	Desc bool
+}
+
+// SortCol returns the primary sort column and order.
+func (v *ViewSetting) SortCol() (string, bool, error) {
+	specs, err := v.SortCols()
+	if err != nil {
+		return "", false, err
+	}
+
+	return specs[0].Name, specs[0].Desc, nil
+}
+
+// SortCols returns the ordered sort columns. Subsequent columns break ties.
+// Specs are of the form col-name:asc|desc[,col-name:asc|desc...].
+// Returns ErrNoSort if sorting is disabled.
+func (v *ViewSetting) SortCols() ([]SortSpec, error) {
+	if v == nil || v.SortColumn == "" {
+		return nil, fmt.Errorf("no sort column specified")
+	}
+	if v.SortColumn == NoSortColumn {
+		return nil, ErrNoSort
+	}
+	segs := strings.Split(v.SortColumn, ",")
+	specs := make([]SortSpec, 0, len(segs))
+	for i, seg := range segs {
+		tt := strings.Split(strings.TrimSpace(seg), ":")
+		if len(tt) != 2 || tt[0] == "" || (tt[1] != "asc" && tt[1] != "desc") {
+			return nil, fmt.Errorf("invalid sort column spec #%d: %q. must be col-name:asc|desc", i, seg)
+		}
+		specs = append(specs, SortSpec{Name: tt[0], Desc: tt[1] == "desc"})
+	}
+
+	return specs, nil
+}
+
+// TimeLayout returns the layout for time columns or blank for relative times.
+func (v *ViewSetting) TimeLayout() (string, error) {
+	if v == nil {
+		return "", nil
+	}
+	switch v.TimeFormat {
+	case "", RelativeTime:
+		return "", nil
+	case AbsoluteTime:
+		return time.RFC3339, nil
+	}
+	s := time.Date(2021, 3, 4, 5, 6, 7, 0, time.UTC).Format(v.TimeFormat)
+	if s == v.TimeFormat {
+		return "", fmt.Errorf("invalid time layout %q", v.TimeFormat)
+	}
+	if _, err := time.Parse(v.TimeFormat, s); err != nil {
+		return "", fmt.Errorf("invalid time layout %q: %w", v.TimeFormat, err)
+	}
+
+	return v.TimeFormat, nil
+}
+
+// HighlightFor returns how long changed cells are highlighted or zero if
+// highlighting is off.
+func (v *ViewSetting) HighlightFor() (time.Duration, error) {
+	if v == nil || !v.HighlightChanges {
+		return 0, nil
+	}
+	if v.HighlightDuration == "" {
+		return DefaultHighlightDuration, nil
+	}
+	d, err := time.ParseDuration(v.HighlightDuration)
+	if err != nil {
+		return 0, fmt.Errorf("invalid highlight duration %q: %w", v.HighlightDuration, err)
+	}
+	if d <= 0 {
+		return 0, fmt.Errorf("invalid highlight duration %q. must be positive", v.HighlightDuration)
+	}
+
+	return d, nil
+}
+
+func (v *ViewSetting) Equals(vs *ViewSetting) bool {
+	if v == nil || vs == nil {
+		return v == nil && vs == nil
+	}
+	if c := slices.Compare(v.Columns, vs.Columns); c != 0 {
+		return false
+	}
+	if c := cmp.Compare(v.SortColumn, vs.SortColumn); c != 0 {
+		return false
+	}
+	if c := slices.Compare(v.GroupSum, vs.GroupSum); c != 0 {
+		return false
+	}
+	if c := slices.Compare(v.GroupBadges, vs.GroupBadges); c != 0 {
+		return false
+	}
+	if !maps.Equal(v.Transform, vs.Transform) {
+		return false
+	}
+	if !maps.Equal(v.MultiValue, vs.MultiValue) {
+		return false
+	}
+	if !maps.Equal(v.Normalize, vs.Normalize) {
+		return false
+	}
+	if c := slices.Compare(v.GroupDigits, vs.GroupDigits); c != 0 {
+		return false
+	}
+	if v.DigitSeparator != vs.DigitSeparator {
+		return false
+	}
+	if !maps.Equal(v.ConditionalColumns, vs.ConditionalColumns) {
+		return false
+	}
+	if !maps.EqualFunc(v.Breakpoints, vs.Breakpoints, func(a, b Breakpoint) bool {
+		return slices.Equal(a.Columns, b.Columns)
+	}) {
+		return false
+	}
+	if !maps.Equal(v.Keys, vs.Keys) {
+		return false
+	}
+	if c := slices.Compare(v.Mask, vs.Mask); c != 0 {
+		return false
+	}
+	if c := slices.Compare(v.Links, vs.Links); c != 0 {
+		return false
+	}
+	if !maps.Equal(v.ColumnTypes, vs.ColumnTypes) {
+		return false
+	}
+	if !maps.Equal(v.SavedFilters, vs.SavedFilters) {
+		return false
+	}
+	if c := slices.Compare(v.MuteStatuses, vs.MuteStatuses); c != 0 {
+		return false
+	}
+	if c := slices.Compare(v.ExcludeNamespaces, vs.ExcludeNamespaces); c != 0 {
+		return false
+	}
+	if c := slices.Compare(v.WideColumns, vs.WideColumns); c != 0 {
+		return false
+	}
+	if c := slices.Compare(v.KubectlColumns, vs.KubectlColumns); c != 0 {
+		return false
+	}
+	return v.Theme == vs.Theme &&
+		v.PageSize == vs.PageSize &&
+		v.GroupBy == vs.GroupBy &&
+		v.GroupsCollapsed == vs.GroupsCollapsed &&
+		v.RememberGroupState == vs.RememberGroupState &&
+		v.DefaultContainer == vs.DefaultContainer &&
+		v.TimeFormat == vs.TimeFormat &&
+		v.DefaultFilter == vs.DefaultFilter &&
+		v.EnterAction == vs.EnterAction &&
+		v.EnterView == vs.EnterView &&
+		v.YAMLOptions == vs.YAMLOptions &&
+		v.PauseOnSelect == vs.PauseOnSelect &&
+		v.ActiveFilter == vs.ActiveFilter &&
+		v.ZebraStripes == vs.ZebraStripes &&
+		v.Density == vs.Density &&
+		v.InheritFrom == vs.InheritFrom &&
+		v.KeepScroll == vs.KeepScroll &&
+		v.AutoHideEmpty == vs.AutoHideEmpty &&
+		v.KubectlOrder == vs.KubectlOrder &&
+		v.HighlightChanges == vs.HighlightChanges &&
+		v.HighlightDuration == vs.HighlightDuration &&
+		v.EmptyMessage == vs.EmptyMessage &&
+		v.StatusFrom.Equals(vs.StatusFrom) &&
+		v.Active == vs.Active
+}
+
+// CustomView represents a collection of view customization.
+type CustomView struct {
+	Views    map[string]ViewSetting `yaml:"views"`
+	ReadOnly bool                   `yaml:"readOnly,omitempty"`
+	Contexts []string               `yaml:"contexts,omitempty"`
+
+	// LenientValidation logs schema violations and proceeds loading. Otherwise
+	// configurations failing schema validation are rejected, keeping the
+	// current ones.
+	LenientValidation bool `yaml:"-"`
+
+	// NotifyDelay coalesces listeners notifications fired within the delay into
+	// a single asynchronous refresh. Zero notifies listeners synchronously.
+	NotifyDelay time.Duration `yaml:"-"`
+
+	// Dispatch runs coalesced notifications ie on the UI event loop. Unset,
+	// listeners are notified from the notifier goroutine.
+	Dispatch func(func()) `yaml:"-"`
+
+	// ScrollSaveFn persists views horizontal scroll positions when set.
+	ScrollSaveFn func(gvr, ns string, offset int) `yaml:"-"`
+
+	context   string
+	profiles  []string
+	store     ViewStore
+	url, etag string
+	listeners map[string]ViewConfigListener
+	offsets   map[string]int
+	mx        sync.RWMutex
+
+	notifying, pending bool
+	notifyMx, fireMx   sync.Mutex
+
+	reloadSig  chan os.Signal
+	reloadDone chan struct{}
+
+	// file tracks the last views file successfully loaded by Refresh.
+	file viewsFile
+
+	// own tracks the loaded views file as authored and loaded the views
+	// fingerprints as loaded. Saves only persist the file own views along
+	// with the views changed since.
+	own    viewsFile
+	loaded map[string]string
+}
+
+// viewsFile represents a views configuration file.
+type viewsFile struct {
+	Views    map[string]ViewSetting `yaml:"views"`
+	ReadOnly bool                   `yaml:"readOnly,omitempty"`
+	Contexts []string               `yaml:"contexts,omitempty"`
+	Includes []string               `yaml:"includes,omitempty"`
+	Profile  string                 `yaml:"profile,omitempty"`
+
+	profiles []string
+	own      map[string]ViewSetting
+}
+
+// authored returns the views file own settings sans its includes and profile
+// views.
+func (f viewsFile) authored() viewsFile {
+	return viewsFile{Views: f.own, Includes: f.Includes, Profile: f.Profile}
+}
+
+// NewCustomView returns a views configuration.
+func NewCustomView() *CustomView {
+	return &CustomView{
+		Views:     make(map[string]ViewSetting),
+		listeners: make(map[string]ViewConfigListener),
+	}
+}
+
+// SetContext sets the context name used to gate configurations on load.
+func (v *CustomView) SetContext(name string) {
+	v.mx.Lock()
+	defer v.mx.Unlock()
+
+	v.context = name
+}
+
+// Reset clears out configurations.
+func (v *CustomView) Reset() {
+	v.mx.Lock()
+	defer v.mx.Unlock()
+
+	for k := range v.Views {
+		delete(v.Views, k)
+	}
+}
+
+// ResetGVR clears out all configurations of the given gvr including its
+// namespaced ones ie gvr@ns. Returns the number of configurations removed.
+func (v *CustomView) ResetGVR(gvr string) (int, error) {
+	v.mx.Lock()
+	if v.ReadOnly {
+		v.mx.Unlock()
+		return 0, ErrReadOnly
+	}
+	var count int
+	for k := range v.Views {
+		if k == gvr || strings.HasPrefix(k, gvr+"@") {
+			delete(v.Views, k)
+			count++
+		}
+	}
+	v.mx.Unlock()
+
+	if count > 0 {
+		v.fireConfigChanged()
+	}
+
+	return count, nil
+}
+
+// SetSort updates the sort column spec of the view matching a gvr in a given namespace.
+func (v *CustomView) SetSort(gvr, ns, spec string) error {
+	if spec != NoSortColumn {
+		if _, err := (&ViewSetting{SortColumn: spec}).SortCols(); err != nil {
+			return err
+		}
+	}
+
+	v.mx.Lock()
+	if v.ReadOnly {
+		v.mx.Unlock()
+		return ErrReadOnly
+	}
+	key, vs, ok := v.lookup(gvr, ns)
+	if !ok {
+		key = gvr
+	}
+	vs = vs.Clone()
+	if p, ok := vs.Presets[vs.Active]; ok {
+		p.SortColumn = spec
+		vs.Presets[vs.Active] = p
+	} else {
+		vs.SortColumn = spec
+	}
+	if v.Views == nil {
+		v.Views = make(map[string]ViewSetting)
+	}
+	v.Views[key] = vs
+	v.mx.Unlock()
+
+	v.fireConfigChanged()
+
+	return nil
+}
+
+// NextPreset activates the next preset of the view matching a gvr in a given namespace.
+// Presets are rotated in lexical order. Returns the newly active preset name.
+func (v *CustomView) NextPreset(gvr, ns string) (string, error) {
+	v.mx.Lock()
+	if v.ReadOnly {
+		v.mx.Unlock()
+		return "", ErrReadOnly
+	}
+	key, vs, ok := v.lookup(gvr, ns)
+	nn := vs.PresetNames()
+	if !ok || len(nn) == 0 {
+		v.mx.Unlock()
+		return "", fmt.Errorf("no view presets defined for %q", gvr)
+	}
+	vs.Active = nn[(slices.Index(nn, vs.Active)+1)%len(nn)]
+	v.Views[key] = vs
+	v.mx.Unlock()
+
+	v.fireConfigChanged()
+
+	return vs.Active, nil
 }
 
-func (v *ViewSetting) HasCols() bool {
-	return len(v.Columns) > 0
+// ApplyFilter activates the named saved filter of the view matching a gvr in a
+// given namespace.
+func (v *CustomView) ApplyFilter(gvr, ns, name string) error {
+	v.mx.Lock()
+	if v.ReadOnly {
+		v.mx.Unlock()
+		return ErrReadOnly
+	}
+	key, vs, ok := v.lookup(gvr, ns)
+	if !ok {
+		v.mx.Unlock()
+		return fmt.Errorf("no saved filters defined for %q", gvr)
+	}
+	if _, ok := vs.Preset().SavedFilters[name]; !ok {
+		v.mx.Unlock()
+		return fmt.Errorf("unknown saved filter %q for %q", name, gvr)
+	}
+	vs.ActiveFilter = name
+	v.Views[key] = vs
+	v.mx.Unlock()
+
+	v.fireConfigChanged()
+
+	return nil
 }
 
-func (v *ViewSetting) IsBlank() bool {
-	return v == nil || len(v.Columns) == 0
+// NextFilter activates the next saved filter of the view matching a gvr in a
+// given namespace. Filters are rotated in lexical order. Returns the newly
+// active filter name.
+func (v *CustomView) NextFilter(gvr, ns string) (string, error) {
+	v.mx.Lock()
+	if v.ReadOnly {
+		v.mx.Unlock()
+		return "", ErrReadOnly
+	}
+	key, vs, ok := v.lookup(gvr, ns)
+	pvs := vs.Preset()
+	nn := pvs.FilterNames()
+	if !ok || len(nn) == 0 {
+		v.mx.Unlock()
+		return "", fmt.Errorf("no saved filters defined for %q", gvr)
+	}
+	vs.ActiveFilter = nn[(slices.Index(nn, vs.ActiveFilter)+1)%len(nn)]
+	v.Views[key] = vs
+	v.mx.Unlock()
+
+	v.fireConfigChanged()
+
+	return vs.ActiveFilter, nil
 }
 
-func (v *ViewSetting) SortCol() (string, bool, error) {
-	if v == nil || v.SortColumn == "" {
-		return "", false, fmt.Errorf("no sort column specified")
+// SetScrollOffset records the horizontal scroll position of a gvr in a given
+// namespace.
+func (v *CustomView) SetScrollOffset(gvr, ns string, offset int) {
+	v.mx.Lock()
+	if v.offsets == nil {
+		v.offsets = make(map[string]int)
 	}
-	tt := strings.Split(v.SortColumn, ":")
-	if len(tt) < 2 {
-		return "", false, fmt.Errorf("invalid sort column spec: %q. must be col-name:asc|desc", v.SortColumn)
+	v.offsets[scrollKey(gvr, ns)] = offset
+	fn := v.ScrollSaveFn
+	v.mx.Unlock()
+
+	if fn != nil {
+		fn(gvr, ns, offset)
 	}
+}
 
-	return tt[0], tt[1] == "desc", nil
+// Save saves view configurations to disk.
+func (v *CustomView) Save(path string) error {
+	return v.saveTo(NewFileViewStore(path))
 }
 
-func (v *ViewSetting) Equals(vs *ViewSetting) bool {
-	if v == nil || vs == nil {
-		return v == nil && vs == nil
+// Load loads view configurations from disk.
+func (v *CustomView) Load(path string) error {
+	return v.loadFrom(NewFileViewStore(path))
+}
+
+// ProfileOrder returns the views profiles resolution order of the last loaded
+// views file, base profiles first.
+func (v *CustomView) ProfileOrder() []string {
+	v.mx.RLock()
+	defer v.mx.RUnlock()
+
+	return slices.Clone(v.profiles)
+}
+
+// LoadURL loads view configurations from a remote http(s) location.
+// Unchanged configurations are skipped using etags. On failure the current
+// configurations are kept. Once loaded, the remote views stand in for the
+// views file on Refresh.
+func (v *CustomView) LoadURL(ctx context.Context, url string) error {
+	ctx, cancel := context.WithTimeout(ctx, viewsURLTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return newLoadError(LoadIOError, url, fmt.Errorf("invalid views url %q: %w", url, err))
 	}
-	if c := slices.Compare(v.Columns, vs.Columns); c != 0 {
-		return false
+	v.mx.RLock()
+	if v.url == url && v.etag != "" {
+		req.Header.Set("If-None-Match", v.etag)
+	}
+	v.mx.RUnlock()
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return newLoadError(LoadIOError, url, fmt.Errorf("views fetch failed for %q: %w", url, err))
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotModified:
+		return nil
+	default:
+		return newLoadError(LoadIOError, url, fmt.Errorf("views fetch failed for %q: %s", url, resp.Status))
+	}
+	bb, err := io.ReadAll(io.LimitReader(resp.Body, maxViewsSize+1))
+	if err != nil {
+		return newLoadError(LoadIOError, url, fmt.Errorf("views fetch failed for %q: %w", url, err))
+	}
+	if len(bb) > maxViewsSize {
+		return newLoadError(LoadIOError, url, fmt.Errorf("views fetched from %q exceed %d bytes", url, maxViewsSize))
+	}
+	in, err := parseRemoteViews(bb, url, v.getContext(), v.isStrict())
+	if err != nil {
+		return err
+	}
+	if err := validateInherits(in.Views, url); err != nil {
+		return err
 	}
-	return cmp.Compare(v.SortColumn, vs.SortColumn) == 0
+
+	v.mx.Lock()
+	v.Views, v.ReadOnly, v.profiles = in.Views, in.ReadOnly, in.profiles
+	v.url, v.etag, v.file = url, resp.Header.Get("ETag"), in
+	v.track(in.authored())
+	v.mx.Unlock()
+
+	v.fireConfigChanged()
+
+	return nil
 }
 
-// CustomView represents a collection of view customization.
-type CustomView struct {
-	Views     map[string]ViewSetting `yaml:"views"`
-	listeners map[string]ViewConfigListener
+// LoadDir merges all view configurations found in a directory in lexical order.
+// Later files override settings for the same gvr. Invalid files are skipped.
+// Views become read only if any of the files is marked as such.
+func (v *CustomView) LoadDir(dir string) error {
+	ii, err := loadDirViews(dir, v.getContext(), v.isStrict())
+	if err != nil {
+		return err
+	}
+
+	v.mx.Lock()
+	vv, ro := mergeViews(v.Views, v.ReadOnly, ii)
+	if err := validateInherits(vv, dir); err != nil {
+		v.mx.Unlock()
+		return err
+	}
+	v.Views, v.ReadOnly = vv, ro
+	v.track(v.own)
+	v.mx.Unlock()
+
+	v.fireConfigChanged()
+
+	return nil
 }
 
-// NewCustomView returns a views configuration.
-func NewCustomView() *CustomView {
-	return &CustomView{
-		Views:     make(map[string]ViewSetting),
-		listeners: make(map[string]ViewConfigListener),
+// Refresh reloads view configurations from a views file merged with the
+// views files found in a directory and notifies listeners once. If the views
+// file fails to load, its previously loaded settings are kept, the directory
+// views still load and the views file error is returned. Views loaded from a
+// url stand in for the views file.
+func (v *CustomView) Refresh(path, dir string) error {
+	ct, strict := v.getContext(), v.isStrict()
+	v.mx.RLock()
+	remote := v.url != ""
+	v.mx.RUnlock()
+	var (
+		in   viewsFile
+		ferr error
+	)
+	if _, err := os.Stat(path); !remote && !errors.Is(err, fs.ErrNotExist) {
+		in, ferr = loadViews(path, ct, strict)
+	}
+	ii, derr := loadDirViews(dir, ct, strict)
+
+	v.mx.Lock()
+	file := v.file
+	if ferr == nil && !remote {
+		file = in
+	}
+	vv, ro := mergeViews(file.Views, file.ReadOnly, ii)
+	if err := validateInherits(vv, path); err != nil {
+		v.mx.Unlock()
+		return err
+	}
+	v.file = file
+	v.Views, v.ReadOnly, v.profiles = vv, ro, file.profiles
+	v.track(file.authored())
+	v.mx.Unlock()
+
+	v.fireConfigChanged()
+
+	if ferr != nil {
+		return ferr
+	}
+
+	return derr
+}
+
+// track records the loaded views file as authored and fingerprints the
+// current views. Callers must hold the lock.
+func (v *CustomView) track(own viewsFile) {
+	v.own, v.loaded = own, make(map[string]string, len(v.Views))
+	for k, vs := range v.Views {
+		v.loaded[k] = vs.Hash()
 	}
 }
 
-// Reset clears out configurations.
-func (v *CustomView) Reset() {
+// ownViews returns the views file own views along with the views changed
+// since they were loaded. Callers must hold the lock.
+func (v *CustomView) ownViews() map[string]ViewSetting {
+	vv := make(map[string]ViewSetting, len(v.own.Views))
+	for k, vs := range v.own.Views {
+		if _, ok := v.Views[k]; ok {
+			vv[k] = vs
+		}
+	}
+	for k, vs := range v.Views {
+		if h, ok := v.loaded[k]; !ok || h != vs.Hash() {
+			vv[k] = vs
+		}
+	}
+
+	return vv
+}
+
+// mergeViews returns the given views overridden by the views files settings
+// in order.
+func mergeViews(vv map[string]ViewSetting, ro bool, ii []viewsFile) (map[string]ViewSetting, bool) {
+	out := make(map[string]ViewSetting, len(vv))
+	maps.Copy(out, vv)
+	for _, in := range ii {
+		maps.Copy(out, in.Views)
+		ro = ro || in.ReadOnly
+	}
+
+	return out, ro
+}
+
+// Hash returns a stable fingerprint of all view configurations.
+func (v *CustomView) Hash() string {
+	v.mx.RLock()
+	defer v.mx.RUnlock()
+
+	kk := make([]string, 0, len(v.Views))
 	for k := range v.Views {
-		delete(v.Views, k)
+		kk = append(kk, k)
 	}
+	slices.Sort(kk)
+	h := sha256.New()
+	for _, k := range kk {
+		vs := v.Views[k]
+		fmt.Fprintf(h, "%s=%s;", k, vs.Hash())
+	}
+
+	return fmt.Sprintf("%x", h.Sum(nil))
 }
 
-// Load loads view configurations.
-func (v *CustomView) Load(path string) error {
-	if _, err := os.Stat(path); errors.Is(err, fs.ErrNotExist) {
-		return nil
+func (v *CustomView) getContext() string {
+	v.mx.RLock()
+	defer v.mx.RUnlock()
+
+	return v.context
+}
+
+func (v *CustomView) notifyDelay() time.Duration {
+	v.mx.RLock()
+	defer v.mx.RUnlock()
+
+	return v.NotifyDelay
+}
+
+func (v *CustomView) dispatcher() func(func()) {
+	v.mx.RLock()
+	defer v.mx.RUnlock()
+
+	return v.Dispatch
+}
+
+func (v *CustomView) isStrict() bool {
+	v.mx.RLock()
+	defer v.mx.RUnlock()
+
+	return !v.LenientValidation
+}
+
+// loadDirViews loads the views files found in a directory in lexical order.
+// Invalid files are logged and skipped.
+func loadDirViews(dir, ct string, strict bool) ([]viewsFile, error) {
+	if _, err := os.Stat(dir); errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	var ff []string
+	for _, ext := range []string{"*.yaml", "*.json"} {
+		mm, err := filepath.Glob(filepath.Join(dir, ext))
+		if err != nil {
+			return nil, err
+		}
+		ff = append(ff, mm...)
+	}
+	slices.Sort(ff)
+	ii := make([]viewsFile, 0, len(ff))
+	for _, f := range ff {
+		in, err := loadViews(f, ct, strict)
+		if err != nil {
+			log.Warn().Err(err).Msgf("Skipping views file %q", f)
+			continue
+		}
+		ii = append(ii, in)
+	}
+
+	return ii, nil
+}
+
+// parseRemoteViews parses views not backed by a file along with their
+// profile. Includes are skipped.
+func parseRemoteViews(bb []byte, name, ct string, strict bool) (viewsFile, error) {
+	in, err := parseViews(bb, name, ct, strict)
+	if err != nil {
+		return in, err
+	}
+	if len(in.Includes) > 0 {
+		log.Warn().Msgf("Skipping views includes for remote views %q", name)
 	}
+	pv, order, err := profileViews(in, name, ct)
+	if err != nil {
+		return in, err
+	}
+	if pv != nil {
+		maps.Copy(pv, in.Views)
+		in.Views = pv
+	}
+	in.profiles = order
+
+	return in, nil
+}
+
+// loadViews loads a views file. Configurations gated by contexts patterns
+// not matching the given context are dropped.
+func loadViews(path, ct string, strict bool) (viewsFile, error) {
+	return loadIncludes(filepath.Clean(path), ct, strict, nil)
+}
+
+// loadIncludes loads a views file along with its profile and the files it
+// includes. The profile applies first, then includes resolved relative to the
+// including file in order, the including file settings taking precedence.
+func loadIncludes(path, ct string, strict bool, stack []string) (viewsFile, error) {
 	bb, err := os.ReadFile(path)
 	if err != nil {
-		return err
+		return viewsFile{}, newLoadError(LoadIOError, path, err)
+	}
+	in, err := parseViews(bb, path, ct, strict)
+	if err != nil || (len(in.Includes) == 0 && in.Profile == "") {
+		return in, err
+	}
+	pv, order, err := profileViews(in, path, ct)
+	if err != nil {
+		return in, err
+	}
+
+	stack = append(stack, path)
+	out := viewsFile{
+		Views:    make(map[string]ViewSetting, len(in.Views)+len(pv)),
+		ReadOnly: in.ReadOnly,
+		Contexts: in.Contexts,
+		Includes: in.Includes,
+		Profile:  in.Profile,
+		profiles: order,
+		own:      in.own,
+	}
+	maps.Copy(out.Views, pv)
+	for _, inc := range in.Includes {
+		p := inc
+		if !filepath.IsAbs(p) {
+			p = filepath.Join(filepath.Dir(path), p)
+		}
+		p = filepath.Clean(p)
+		if slices.Contains(stack, p) {
+			cycle := strings.Join(append(slices.Clip(stack), p), " -> ")
+			return in, newLoadError(LoadValidateError, path, fmt.Errorf("views include cycle detected: %s", cycle))
+		}
+		if _, err := os.Stat(p); errors.Is(err, fs.ErrNotExist) {
+			return in, newLoadError(LoadIOError, path, fmt.Errorf("views include %q in %q does not exist", inc, path))
+		}
+		iv, err := loadIncludes(p, ct, strict, stack)
+		if err != nil {
+			return in, err
+		}
+		maps.Copy(out.Views, iv.Views)
+		out.ReadOnly = out.ReadOnly || iv.ReadOnly
+	}
+	maps.Copy(out.Views, in.Views)
+
+	return out, nil
+}
+
+// parseViews validates and decodes a views configuration from a given source.
+// Sources may be either YAML or JSON since the latter is valid YAML.
+// Failures are reported as a LoadError. Schema violations are only logged
+// unless strict.
+func parseViews(bb []byte, path, ct string, strict bool) (viewsFile, error) {
+	var (
+		in  viewsFile
+		raw any
+	)
+	if err := yaml.Unmarshal(bb, &raw); err != nil {
+		return in, newLoadError(LoadParseError, path, err)
 	}
 	if err := data.JSONValidator.Validate(json.ViewsSchema, bb); err != nil {
-		return fmt.Errorf("validation failed for %q: %w", path, err)
+		if strict {
+			return in, newLoadError(LoadValidateError, path, fmt.Errorf("validation failed for %q: %w", path, err))
+		}
+		log.Warn().Err(err).Msgf("Views validation failed for %q. Proceeding anyway!", path)
 	}
-	var in CustomView
 	if err := yaml.Unmarshal(bb, &in); err != nil {
-		return err
+		return in, newLoadError(LoadParseError, path, err)
+	}
+	ok, err := matchContext(in.Contexts, ct)
+	if err != nil {
+		return in, newLoadError(LoadValidateError, path, fmt.Errorf("views contexts in %q: %w", path, err))
+	}
+	if !ok {
+		return viewsFile{}, nil
+	}
+	for gvr, vs := range in.Views {
+		if errs := vs.validate(); len(errs) > 0 {
+			return in, newLoadError(LoadValidateError, path, fmt.Errorf("view %q in %q: %w", gvr, path, errs[0]))
+		}
+		ok, err := matchContext(vs.Contexts, ct)
+		if err != nil {
+			return in, newLoadError(LoadValidateError, path, fmt.Errorf("view %q contexts in %q: %w", gvr, path, err))
+		}
+		if !ok {
+			delete(in.Views, gvr)
+		}
+	}
+	in.own = maps.Clone(in.Views)
+
+	return in, nil
+}
+
+// inheritChain returns the keys a view inherits from, closest first.
+func inheritChain(vv map[string]ViewSetting, key string) ([]string, error) {
+	var (
+		kk   []string
+		seen = map[string]struct{}{key: {}}
+	)
+	for p := vv[key].InheritFrom; p != ""; p = vv[p].InheritFrom {
+		if _, ok := seen[p]; ok {
+			return kk, fmt.Errorf("%w detected for %q via %q", errInheritCycle, key, p)
+		}
+		if _, ok := vv[p]; !ok {
+			return kk, fmt.Errorf("unknown inheritFrom view %q for %q", p, key)
+		}
+		seen[p] = struct{}{}
+		kk = append(kk, p)
+	}
+
+	return kk, nil
+}
+
+// inherit sets a view columns from its closest ancestor defining some if none
+// are set. Inheritance issues are reported when views load.
+func inherit(vv map[string]ViewSetting, key string, vs *ViewSetting) {
+	if len(vs.Columns) > 0 || vs.InheritFrom == "" {
+		return
+	}
+	kk, _ := inheritChain(vv, key)
+	for _, k := range kk {
+		if cc := vv[k].Columns; len(cc) > 0 {
+			vs.Columns = slices.Clone(cc)
+			break
+		}
+	}
+}
+
+// validateInherits checks the merged views inheritance is acyclic. Unknown
+// parents are logged and their children inherit no further.
+func validateInherits(vv map[string]ViewSetting, path string) error {
+	kk := make([]string, 0, len(vv))
+	for k := range vv {
+		kk = append(kk, k)
+	}
+	slices.Sort(kk)
+	for _, k := range kk {
+		_, err := inheritChain(vv, k)
+		switch {
+		case errors.Is(err, errInheritCycle):
+			return newLoadError(LoadValidateError, path, fmt.Errorf("views in %q: %w", path, err))
+		case err != nil:
+			log.Warn().Err(err).Msgf("Skipping views inheritance for %q", k)
+		}
 	}
-	v.Views = in.Views
-	v.fireConfigChanged()
 
 	return nil
 }
 
+// matchContext checks if a context name fully matches any of the given patterns.
+// No patterns matches all contexts.
+func matchContext(pp []string, ct string) (bool, error) {
+	if len(pp) == 0 {
+		return true, nil
+	}
+	var match bool
+	for _, p := range pp {
+		rx, err := regexp.Compile(`\A(?:` + p + `)\z`)
+		if err != nil {
+			return false, fmt.Errorf("invalid context pattern %q: %w", p, err)
+		}
+		match = match || rx.MatchString(ct)
+	}
+
+	return match, nil
+}
+
 // AddListener registers a new listener.
 func (v *CustomView) AddListener(gvr string, l ViewConfigListener) {
+	v.mx.Lock()
 	v.listeners[gvr] = l
+	v.mx.Unlock()
+
 	v.fireConfigChanged()
 }
 
 // RemoveListener unregister a listener.
 func (v *CustomView) RemoveListener(gvr string) {
+	v.mx.Lock()
+	defer v.mx.Unlock()
+
 	delete(v.listeners, gvr)
 }
 
+// Explain returns the views key matching a gvr in a given namespace and the
+// resulting view setting. Returns a blank key and nil if defaults apply.
+func (v *CustomView) Explain(gvr, ns string) (string, *ViewSetting) {
+	return v.getVS(gvr, ns)
+}
+
+// EffectiveColumns returns the column names resolved for a gvr in a given
+// namespace. Returns nil if defaults apply.
+func (v *CustomView) EffectiveColumns(gvr, ns string) []string {
+	_, vs := v.getVS(gvr, ns)
+	if vs == nil || len(vs.Columns) == 0 {
+		return nil
+	}
+
+	return vs.ColNames()
+}
+
+// getVS returns a copy of the view setting for a gvr with its active preset applied.
+func (v *CustomView) getVS(gvr, ns string) (string, *ViewSetting) {
+	v.mx.RLock()
+	defer v.mx.RUnlock()
+
+	k, vs, ok := v.lookup(gvr, ns)
+	if !ok {
+		return "", nil
+	}
+	vs = vs.Clone()
+	inherit(v.Views, k, &vs)
+	vs = vs.Preset()
+	if vs.KeepScroll {
+		vs.ScrollOffset = v.offsets[scrollKey(gvr, ns)]
+	}
+
+	return k, &vs
+}
+
+// CustomizedGVRs returns the sorted distinct gvrs having at least one view
+// configuration. Namespace scoped keys ie gvr@ns count toward their gvr.
+func (v *CustomView) CustomizedGVRs() []string {
+	mm := v.CustomizedCounts()
+	gg := make([]string, 0, len(mm))
+	for gvr := range mm {
+		gg = append(gg, gvr)
+	}
+	slices.Sort(gg)
+
+	return gg
+}
+
+// CustomizedCounts returns the number of view configurations per gvr.
+func (v *CustomView) CustomizedCounts() map[string]int {
+	v.mx.RLock()
+	defer v.mx.RUnlock()
+
+	mm := make(map[string]int, len(v.Views))
+	for k := range v.Views {
+		gvr, _ := splitViewKey(k)
+		mm[gvr]++
+	}
+
+	return mm
+}
+
+// splitViewKey splits a view key into its gvr and namespace scope if any ie
+// v1/pods@kube-* -> v1/pods, kube-*.
+func splitViewKey(k string) (string, string) {
+	gvr, ns, _ := strings.Cut(k, "@")
+
+	return gvr, ns
+}
+
+func scrollKey(gvr, ns string) string {
+	if ns == "" {
+		return gvr
+	}
+
+	return gvr + "@" + ns
+}
+
+// lookup returns the configured view setting for a gvr. Namespace scoped keys
+// ie gvr@ns take precedence over gvr keys. Callers must hold the lock.
+func (v *CustomView) lookup(gvr, ns string) (string, ViewSetting, bool) {
+	keys := []string{gvr}
+	if ns != "" {
+		keys = []string{gvr + "@" + ns, gvr}
+	}
+	for _, k := range keys {
+		if vs, ok := v.Views[k]; ok {
+			return k, vs, true
+		}
+	}
+
+	return "", ViewSetting{}, false
+}
+
+// Flush synchronously notifies listeners of any pending throttled changes.
+func (v *CustomView) Flush() {
+	v.notifyMx.Lock()
+	p := v.pending
+	v.pending = false
+	v.notifyMx.Unlock()
+
+	if p {
+		v.fireMx.Lock()
+		defer v.fireMx.Unlock()
+		v.notifyListeners()
+	}
+}
+
 func (v *CustomView) fireConfigChanged() {
-	for gvr, list := range v.listeners {
-		if v, ok := v.Views[gvr]; ok {
-			list.ViewSettingsChanged(v)
+	d := v.notifyDelay()
+	if d <= 0 {
+		v.notifyListeners()
+		return
+	}
+
+	v.notifyMx.Lock()
+	defer v.notifyMx.Unlock()
+	v.pending = true
+	if !v.notifying {
+		v.notifying = true
+		go v.notifyLoop(d)
+	}
+}
+
+// notifyLoop notifies listeners of coalesced changes until none are pending.
+// Settings are resolved when listeners are notified so the last notification
+// always reflects the latest configuration.
+func (v *CustomView) notifyLoop(d time.Duration) {
+	for {
+		time.Sleep(d)
+		v.notifyMx.Lock()
+		if !v.pending {
+			v.notifying = false
+			v.notifyMx.Unlock()
+			return
+		}
+		v.pending = false
+		v.notifyMx.Unlock()
+		fire := func() {
+			v.fireMx.Lock()
+			v.notifyListeners()
+			v.fireMx.Unlock()
+		}
+		if dispatch := v.dispatcher(); dispatch != nil {
+			dispatch(fire)
 		} else {
-			list.ViewSettingsChanged(ViewSetting{})
+			fire()
 		}
 	}
 }
+
+func (v *CustomView) notifyListeners() {
+	v.mx.RLock()
+	ll := maps.Clone(v.listeners)
+	v.mx.RUnlock()
+
+	for gvr, list := range ll {
+		v.notify(gvr, list)
+	}
+}
+
+// NotifyListener notifies the listener registered for a gvr of its view
+// setting, resolved for the listener active namespace.
+func (v *CustomView) NotifyListener(gvr string) {
+	v.mx.RLock()
+	l, ok := v.listeners[gvr]
+	v.mx.RUnlock()
+	if ok {
+		v.notify(gvr, l)
+	}
+}
+
+func (v *CustomView) notify(gvr string, l ViewConfigListener) {
+	var ns string
+	if n, ok := l.(ViewNamespacer); ok {
+		ns = n.ViewNamespace()
+	}
+	if _, vs := v.getVS(gvr, ns); vs != nil {
+		l.ViewSettingsChanged(*vs)
+	} else {
+		l.ViewSettingsChanged(ViewSetting{})
+	}
+}
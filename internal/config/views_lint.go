@@ -0,0 +1,181 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+
+	"github.com/derailed/k9s/internal/config/data"
+	"github.com/derailed/k9s/internal/config/json"
+	"gopkg.in/yaml.v2"
+	yaml3 "gopkg.in/yaml.v3"
+)
+
+// LintSeverity represents a lint issue severity.
+type LintSeverity string
+
+const (
+	// LintError flags a configuration k9s rejects.
+	LintError LintSeverity = "error"
+
+	// LintWarning flags a configuration k9s ignores at runtime.
+	LintWarning LintSeverity = "warning"
+)
+
+// LintIssue represents a views configuration issue.
+type LintIssue struct {
+	Severity LintSeverity
+	Path     string
+	Line     int
+	View     string
+	Message  string
+}
+
+// String returns an issue report line.
+func (i LintIssue) String() string {
+	loc := i.Path
+	if i.Line > 0 {
+		loc = fmt.Sprintf("%s:%d", i.Path, i.Line)
+	}
+	if i.View != "" {
+		return fmt.Sprintf("%s: %s: view %q: %s", loc, i.Severity, i.View, i.Message)
+	}
+
+	return fmt.Sprintf("%s: %s: %s", loc, i.Severity, i.Message)
+}
+
+// HasLintErrors returns true if any of the issues is an error.
+func HasLintErrors(ii []LintIssue) bool {
+	return slices.ContainsFunc(ii, func(i LintIssue) bool {
+		return i.Severity == LintError
+	})
+}
+
+// LintViews checks a views file and reports all issues found.
+// No issues means the file is clean.
+func LintViews(path string) []LintIssue {
+	bb, err := os.ReadFile(path)
+	if err != nil {
+		return []LintIssue{{Severity: LintError, Path: path, Message: err.Error()}}
+	}
+	var root yaml3.Node
+	if err := yaml3.Unmarshal(bb, &root); err != nil {
+		return []LintIssue{{Severity: LintError, Path: path, Message: err.Error()}}
+	}
+	if ii := duplicateKeys(path, &root); len(ii) > 0 {
+		return ii
+	}
+
+	var ii []LintIssue
+	if err := data.JSONValidator.Validate(json.ViewsSchema, bb); err != nil {
+		for _, e := range unjoin(err) {
+			ii = append(ii, LintIssue{Severity: LintError, Path: path, Message: e.Error()})
+		}
+	}
+	var in viewsFile
+	if err := yaml.Unmarshal(bb, &in); err != nil {
+		return append(ii, LintIssue{Severity: LintError, Path: path, Message: err.Error()})
+	}
+	if _, err := matchContext(in.Contexts, ""); err != nil {
+		ii = append(ii, LintIssue{Severity: LintError, Path: path, Message: err.Error()})
+	}
+	if in.Profile != "" {
+		if _, err := ViewProfileOrder(in.Profile); err != nil {
+			ii = append(ii, LintIssue{Severity: LintError, Path: path, Message: err.Error()})
+		}
+	}
+
+	lines := viewLines(&root)
+	gvrs := make([]string, 0, len(in.Views))
+	for gvr := range in.Views {
+		gvrs = append(gvrs, gvr)
+	}
+	slices.Sort(gvrs)
+	for _, gvr := range gvrs {
+		vs := in.Views[gvr]
+		issue := func(s LintSeverity, err error) {
+			ii = append(ii, LintIssue{Severity: s, Path: path, Line: lines[gvr], View: gvr, Message: err.Error()})
+		}
+		for _, err := range vs.validate() {
+			issue(LintError, err)
+		}
+		if _, err := matchContext(vs.Contexts, ""); err != nil {
+			issue(LintError, err)
+		}
+		if _, err := inheritChain(in.Views, gvr); err != nil {
+			s := LintWarning
+			if errors.Is(err, errInheritCycle) {
+				s = LintError
+			}
+			issue(s, err)
+		}
+		if vs.SortColumn != "" {
+			if _, err := vs.SortCols(); err != nil && !errors.Is(err, ErrNoSort) {
+				issue(LintWarning, err)
+			}
+		}
+	}
+
+	return ii
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// duplicateKeys reports mapping keys defined more than once.
+func duplicateKeys(path string, n *yaml3.Node) []LintIssue {
+	var ii []LintIssue
+	if n.Kind == yaml3.MappingNode {
+		seen := make(map[string]int, len(n.Content)/2)
+		for i := 0; i+1 < len(n.Content); i += 2 {
+			k := n.Content[i]
+			if l, ok := seen[k.Value]; ok {
+				ii = append(ii, LintIssue{
+					Severity: LintError,
+					Path:     path,
+					Line:     k.Line,
+					Message:  fmt.Sprintf("duplicate key %q first defined at line %d", k.Value, l),
+				})
+				continue
+			}
+			seen[k.Value] = k.Line
+		}
+	}
+	for _, c := range n.Content {
+		ii = append(ii, duplicateKeys(path, c)...)
+	}
+
+	return ii
+}
+
+// viewLines returns the line of each view definition keyed by gvr.
+func viewLines(root *yaml3.Node) map[string]int {
+	ll := make(map[string]int)
+	if root.Kind != yaml3.DocumentNode || len(root.Content) == 0 {
+		return ll
+	}
+	doc := root.Content[0]
+	for i := 0; i+1 < len(doc.Content); i += 2 {
+		if doc.Content[i].Value != "views" {
+			continue
+		}
+		vv := doc.Content[i+1]
+		for j := 0; j+1 < len(vv.Content); j += 2 {
+			ll[vv.Content[j].Value] = vv.Content[j].Line
+		}
+	}
+
+	return ll
+}
+
+func unjoin(err error) []error {
+	if j, ok := err.(interface{ Unwrap() []error }); ok {
+		return j.Unwrap()
+	}
+
+	return []error{err}
+}
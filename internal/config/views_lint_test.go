@@ -0,0 +1,83 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLintViews(t *testing.T) {
+	uu := map[string]struct {
+		path string
+		ii   []config.LintIssue
+	}{
+		"clean": {
+			path: "testdata/views/views.yaml",
+		},
+		"missing": {
+			path: "testdata/views/bozo.yaml",
+			ii: []config.LintIssue{
+				{Severity: config.LintError, Path: "testdata/views/bozo.yaml", Message: "open testdata/views/bozo.yaml: no such file or directory"},
+			},
+		},
+		"dups": {
+			path: "testdata/views/lint-dup.yaml",
+			ii: []config.LintIssue{
+				{Severity: config.LintError, Path: "testdata/views/lint-dup.yaml", Line: 8, Message: `duplicate key "v1/pods" first defined at line 2`},
+			},
+		},
+		"inherit-cycle": {
+			path: "testdata/views/inherit-cycle.yaml",
+			ii: []config.LintIssue{
+				{Severity: config.LintError, Path: "testdata/views/inherit-cycle.yaml", Line: 2, View: "v1/pods@a", Message: `inheritFrom cycle detected for "v1/pods@a" via "v1/pods@a"`},
+				{Severity: config.LintError, Path: "testdata/views/inherit-cycle.yaml", Line: 4, View: "v1/pods@b", Message: `inheritFrom cycle detected for "v1/pods@b" via "v1/pods@b"`},
+			},
+		},
+		"issues": {
+			path: "testdata/views/lint.yaml",
+			ii: []config.LintIssue{
+				{Severity: config.LintError, Path: "testdata/views/lint.yaml", Message: "Additional property colums is not allowed"},
+				{Severity: config.LintError, Path: "testdata/views/lint.yaml", Message: "invalid context pattern \"prod-(\": error parsing regexp: missing closing ): `\\A(?:prod-()\\z`"},
+				{Severity: config.LintError, Path: "testdata/views/lint.yaml", Line: 9, View: "apps/v1/deployments", Message: `invalid jsonpath column "jsonpath:{.spec.replicas". must be jsonpath:{expr}|NAME`},
+				{Severity: config.LintError, Path: "testdata/views/lint.yaml", Line: 9, View: "apps/v1/deployments", Message: `unknown active preset "fred"`},
+				{Severity: config.LintWarning, Path: "testdata/views/lint.yaml", Line: 4, View: "v1/pods", Message: `invalid sort column spec #0: "STATUS:up". must be col-name:asc|desc`},
+			},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			ii := config.LintViews(u.path)
+			assert.Equal(t, u.ii, ii)
+			assert.Equal(t, len(u.ii) > 0, config.HasLintErrors(ii))
+		})
+	}
+}
+
+func TestLintIssueString(t *testing.T) {
+	uu := map[string]struct {
+		i config.LintIssue
+		e string
+	}{
+		"file": {
+			i: config.LintIssue{Severity: config.LintError, Path: "v.yaml", Message: "boom"},
+			e: "v.yaml: error: boom",
+		},
+		"view": {
+			i: config.LintIssue{Severity: config.LintWarning, Path: "v.yaml", Line: 3, View: "v1/pods", Message: "boom"},
+			e: `v.yaml:3: warning: view "v1/pods": boom`,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, u.i.String())
+		})
+	}
+}
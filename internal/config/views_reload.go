@@ -0,0 +1,78 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package config
+
+import (
+	"os"
+	"os/signal"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// signalReloadDelay debounces bursts of reload signals into a single reload.
+const signalReloadDelay = 100 * time.Millisecond
+
+// ReloadSignalSupported checks if views can be reloaded on signal on this platform.
+func ReloadSignalSupported() bool {
+	return reloadSignal != nil
+}
+
+// InstallSignalReload reloads views from a given path whenever the process
+// receives a SIGUSR1. As with the views file watcher, failed reloads keep the
+// current views and listeners notifications are coalesced per NotifyDelay.
+// Installing again replaces the previous handler. No-op on platforms without
+// SIGUSR1.
+func (v *CustomView) InstallSignalReload(path string) {
+	v.InstallSignalReloadFunc(func() error { return v.Load(path) })
+}
+
+// InstallSignalReloadFunc reloads views using a given loader whenever the
+// process receives a SIGUSR1. Signals received in short succession trigger a
+// single reload.
+func (v *CustomView) InstallSignalReloadFunc(reload func() error) {
+	if reloadSignal == nil {
+		return
+	}
+	v.UninstallSignalReload()
+
+	sig, done := make(chan os.Signal, 1), make(chan struct{})
+	signal.Notify(sig, reloadSignal)
+	v.mx.Lock()
+	v.reloadSig, v.reloadDone = sig, done
+	v.mx.Unlock()
+
+	go reloadLoop(reload, sig, done)
+}
+
+// UninstallSignalReload removes the views reload signal handler if any.
+func (v *CustomView) UninstallSignalReload() {
+	v.mx.Lock()
+	sig, done := v.reloadSig, v.reloadDone
+	v.reloadSig, v.reloadDone = nil, nil
+	v.mx.Unlock()
+	if sig == nil {
+		return
+	}
+	signal.Stop(sig)
+	close(done)
+}
+
+func reloadLoop(reload func() error, sig <-chan os.Signal, done <-chan struct{}) {
+	var fire <-chan time.Time
+	for {
+		select {
+		case <-sig:
+			fire = time.After(signalReloadDelay)
+		case <-fire:
+			fire = nil
+			log.Debug().Msgf("Reloading views on signal")
+			if err := reload(); err != nil {
+				log.Warn().Err(err).Msgf("Views reload failed. Keeping current views")
+			}
+		case <-done:
+			return
+		}
+	}
+}
@@ -0,0 +1,11 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+//go:build !unix
+
+package config
+
+import "os"
+
+// reloadSignal is not supported on this platform.
+var reloadSignal os.Signal
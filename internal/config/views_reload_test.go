@@ -0,0 +1,73 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+//go:build unix
+
+package config_test
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"sync/atomic"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCustomViewSignalReload(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "views.yaml")
+	write := func(col string) {
+		assert.NoError(t, os.WriteFile(path, []byte("views:\n  v1/pods:\n    columns:\n      - "+col+"\n"), 0600))
+	}
+	cfg := config.NewCustomView()
+	columns := func() []string {
+		if _, vs := cfg.Explain("v1/pods", ""); vs != nil {
+			return vs.Columns
+		}
+		return nil
+	}
+	reload := func() {
+		p, err := os.FindProcess(os.Getpid())
+		assert.NoError(t, err)
+		assert.NoError(t, p.Signal(syscall.SIGUSR1))
+	}
+
+	write("NAME")
+	cfg.InstallSignalReload(path)
+	defer cfg.UninstallSignalReload()
+	reload()
+	assert.Eventually(t, func() bool { return slices.Equal(columns(), []string{"NAME"}) }, time.Second, 10*time.Millisecond)
+
+	write("AGE")
+	reload()
+	assert.Eventually(t, func() bool { return slices.Equal(columns(), []string{"AGE"}) }, time.Second, 10*time.Millisecond)
+
+	assert.NoError(t, os.WriteFile(path, []byte("views: ["), 0600))
+	reload()
+	assert.Never(t, func() bool { return !slices.Equal(columns(), []string{"AGE"}) }, 100*time.Millisecond, 10*time.Millisecond)
+
+	cfg.UninstallSignalReload()
+	cfg.UninstallSignalReload()
+}
+
+func TestCustomViewSignalReloadDebounce(t *testing.T) {
+	var count atomic.Int32
+	cfg := config.NewCustomView()
+	cfg.InstallSignalReloadFunc(func() error {
+		count.Add(1)
+		return nil
+	})
+	defer cfg.UninstallSignalReload()
+
+	p, err := os.FindProcess(os.Getpid())
+	assert.NoError(t, err)
+	for i := 0; i < 3; i++ {
+		assert.NoError(t, p.Signal(syscall.SIGUSR1))
+	}
+	assert.Eventually(t, func() bool { return count.Load() == 1 }, time.Second, 10*time.Millisecond)
+	assert.Never(t, func() bool { return count.Load() > 1 }, 300*time.Millisecond, 10*time.Millisecond)
+}
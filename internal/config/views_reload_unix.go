@@ -0,0 +1,15 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+//go:build unix
+
+package config
+
+import (
+	"os"
+	"syscall"
+)
+
+// reloadSignal triggers views reloads. SIGHUP is left to terminate k9s when
+// its terminal hangs up.
+var reloadSignal os.Signal = syscall.SIGUSR1
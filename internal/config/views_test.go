@@ -4,9 +4,22 @@
 package config_test
 
 import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/config/data"
+	"github.com/derailed/k9s/internal/config/viewtest"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -18,6 +31,1332 @@ func TestViewSettingsLoad(t *testing.T) {
 	assert.Equal(t, 4, len(cfg.Views["v1/pods"].Columns))
 }
 
+func TestCustomViewLoadContexts(t *testing.T) {
+	uu := map[string]struct {
+		file, context string
+		gvrs          []string
+		err           bool
+	}{
+		"entries-prod": {
+			file:    "testdata/views/contexts.yaml",
+			context: "prod-us",
+			gvrs:    []string{"v1/configmaps", "v1/pods"},
+		},
+		"entries-staging": {
+			file:    "testdata/views/contexts.yaml",
+			context: "staging-1",
+			gvrs:    []string{"v1/configmaps", "v1/services"},
+		},
+		"entries-partial": {
+			file:    "testdata/views/contexts.yaml",
+			context: "xprod-us",
+			gvrs:    []string{"v1/configmaps"},
+		},
+		"file-match": {
+			file:    "testdata/views/contexts-prod.yaml",
+			context: "prod-eu",
+			gvrs:    []string{"v1/pods"},
+		},
+		"file-no-match": {
+			file:    "testdata/views/contexts-prod.yaml",
+			context: "dev",
+			gvrs:    []string{},
+		},
+		"invalid": {
+			file:    "testdata/views/contexts-toast.yaml",
+			context: "prod-eu",
+			err:     true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			cfg := config.NewCustomView()
+			cfg.SetContext(u.context)
+			err := cfg.Load(u.file)
+			if u.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			gvrs := make([]string, 0, len(cfg.Views))
+			for gvr := range cfg.Views {
+				gvrs = append(gvrs, gvr)
+			}
+			slices.Sort(gvrs)
+			assert.Equal(t, u.gvrs, gvrs)
+		})
+	}
+}
+
+func TestCustomViewLoadMuteStatuses(t *testing.T) {
+	cfg := config.NewCustomView()
+	assert.NoError(t, cfg.Load("testdata/views/mute.yaml"))
+	vs := cfg.Views["batch/v1/jobs"]
+	assert.True(t, vs.IsMuted("Completed"))
+	assert.False(t, vs.IsMuted("completed"))
+	assert.False(t, vs.IsMuted("Failed"))
+
+	assert.Error(t, config.NewCustomView().Load("testdata/views/mute-empty.yaml"))
+}
+
+func TestCustomViewLoadExcludeNamespaces(t *testing.T) {
+	cfg := config.NewCustomView()
+	assert.NoError(t, cfg.Load("testdata/views/exclude-ns.yaml"))
+	assert.Equal(t, []string{"kube-*", "local-path-storage"}, cfg.Views["v1/pods"].ExcludeNamespaces)
+
+	assert.Error(t, config.NewCustomView().Load("testdata/views/exclude-ns-bad.yaml"))
+}
+
+func TestCustomViewLoadGroupBadges(t *testing.T) {
+	cfg := config.NewCustomView()
+	assert.NoError(t, cfg.Load("testdata/views/badges.yaml"))
+	assert.Equal(t, []string{"STATUS"}, cfg.Views["v1/pods"].GroupBadges)
+
+	assert.Error(t, config.NewCustomView().Load("testdata/views/badges-bad.yaml"))
+	ii := config.LintViews("testdata/views/badges-bad.yaml")
+	assert.Len(t, ii, 2)
+	assert.Equal(t, `group badge column "STATUS" is not a view column`, ii[0].Message)
+	assert.Equal(t, "groupBadges requires groupBy", ii[1].Message)
+}
+
+func TestCustomViewLoadGroupBy(t *testing.T) {
+	assert.Error(t, config.NewCustomView().Load("testdata/views/group-by-bad.yaml"))
+	ii := config.LintViews("testdata/views/group-by-bad.yaml")
+	assert.Len(t, ii, 3)
+	mm := []string{ii[0].Message, ii[1].Message, ii[2].Message}
+	assert.Contains(t, mm, `groupBy column "NODE" is not a view column`)
+	assert.Contains(t, mm, `groupSum column "PORTS" is not a view column`)
+	assert.Contains(t, mm, "groupSum requires groupBy")
+}
+
+func TestCustomViewLoadGroupState(t *testing.T) {
+	assert.ErrorContains(t, config.NewCustomView().Load("testdata/views/groups.yaml"), "groupsCollapsed and rememberGroupState require groupBy")
+	ii := config.LintViews("testdata/views/groups.yaml")
+	assert.Len(t, ii, 1)
+	assert.Equal(t, "groupsCollapsed and rememberGroupState require groupBy", ii[0].Message)
+}
+
+func TestCustomViewLoadConditionalColumns(t *testing.T) {
+	cfg := config.NewCustomView()
+	assert.NoError(t, cfg.Load("testdata/views/conditional.yaml"))
+	assert.Equal(t, map[string]string{"REASON": "!Running"}, cfg.Views["v1/pods"].ConditionalColumns)
+
+	assert.Error(t, config.NewCustomView().Load("testdata/views/conditional-bad.yaml"))
+	ii := config.LintViews("testdata/views/conditional-bad.yaml")
+	assert.Len(t, ii, 3)
+	mm := make([]string, 0, len(ii))
+	for _, i := range ii {
+		mm = append(mm, i.Message)
+	}
+	assert.ElementsMatch(t, []string{
+		`conditional column "REASON" predicate "-l app=fred" must not be a label selector`,
+		`conditional column "TYPE" is not a view column`,
+		"conditional column \"NAME\": invalid rx filter \"(fred\": error parsing regexp: missing closing ): `(fred`",
+	}, mm)
+}
+
+func TestCustomViewCustomizedGVRs(t *testing.T) {
+	uu := map[string]struct {
+		vv     map[string]config.ViewSetting
+		gvrs   []string
+		counts map[string]int
+	}{
+		"empty": {
+			gvrs:   []string{},
+			counts: map[string]int{},
+		},
+		"scoped": {
+			vv: map[string]config.ViewSetting{
+				"v1/services":           {},
+				"v1/pods@prod-.*":       {},
+				"v1/pods@kube-system":   {},
+				"v1/pods":               {},
+				"apps/v1/deployments@a": {},
+			},
+			gvrs:   []string{"apps/v1/deployments", "v1/pods", "v1/services"},
+			counts: map[string]int{"apps/v1/deployments": 1, "v1/pods": 3, "v1/services": 1},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			cfg := config.NewCustomView()
+			for key, vs := range u.vv {
+				cfg.Views[key] = vs
+			}
+			assert.Equal(t, u.gvrs, cfg.CustomizedGVRs())
+			assert.Equal(t, u.counts, cfg.CustomizedCounts())
+		})
+	}
+}
+
+func TestCustomViewLoadIncludes(t *testing.T) {
+	cfg := config.NewCustomView()
+	assert.NoError(t, cfg.Load("testdata/views/includes/main.yaml"))
+	assert.Len(t, cfg.Views, 4)
+	assert.Equal(t, config.ViewSetting{Columns: []string{"NAME", "STATUS"}}, cfg.Views["v1/pods"])
+	assert.Equal(t, []string{"NAME"}, cfg.Views["v1/configmaps"].Columns)
+	assert.Equal(t, []string{"NAME", "TYPE"}, cfg.Views["v1/services"].Columns)
+	assert.Equal(t, []string{"NAME", "READY"}, cfg.Views["apps/v1/deployments"].Columns)
+
+	uu := map[string]struct {
+		path, errPath, err string
+		kind               config.LoadErrorKind
+	}{
+		"cycle": {
+			path:    "testdata/views/includes/cycle-a.yaml",
+			errPath: "testdata/views/includes/cycle-b.yaml",
+			err:     "views include cycle detected: testdata/views/includes/cycle-a.yaml -> testdata/views/includes/cycle-b.yaml -> testdata/views/includes/cycle-a.yaml",
+			kind:    config.LoadValidateError,
+		},
+		"missing": {
+			path:    "testdata/views/includes/missing.yaml",
+			errPath: "testdata/views/includes/missing.yaml",
+			err:     `views include "fragments/nope.yaml" in "testdata/views/includes/missing.yaml" does not exist`,
+			kind:    config.LoadIOError,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			err := config.NewCustomView().Load(u.path)
+			var le *config.LoadError
+			assert.ErrorAs(t, err, &le)
+			assert.Equal(t, u.kind, le.Kind)
+			assert.Equal(t, u.errPath, le.Path)
+			assert.EqualError(t, err, u.err)
+		})
+	}
+}
+
+func TestCustomViewLoadMultiValue(t *testing.T) {
+	cfg := config.NewCustomView()
+	assert.NoError(t, cfg.Load("testdata/views/multi-value.yaml"))
+	assert.Equal(t, map[string]string{"IMAGES": config.FirstValue, "PORTS": config.CommaValues}, cfg.Views["v1/pods"].MultiValue)
+
+	assert.Error(t, config.NewCustomView().Load("testdata/views/multi-value-bad.yaml"))
+	ii := config.LintViews("testdata/views/multi-value-bad.yaml")
+	assert.Len(t, ii, 3)
+	assert.Equal(t, `multiValue column "IMAGES" is not a view column`, ii[1].Message)
+	assert.Equal(t, `invalid multiValue style "newline" for column "PORTS". must be one of comma, space, first or count`, ii[2].Message)
+}
+
+func TestCustomViewLoadNormalize(t *testing.T) {
+	cfg := config.NewCustomView()
+	assert.NoError(t, cfg.Load("testdata/views/normalize.yaml"))
+	assert.Equal(t, map[string]string{"MEM": "MiB"}, cfg.Views["v1/pods"].Normalize)
+
+	assert.Error(t, config.NewCustomView().Load("testdata/views/normalize-bad.yaml"))
+	ii := config.LintViews("testdata/views/normalize-bad.yaml")
+	assert.Len(t, ii, 3)
+	assert.Equal(t, `invalid normalize unit "cores" for column "CPU"`, ii[1].Message)
+	assert.Equal(t, `normalize column "MEM" is not a view column`, ii[2].Message)
+}
+
+func TestCustomViewLoadGroupDigits(t *testing.T) {
+	cfg := config.NewCustomView()
+	assert.NoError(t, cfg.Load("testdata/views/group-digits.yaml"))
+	vs := cfg.Views["v1/pods"]
+	assert.Equal(t, []string{"RESTARTS"}, vs.GroupDigits)
+	assert.Equal(t, "_", vs.DigitSep())
+	assert.Equal(t, ",", new(config.ViewSetting).DigitSep())
+
+	assert.Error(t, config.NewCustomView().Load("testdata/views/group-digits-bad.yaml"))
+	ii := config.LintViews("testdata/views/group-digits-bad.yaml")
+	assert.Len(t, ii, 2)
+	mm := []string{ii[0].Message, ii[1].Message}
+	assert.Contains(t, mm, `invalid digit separator "..". must be a single character`)
+	assert.Contains(t, mm, `groupDigits column "RESTARTS" is not a view column`)
+}
+
+func TestCustomViewLoadBreakpoints(t *testing.T) {
+	cfg := config.NewCustomView()
+	assert.NoError(t, cfg.Load("testdata/views/breakpoints.yaml"))
+	vs := cfg.Views["v1/pods"]
+	assert.Len(t, vs.Breakpoints, 2)
+	assert.Equal(t, []string{"NAME"}, vs.ForWidth(60).Columns)
+	assert.Equal(t, []string{"NAME", "STATUS", "READY"}, vs.ForWidth(100).Columns)
+	assert.Equal(t, []string{"NAMESPACE", "NAME", "STATUS", "NODE", "AGE"}, vs.ForWidth(200).Columns)
+
+	assert.Error(t, config.NewCustomView().Load("testdata/views/breakpoints-bad.yaml"))
+	ii := config.LintViews("testdata/views/breakpoints-bad.yaml")
+	mm := make([]string, 0, len(ii))
+	for _, i := range ii {
+		mm = append(mm, i.Message)
+	}
+	assert.Contains(t, mm, `invalid breakpoint "~100". must read <op><width> with op one of <, <=, >, >= or =`)
+	assert.Contains(t, mm, `breakpoint ">=100" must list columns`)
+}
+
+func TestCustomViewLoadKeys(t *testing.T) {
+	assert.Error(t, config.NewCustomView().Load("testdata/views/keys-bad.yaml"))
+	ii := config.LintViews("testdata/views/keys-bad.yaml")
+	assert.Len(t, ii, 1)
+	assert.Equal(t, `key "d" must map to an action or plugin`, ii[0].Message)
+}
+
+func TestCustomViewLoadEnterView(t *testing.T) {
+	cfg := config.NewCustomView()
+	assert.NoError(t, cfg.Load("testdata/views/enter-view.yaml"))
+	vs := cfg.Views["v1/configmaps"]
+	assert.Equal(t, config.YAMLEnterView, vs.EnterView)
+
+	vs.Active = "linked"
+	p := vs.Preset()
+	assert.Empty(t, p.EnterView)
+	assert.Equal(t, "v1/pods:NAME", p.EnterAction)
+
+	assert.Error(t, config.NewCustomView().Load("testdata/views/enter-view-bad.yaml"))
+	ii := config.LintViews("testdata/views/enter-view-bad.yaml")
+	assert.Len(t, ii, 1)
+	assert.Equal(t, "enterView and enterAction are mutually exclusive", ii[0].Message)
+}
+
+func TestCustomViewLoadLinks(t *testing.T) {
+	cfg := config.NewCustomView()
+	assert.NoError(t, cfg.Load("testdata/views/links.yaml"))
+	assert.Equal(t, []string{"DASHBOARD", "RUNBOOK"}, cfg.Views["fred.io/v1/blees"].Links)
+
+	assert.ErrorContains(t, config.NewCustomView().Load("testdata/views/links-bad.yaml"), `link column "DOCS" is not a view column`)
+}
+
+func TestCustomViewLoadError(t *testing.T) {
+	uu := map[string]struct {
+		path string
+		kind config.LoadErrorKind
+	}{
+		"io": {
+			path: "testdata/views",
+			kind: config.LoadIOError,
+		},
+		"parse": {
+			path: "testdata/views/bad-yaml.yaml",
+			kind: config.LoadParseError,
+		},
+		"schema": {
+			path: "testdata/views/mute-empty.yaml",
+			kind: config.LoadValidateError,
+		},
+		"validate": {
+			path: "testdata/views/exclude-ns-bad.yaml",
+			kind: config.LoadValidateError,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			err := config.NewCustomView().Load(u.path)
+			var le *config.LoadError
+			assert.ErrorAs(t, err, &le)
+			assert.Equal(t, u.kind, le.Kind)
+			assert.Equal(t, u.path, le.Path)
+		})
+	}
+}
+
+func TestCustomViewLoadStrictValidation(t *testing.T) {
+	uu := map[string]struct {
+		lenient bool
+		err     bool
+		cols    []string
+	}{
+		"strict": {
+			err:  true,
+			cols: []string{"NAMESPACE", "NAME"},
+		},
+		"lenient": {
+			lenient: true,
+			cols:    []string{"NAME", "STATUS"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			cfg := config.NewCustomView()
+			assert.NoError(t, cfg.Load("testdata/views/exclude-ns.yaml"))
+			cfg.LenientValidation = u.lenient
+
+			err := cfg.Load("testdata/views/schema-bad.yaml")
+			if u.err {
+				var le *config.LoadError
+				assert.ErrorAs(t, err, &le)
+				assert.Equal(t, config.LoadValidateError, le.Kind)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, u.cols, cfg.Views["v1/pods"].Columns)
+		})
+	}
+}
+
+func TestCustomViewLoadNoColumns(t *testing.T) {
+	cfg := config.NewCustomView()
+	assert.NoError(t, cfg.Load("testdata/views/no-columns.yaml"))
+	assert.Len(t, cfg.Views, 4)
+	assert.Equal(t, config.NoSortColumn, cfg.Views["v1/events"].SortColumn)
+	assert.Equal(t, []string{"NAME"}, cfg.Views["v1/pods"].Presets["minimal"].Columns)
+	assert.Empty(t, cfg.Views["v1/services"].Columns)
+	assert.Equal(t, "fred", cfg.Views["apps/v1/deployments"].Theme)
+
+	var le *config.LoadError
+	assert.ErrorAs(t, config.NewCustomView().Load("testdata/views/empty-view.yaml"), &le)
+	assert.Equal(t, config.LoadValidateError, le.Kind)
+}
+
+func TestCustomViewLoadURL(t *testing.T) {
+	var hits, fetches int
+	body, status := "", http.StatusOK
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits++
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		fetches++
+		w.Header().Set("ETag", `"v1"`)
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	}))
+	defer srv.Close()
+
+	bb, err := os.ReadFile("testdata/views/views.yaml")
+	assert.NoError(t, err)
+	body = string(bb)
+
+	cfg := config.NewCustomView()
+	assert.NoError(t, cfg.LoadURL(context.Background(), srv.URL))
+	assert.Equal(t, 4, len(cfg.Views["v1/pods"].Columns))
+	assert.NoError(t, cfg.LoadURL(context.Background(), srv.URL))
+	assert.Equal(t, 2, hits)
+	assert.Equal(t, 1, fetches)
+
+	cfg = config.NewCustomView()
+	assert.NoError(t, cfg.Load("testdata/views/presets.yaml"))
+	body = "views:\n  v1/pods:\n    columns: fred\n"
+	assert.Error(t, cfg.LoadURL(context.Background(), srv.URL))
+	status = http.StatusInternalServerError
+	assert.ErrorContains(t, cfg.LoadURL(context.Background(), srv.URL), "500")
+	status, body = http.StatusOK, "# "+strings.Repeat("x", 1<<20)+"\n"+string(bb)
+	assert.ErrorContains(t, cfg.LoadURL(context.Background(), srv.URL), "exceed")
+	assert.Equal(t, "minimal", cfg.Views["v1/pods"].Active)
+}
+
+func TestCustomViewLoadColumnTypes(t *testing.T) {
+	cfg := config.NewCustomView()
+	assert.NoError(t, cfg.Load("testdata/views/coltypes.yaml"))
+	vs := cfg.Views["v1/pods"]
+	assert.Equal(t, config.IPColumn, vs.ColumnType("IP"))
+	assert.Equal(t, config.StringColumn, vs.ColumnType("NAME"))
+
+	assert.Error(t, config.NewCustomView().Load("testdata/views/coltypes-bad.yaml"))
+}
+
+func TestCustomViewEffectiveColumns(t *testing.T) {
+	viewtest.LoadAndAssertView(t, "testdata/views/views.yaml", "v1/pods", "default", []string{"NAMESPACE", "NAME", "AGE", "IP"})
+	viewtest.LoadAndAssertView(t, "testdata/views/presets.yaml", "v1/pods", "", []string{"NAME"})
+	viewtest.LoadAndAssertView(t, "testdata/views/views.yaml", "v1/services", "", nil)
+}
+
+func TestCustomViewLoadDir(t *testing.T) {
+	cfg := config.NewCustomView()
+	l := viewListener{}
+	cfg.AddListener("v1/pods", &l)
+	l.count = 0
+
+	assert.Nil(t, cfg.LoadDir("testdata/views.d"))
+	assert.Equal(t, 2, len(cfg.Views))
+	assert.Equal(t, []string{"NAMESPACE", "NAME"}, cfg.Views["v1/pods"].Columns)
+	assert.Equal(t, []string{"NAME", "TYPE"}, cfg.Views["v1/services"].Columns)
+	assert.Equal(t, 1, l.count)
+	assert.Equal(t, []string{"NAMESPACE", "NAME"}, l.vs.Columns)
+
+	assert.Nil(t, cfg.LoadDir("testdata/views.d.not-there"))
+	assert.Equal(t, 2, len(cfg.Views))
+}
+
+func TestCustomViewRefresh(t *testing.T) {
+	cfg := config.NewCustomView()
+	l := viewListener{}
+	cfg.AddListener("v1/pods", &l)
+	l.count = 0
+
+	assert.NoError(t, cfg.Refresh("testdata/views/views.yaml", "testdata/views.d"))
+	assert.Equal(t, 2, len(cfg.Views))
+	assert.Equal(t, []string{"NAMESPACE", "NAME"}, cfg.Views["v1/pods"].Columns)
+	assert.Equal(t, 1, l.count)
+
+	assert.Error(t, cfg.Refresh("testdata/views/schema-bad.yaml", "testdata/views.d"))
+	assert.Equal(t, []string{"NAME", "TYPE"}, cfg.Views["v1/services"].Columns)
+	assert.Equal(t, 2, l.count)
+}
+
+func TestCustomViewRefreshURL(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("views:\n  apps/v1/deployments:\n    columns:\n      - NAME\n"))
+	}))
+	defer srv.Close()
+
+	cfg := config.NewCustomView()
+	assert.NoError(t, cfg.LoadURL(context.Background(), srv.URL))
+	assert.NoError(t, cfg.Refresh("testdata/views/views.yaml", "testdata/views.d"))
+	assert.Equal(t, []string{"NAME"}, cfg.Views["apps/v1/deployments"].Columns)
+	assert.Equal(t, []string{"NAME", "TYPE"}, cfg.Views["v1/services"].Columns)
+	assert.Equal(t, 3, len(cfg.Views))
+}
+
+func TestCustomViewRefreshKeepsViews(t *testing.T) {
+	cfg := config.NewCustomView()
+	assert.NoError(t, cfg.Refresh("testdata/views/exclude-ns.yaml", "testdata/views.d.not-there"))
+	assert.Equal(t, []string{"NAMESPACE", "NAME"}, cfg.Views["v1/pods"].Columns)
+
+	var le *config.LoadError
+	assert.ErrorAs(t, cfg.Refresh("testdata/views/schema-bad.yaml", "testdata/views.d.not-there"), &le)
+	assert.Equal(t, config.LoadValidateError, le.Kind)
+	assert.Equal(t, []string{"NAMESPACE", "NAME"}, cfg.Views["v1/pods"].Columns)
+
+	strict := config.CustomView{}
+	assert.Error(t, strict.Load("testdata/views/schema-bad.yaml"))
+}
+
+func TestCustomViewNotifyDelay(t *testing.T) {
+	cfg := config.NewCustomView()
+	cfg.NotifyDelay = time.Minute
+	l := viewListener{}
+	cfg.AddListener("v1/pods", &l)
+
+	assert.NoError(t, cfg.Load("testdata/views/views.yaml"))
+	assert.NoError(t, cfg.Load("testdata/views/equiv.yaml"))
+	assert.Equal(t, 0, l.count)
+
+	cfg.Flush()
+	assert.Equal(t, 1, l.count)
+	assert.Equal(t, []string{"NAMESPACE", "NAME", "jsonpath:{.spec.nodeName}|NODE"}, l.vs.Columns[:3])
+	cfg.Flush()
+	assert.Equal(t, 1, l.count)
+}
+
+func TestCustomViewNotifyLoop(t *testing.T) {
+	cfg := config.NewCustomView()
+	cfg.NotifyDelay = 5 * time.Millisecond
+	var l syncListener
+	cfg.AddListener("v1/pods", &l)
+
+	assert.NoError(t, cfg.Load("testdata/views/views.yaml"))
+	assert.NoError(t, cfg.Load("testdata/views/equiv.yaml"))
+	assert.Eventually(t, func() bool {
+		vs, n := l.get()
+		return n > 0 && slices.Contains(vs.Columns, "jsonpath:{.spec.nodeName}|NODE")
+	}, time.Second, time.Millisecond)
+}
+
+func TestCustomViewNotifyListener(t *testing.T) {
+	cfg := config.NewCustomView()
+	assert.NoError(t, cfg.Load("testdata/views/views.yaml"))
+	cfg.Views["v1/pods@fred"] = config.ViewSetting{Columns: []string{"NAME"}}
+	l := nsListener{ns: "blee"}
+	cfg.AddListener("v1/pods", &l)
+	assert.Equal(t, "NAMESPACE", l.vs.Columns[0])
+
+	l.ns = "fred"
+	cfg.NotifyListener("v1/pods")
+	assert.Equal(t, []string{"NAME"}, l.vs.Columns)
+
+	cfg.NotifyListener("v1/services")
+	assert.Equal(t, 2, l.count)
+}
+
+func TestCustomViewNotifyDispatch(t *testing.T) {
+	cfg := config.NewCustomView()
+	cfg.NotifyDelay = 5 * time.Millisecond
+	queue := make(chan func(), 10)
+	cfg.Dispatch = func(f func()) { queue <- f }
+	l := viewListener{}
+	cfg.AddListener("v1/pods", &l)
+
+	assert.NoError(t, cfg.Load("testdata/views/views.yaml"))
+	select {
+	case f := <-queue:
+		f()
+	case <-time.After(time.Second):
+		assert.Fail(t, "notification was not dispatched")
+	}
+	assert.Equal(t, 1, l.count)
+	assert.Equal(t, "NAMESPACE", l.vs.Columns[0])
+}
+
+func TestCustomViewInheritFrom(t *testing.T) {
+	cfg := config.NewCustomView()
+	assert.NoError(t, cfg.Load("testdata/views/inherit.yaml"))
+
+	uu := map[string]struct {
+		ns   string
+		cols []string
+		sort string
+	}{
+		"parent": {
+			ns:   "prod-.*",
+			cols: []string{"NAMESPACE", "NAME", "STATUS"},
+			sort: "NAME:asc",
+		},
+		"inherit": {
+			ns:   "prod-special",
+			cols: []string{"NAMESPACE", "NAME", "STATUS"},
+			sort: "STATUS:desc",
+		},
+		"chain": {
+			ns:   "prod-extra",
+			cols: []string{"NAMESPACE", "NAME", "STATUS"},
+		},
+		"override": {
+			ns:   "prod-own",
+			cols: []string{"NAME"},
+		},
+		"orphan": {
+			ns: "prod-orphan",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			_, vs := cfg.Explain("v1/pods", u.ns)
+			assert.NotNil(t, vs)
+			assert.Equal(t, u.cols, vs.Columns)
+			assert.Equal(t, u.sort, vs.SortColumn)
+		})
+	}
+
+	var le *config.LoadError
+	err := config.NewCustomView().Load("testdata/views/inherit-cycle.yaml")
+	assert.ErrorAs(t, err, &le)
+	assert.Equal(t, config.LoadValidateError, le.Kind)
+	assert.ErrorContains(t, err, "inheritFrom cycle")
+}
+
+func TestCustomViewInheritCycleAcrossFiles(t *testing.T) {
+	cfg := config.NewCustomView()
+	assert.NoError(t, cfg.Load("testdata/views/inherit.yaml"))
+
+	var le *config.LoadError
+	err := cfg.LoadDir("testdata/views-inherit.d")
+	assert.ErrorAs(t, err, &le)
+	assert.Equal(t, config.LoadValidateError, le.Kind)
+	assert.ErrorContains(t, err, "inheritFrom cycle")
+	assert.Len(t, cfg.Views, 5)
+
+	assert.ErrorContains(t, cfg.Refresh("testdata/views/inherit.yaml", "testdata/views-inherit.d"), "inheritFrom cycle")
+	assert.Len(t, cfg.Views, 5)
+}
+
+func TestCustomViewLoadJSON(t *testing.T) {
+	y, j := config.NewCustomView(), config.NewCustomView()
+	assert.NoError(t, y.Load("testdata/views/equiv.yaml"))
+	assert.NoError(t, j.Load("testdata/views/equiv.json"))
+	assert.Equal(t, 2, len(j.Views))
+	assert.Equal(t, y.Views, j.Views)
+
+	cfg := config.NewCustomView()
+	assert.NoError(t, cfg.LoadDir("testdata/views-json.d"))
+	assert.Equal(t, []string{"NAMESPACE", "NAME"}, cfg.Views["v1/pods"].Columns)
+	assert.Equal(t, []string{"NAME", "TYPE"}, cfg.Views["v1/services"].Columns)
+}
+
+func TestViewsJSONSchemaInSync(t *testing.T) {
+	var schema struct {
+		Properties  map[string]map[string]interface{} `json:"properties"`
+		Definitions map[string]struct {
+			Properties map[string]map[string]interface{} `json:"properties"`
+		} `json:"definitions"`
+	}
+	assert.NoError(t, json.Unmarshal(data.ViewsJSONSchema(), &schema))
+
+	for _, f := range yamlFields(reflect.TypeOf(config.CustomView{})) {
+		assert.Contains(t, schema.Properties, f)
+	}
+	ref := map[string]interface{}{"$ref": "#/definitions/view"}
+	assert.Equal(t, ref, schema.Properties["views"]["additionalProperties"])
+	props := schema.Definitions["view"].Properties
+	for _, f := range yamlFields(reflect.TypeOf(config.ViewSetting{})) {
+		assert.Contains(t, props, f)
+	}
+	assert.Equal(t, ref, props["presets"]["additionalProperties"])
+}
+
+func TestCustomViewResetGVR(t *testing.T) {
+	cfg := config.NewCustomView()
+	cfg.Views = map[string]config.ViewSetting{
+		"v1/pods":                                {Columns: []string{"NAME"}},
+		"v1/pods@default":                        {Columns: []string{"NAME"}},
+		"apps/v1/deployments":                    {Columns: []string{"NAME"}},
+		"argoproj.io/v1alpha1/applications":      {Columns: []string{"NAME"}},
+		"argoproj.io/v1alpha1/applicationsets":   {Columns: []string{"NAME"}},
+		"argoproj.io/v1alpha1/applicationsets@a": {Columns: []string{"NAME"}},
+	}
+	l := viewListener{}
+	cfg.AddListener("v1/pods", &l)
+
+	n, err := cfg.ResetGVR("v1/pods")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, n)
+	assert.Equal(t, 4, len(cfg.Views))
+	assert.True(t, l.vs.IsBlank())
+	n, err = cfg.ResetGVR("v1/services")
+	assert.NoError(t, err)
+	assert.Equal(t, 0, n)
+
+	n, err = cfg.ResetGVR("argoproj.io/v1alpha1/applications")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, n)
+	assert.Contains(t, cfg.Views, "argoproj.io/v1alpha1/applicationsets")
+	assert.Contains(t, cfg.Views, "argoproj.io/v1alpha1/applicationsets@a")
+}
+
+func TestCustomViewSetSort(t *testing.T) {
+	cfg := config.NewCustomView()
+	cfg.Views = map[string]config.ViewSetting{
+		"v1/pods@default": {Columns: []string{"NAME"}},
+	}
+	l := viewListener{}
+	cfg.AddListener("v1/pods", &l)
+
+	assert.NoError(t, cfg.SetSort("v1/pods", "default", "NAME:desc"))
+	assert.Equal(t, "NAME:desc", cfg.Views["v1/pods@default"].SortColumn)
+	assert.Equal(t, []string{"NAME"}, cfg.Views["v1/pods@default"].Columns)
+
+	assert.NoError(t, cfg.SetSort("v1/pods", "fred", config.NoSortColumn))
+	assert.Equal(t, config.NoSortColumn, cfg.Views["v1/pods"].SortColumn)
+	assert.Equal(t, config.NoSortColumn, l.vs.SortColumn)
+
+	assert.Error(t, cfg.SetSort("v1/pods", "", "NAME"))
+}
+
+func TestCustomViewNextPreset(t *testing.T) {
+	cfg := config.NewCustomView()
+	assert.NoError(t, cfg.Load("testdata/views/presets.yaml"))
+	l := viewListener{}
+	cfg.AddListener("v1/pods", &l)
+	assert.Equal(t, []string{"NAME"}, l.vs.Columns)
+	assert.Equal(t, "AGE:desc", l.vs.SortColumn)
+
+	n, err := cfg.NextPreset("v1/pods", "default")
+	assert.NoError(t, err)
+	assert.Equal(t, "wide", n)
+	assert.Equal(t, []string{"NAMESPACE", "NAME", "IP", "NODE"}, l.vs.Columns)
+	assert.Equal(t, "NAME:asc", l.vs.SortColumn)
+
+	n, err = cfg.NextPreset("v1/pods", "")
+	assert.NoError(t, err)
+	assert.Equal(t, "minimal", n)
+	assert.Equal(t, []string{"NAME"}, l.vs.Columns)
+
+	_, err = cfg.NextPreset("v1/services", "")
+	assert.Error(t, err)
+}
+
+func TestCustomViewApplyFilter(t *testing.T) {
+	cfg := config.NewCustomView()
+	assert.NoError(t, cfg.Load("testdata/views/filters.yaml"))
+	l := viewListener{}
+	cfg.AddListener("v1/pods", &l)
+	assert.Empty(t, l.vs.ActiveFilterText())
+
+	assert.NoError(t, cfg.ApplyFilter("v1/pods", "", "recent"))
+	assert.Equal(t, "recent", l.vs.ActiveFilter)
+	assert.Equal(t, "RESTARTS>=1", l.vs.ActiveFilterText())
+	assert.Error(t, cfg.ApplyFilter("v1/pods", "", "zorg"))
+	assert.Error(t, cfg.ApplyFilter("v1/services", "", "recent"))
+
+	n, err := cfg.NextFilter("v1/pods", "default")
+	assert.NoError(t, err)
+	assert.Equal(t, "mine", n)
+	n, err = cfg.NextFilter("v1/pods", "")
+	assert.NoError(t, err)
+	assert.Equal(t, "problems", n)
+	assert.Equal(t, "!Running", l.vs.ActiveFilterText())
+
+	_, err = cfg.NextFilter("v1/services", "")
+	assert.Error(t, err)
+}
+
+func TestCustomViewNextFilterConcurrent(t *testing.T) {
+	cfg := config.NewCustomView()
+	assert.NoError(t, cfg.Load("testdata/views/filters.yaml"))
+
+	var (
+		wg     sync.WaitGroup
+		mx     sync.Mutex
+		counts = make(map[string]int)
+	)
+	for range 30 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			n, err := cfg.NextFilter("v1/pods", "")
+			assert.NoError(t, err)
+			mx.Lock()
+			counts[n]++
+			mx.Unlock()
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, map[string]int{"mine": 10, "problems": 10, "recent": 10}, counts)
+	assert.Equal(t, "recent", cfg.Views["v1/pods"].ActiveFilter)
+}
+
+func TestCustomViewLoadSavedFilters(t *testing.T) {
+	err := config.NewCustomView().Load("testdata/views/filters-bad.yaml")
+	assert.ErrorContains(t, err, `saved filter "broken"`)
+}
+
+func TestViewSetting_Preset(t *testing.T) {
+	uu := map[string]struct {
+		vs   config.ViewSetting
+		cols []string
+		sort string
+	}{
+		"no-presets": {
+			vs:   config.ViewSetting{Columns: []string{"NAME"}, SortColumn: "NAME:asc"},
+			cols: []string{"NAME"},
+			sort: "NAME:asc",
+		},
+		"inactive": {
+			vs: config.ViewSetting{
+				Columns:    []string{"NAME"},
+				SortColumn: "NAME:asc",
+				Presets:    map[string]config.ViewSetting{"p1": {Columns: []string{"AGE"}}},
+			},
+			cols: []string{"NAME"},
+			sort: "NAME:asc",
+		},
+		"active": {
+			vs: config.ViewSetting{
+				Columns:    []string{"NAME"},
+				SortColumn: "NAME:asc",
+				Active:     "p1",
+				Presets:    map[string]config.ViewSetting{"p1": {Columns: []string{"AGE"}}},
+			},
+			cols: []string{"AGE"},
+			sort: "NAME:asc",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			vs := u.vs.Preset()
+			assert.Equal(t, u.cols, vs.Columns)
+			assert.Equal(t, u.sort, vs.SortColumn)
+		})
+	}
+}
+
+func TestCustomViewReadOnly(t *testing.T) {
+	cfg := config.NewCustomView()
+	assert.NoError(t, cfg.Load("testdata/views/readonly.yaml"))
+	assert.True(t, cfg.ReadOnly)
+
+	_, vs := cfg.Explain("v1/pods", "")
+	assert.Equal(t, []string{"NAMESPACE", "NAME"}, vs.Columns)
+
+	assert.ErrorIs(t, cfg.SetSort("v1/pods", "", "NAME:asc"), config.ErrReadOnly)
+	assert.Equal(t, "AGE:desc", cfg.Views["v1/pods"].SortColumn)
+	_, err := cfg.ResetGVR("v1/pods")
+	assert.ErrorIs(t, err, config.ErrReadOnly)
+	assert.Equal(t, 1, len(cfg.Views))
+	_, err = cfg.NextPreset("v1/pods", "")
+	assert.ErrorIs(t, err, config.ErrReadOnly)
+	assert.ErrorIs(t, cfg.ApplyFilter("v1/pods", "", "problems"), config.ErrReadOnly)
+	_, err = cfg.NextFilter("v1/pods", "")
+	assert.ErrorIs(t, err, config.ErrReadOnly)
+	assert.Empty(t, cfg.Views["v1/pods"].Active)
+	assert.Empty(t, cfg.Views["v1/pods"].ActiveFilter)
+
+	path := filepath.Join(t.TempDir(), "views.yaml")
+	assert.ErrorIs(t, cfg.Save(path), config.ErrReadOnly)
+	assert.NoFileExists(t, path)
+
+	assert.NoError(t, cfg.Load("testdata/views/views.yaml"))
+	assert.False(t, cfg.ReadOnly)
+	assert.NoError(t, cfg.Save(path))
+	assert.FileExists(t, path)
+}
+
+func TestCustomViewSaveRoundTrip(t *testing.T) {
+	assert.NoError(t, config.LoadViewProfiles("testdata/views/profiles"))
+	cfg := config.NewCustomView()
+	assert.NoError(t, cfg.Load("testdata/views/profile.yaml"))
+	assert.NoError(t, cfg.SetSort("v1/pods", "", "AGE:desc"))
+
+	path := filepath.Join(t.TempDir(), "views.yaml")
+	assert.NoError(t, cfg.Save(path))
+	bb, err := os.ReadFile(path)
+	assert.NoError(t, err)
+	assert.Equal(t, `views:
+  v1/pods:
+    columns:
+    - NAME
+    - STATUS
+    sortColumn: AGE:desc
+  v1/services:
+    columns:
+    - NAME
+    - PORTS
+profile: team
+`, string(bb))
+
+	saved := config.NewCustomView()
+	assert.NoError(t, saved.Load(path))
+	assert.Equal(t, cfg.Views, saved.Views)
+}
+
+func TestCustomViewConcurrentLoad(t *testing.T) {
+	cfg := config.NewCustomView()
+	assert.NoError(t, cfg.Load("testdata/views/views.yaml"))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 50; j++ {
+				assert.NoError(t, cfg.Load("testdata/views/views.yaml"))
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 50; j++ {
+				if _, vs := cfg.Explain("v1/pods", "default"); vs != nil {
+					vs.Columns[0] = "FRED"
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	_, vs := cfg.Explain("v1/pods", "")
+	assert.Equal(t, "NAMESPACE", vs.Columns[0])
+}
+
+func TestCustomViewExplain(t *testing.T) {
+	cfg := config.NewCustomView()
+	cfg.Views = map[string]config.ViewSetting{
+		"v1/pods":         {Columns: []string{"NAME"}},
+		"v1/pods@default": {Columns: []string{"NAMESPACE", "NAME"}},
+	}
+
+	uu := map[string]struct {
+		gvr, ns, key string
+		cols         []string
+	}{
+		"gvr": {
+			gvr:  "v1/pods",
+			ns:   "fred",
+			key:  "v1/pods",
+			cols: []string{"NAME"},
+		},
+		"all-ns": {
+			gvr:  "v1/pods",
+			key:  "v1/pods",
+			cols: []string{"NAME"},
+		},
+		"ns": {
+			gvr:  "v1/pods",
+			ns:   "default",
+			key:  "v1/pods@default",
+			cols: []string{"NAMESPACE", "NAME"},
+		},
+		"defaults": {
+			gvr: "v1/services",
+			ns:  "default",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			key, vs := cfg.Explain(u.gvr, u.ns)
+			assert.Equal(t, u.key, key)
+			if u.cols == nil {
+				assert.Nil(t, vs)
+				return
+			}
+			assert.Equal(t, u.cols, vs.Columns)
+			viewtest.AssertView(t, cfg, u.gvr, u.ns, u.cols)
+		})
+	}
+}
+
+func TestCustomViewScrollOffset(t *testing.T) {
+	cfg := config.NewCustomView()
+	cfg.Views = map[string]config.ViewSetting{
+		"v1/pods":     {Columns: []string{"NAME"}, KeepScroll: true},
+		"v1/services": {Columns: []string{"NAME"}},
+	}
+	cfg.SetScrollOffset("v1/pods", "default", 2)
+	cfg.SetScrollOffset("v1/pods", "", 4)
+	cfg.SetScrollOffset("v1/services", "default", 2)
+
+	uu := map[string]struct {
+		gvr, ns string
+		e       int
+	}{
+		"ns": {
+			gvr: "v1/pods",
+			ns:  "default",
+			e:   2,
+		},
+		"all-ns": {
+			gvr: "v1/pods",
+			e:   4,
+		},
+		"unknown-ns": {
+			gvr: "v1/pods",
+			ns:  "fred",
+		},
+		"no-keep": {
+			gvr: "v1/services",
+			ns:  "default",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			_, vs := cfg.Explain(u.gvr, u.ns)
+			assert.Equal(t, u.e, vs.ScrollOffset)
+		})
+	}
+}
+
+func TestViewSetting_SortCols(t *testing.T) {
+	uu := map[string]struct {
+		spec string
+		e    []config.SortSpec
+		err  string
+	}{
+		"blank": {
+			err: "no sort column specified",
+		},
+		"single": {
+			spec: "AGE:desc",
+			e:    []config.SortSpec{{Name: "AGE", Desc: true}},
+		},
+		"chained": {
+			spec: "STATUS:asc, AGE:desc",
+			e:    []config.SortSpec{{Name: "STATUS"}, {Name: "AGE", Desc: true}},
+		},
+		"no-order": {
+			spec: "STATUS",
+			err:  `invalid sort column spec #0: "STATUS". must be col-name:asc|desc`,
+		},
+		"bad-segment": {
+			spec: "STATUS:asc,AGE:up",
+			err:  `invalid sort column spec #1: "AGE:up". must be col-name:asc|desc`,
+		},
+		"empty-segment": {
+			spec: "STATUS:asc,",
+			err:  `invalid sort column spec #1: "". must be col-name:asc|desc`,
+		},
+		"no-sort": {
+			spec: config.NoSortColumn,
+			err:  config.ErrNoSort.Error(),
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			vs := config.ViewSetting{SortColumn: u.spec}
+			specs, err := vs.SortCols()
+			if u.err != "" {
+				assert.EqualError(t, err, u.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, u.e, specs)
+		})
+	}
+}
+
+func TestViewSetting_EnterTarget(t *testing.T) {
+	uu := map[string]struct {
+		action, gvr, col string
+		err              bool
+	}{
+		"plain": {action: "v1/pods:POD", gvr: "v1/pods", col: "POD"},
+		"crd":   {action: "fred.io/v1alpha1/blees:BLEE", gvr: "fred.io/v1alpha1/blees", col: "BLEE"},
+		"none":  {err: true},
+		"no-col": {
+			action: "v1/pods:",
+			err:    true,
+		},
+		"no-gvr": {
+			action: ":POD",
+			err:    true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			vs := config.ViewSetting{EnterAction: u.action}
+			gvr, col, err := vs.EnterTarget()
+			if u.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, u.gvr, gvr)
+			assert.Equal(t, u.col, col)
+		})
+	}
+}
+
+func TestViewSetting_TimeLayout(t *testing.T) {
+	uu := map[string]struct {
+		f, e string
+		err  string
+	}{
+		"blank":    {},
+		"relative": {f: config.RelativeTime},
+		"absolute": {f: config.AbsoluteTime, e: time.RFC3339},
+		"layout":   {f: "2006-01-02 15:04", e: "2006-01-02 15:04"},
+		"no-tokens": {
+			f:   "bozo",
+			err: `invalid time layout "bozo"`,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			vs := config.ViewSetting{TimeFormat: u.f}
+			l, err := vs.TimeLayout()
+			if u.err != "" {
+				assert.EqualError(t, err, u.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, u.e, l)
+		})
+	}
+}
+
+func TestViewSetting_HighlightFor(t *testing.T) {
+	uu := map[string]struct {
+		vs  config.ViewSetting
+		e   time.Duration
+		err string
+	}{
+		"off": {
+			vs: config.ViewSetting{HighlightDuration: "5s"},
+		},
+		"default": {
+			vs: config.ViewSetting{HighlightChanges: true},
+			e:  config.DefaultHighlightDuration,
+		},
+		"custom": {
+			vs: config.ViewSetting{HighlightChanges: true, HighlightDuration: "500ms"},
+			e:  500 * time.Millisecond,
+		},
+		"negative": {
+			vs:  config.ViewSetting{HighlightChanges: true, HighlightDuration: "-1s"},
+			err: `invalid highlight duration "-1s". must be positive`,
+		},
+		"toast": {
+			vs:  config.ViewSetting{HighlightChanges: true, HighlightDuration: "bozo"},
+			err: `invalid highlight duration "bozo": time: invalid duration "bozo"`,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			d, err := u.vs.HighlightFor()
+			if u.err != "" {
+				assert.EqualError(t, err, u.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, u.e, d)
+		})
+	}
+}
+
+func TestViewSetting_KubectlOrderCols(t *testing.T) {
+	uu := map[string]struct {
+		vs      config.ViewSetting
+		cols, e []string
+	}{
+		"off": {
+			vs:   config.ViewSetting{KubectlColumns: []string{"B", "A"}},
+			cols: []string{"A", "X", "B"},
+			e:    []string{"A", "X", "B"},
+		},
+		"unresolved": {
+			vs:   config.ViewSetting{KubectlOrder: true},
+			cols: []string{"A", "X", "B"},
+			e:    []string{"A", "X", "B"},
+		},
+		"reorder": {
+			vs:   config.ViewSetting{KubectlOrder: true, KubectlColumns: []string{"B", "Z", "A"}},
+			cols: []string{"A", "X", "B"},
+			e:    []string{"B", "X", "A"},
+		},
+		"dups": {
+			vs:   config.ViewSetting{KubectlOrder: true, KubectlColumns: []string{"B", "A"}},
+			cols: []string{"A", "A", "B"},
+			e:    []string{"B", "A", "B"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, u.vs.KubectlOrderCols(u.cols))
+		})
+	}
+}
+
+func TestViewSetting_MaskSpecs(t *testing.T) {
+	uu := map[string]struct {
+		vs  config.ViewSetting
+		e   map[string]int
+		err string
+	}{
+		"none": {},
+		"plain": {
+			vs: config.ViewSetting{Columns: []string{"NAME", "TOKEN"}, Mask: []string{"TOKEN"}},
+			e:  map[string]int{"TOKEN": 0},
+		},
+		"reveal": {
+			vs: config.ViewSetting{Mask: []string{"TOKEN:4", "KEY: 2"}},
+			e:  map[string]int{"TOKEN": 4, "KEY": 2},
+		},
+		"blank": {
+			vs:  config.ViewSetting{Mask: []string{":4"}},
+			err: `invalid mask ":4". must be COL or COL:N`,
+		},
+		"negative": {
+			vs:  config.ViewSetting{Mask: []string{"TOKEN:-1"}},
+			err: `invalid mask "TOKEN:-1". reveal count must be a non-negative integer`,
+		},
+		"toast": {
+			vs:  config.ViewSetting{Mask: []string{"TOKEN:bozo"}},
+			err: `invalid mask "TOKEN:bozo". reveal count must be a non-negative integer`,
+		},
+		"dup": {
+			vs:  config.ViewSetting{Mask: []string{"TOKEN", "TOKEN:2"}},
+			err: `mask column "TOKEN" listed more than once`,
+		},
+		"unknown": {
+			vs:  config.ViewSetting{Columns: []string{"NAME"}, Mask: []string{"TOKEN"}},
+			err: `mask column "TOKEN" is not a view column`,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			mm, err := u.vs.MaskSpecs()
+			if u.err != "" {
+				assert.EqualError(t, err, u.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, u.e, mm)
+		})
+	}
+}
+
+func TestViewSetting_ExpandPrinterColumns(t *testing.T) {
+	uu := map[string]struct {
+		cols, cc, e []string
+	}{
+		"printer": {
+			cols: []string{"@printer"},
+			cc:   []string{"NAME", "READY", "AGE"},
+			e:    []string{"NAME", "READY", "AGE"},
+		},
+		"mixed": {
+			cols: []string{"NAMESPACE", "@printer", "NAME"},
+			cc:   []string{"NAME", "READY"},
+			e:    []string{"NAMESPACE", "NAME", "READY"},
+		},
+		"fallback": {
+			cols: []string{"@printer"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			vs := config.ViewSetting{Columns: u.cols}
+			assert.True(t, vs.HasPrinterColumns())
+			evs := vs.ExpandPrinterColumns(u.cc)
+			assert.Equal(t, u.e, evs.Columns)
+			assert.False(t, evs.HasPrinterColumns())
+		})
+	}
+}
+
+func TestViewSetting_Hash(t *testing.T) {
+	uu := map[string]struct {
+		vs1, vs2 config.ViewSetting
+		same     bool
+	}{
+		"same": {
+			vs1:  config.ViewSetting{Columns: []string{"A", "B"}, SortColumn: "A:asc"},
+			vs2:  config.ViewSetting{Columns: []string{"A", "B"}, SortColumn: "A:asc"},
+			same: true,
+		},
+		"cols-order": {
+			vs1: config.ViewSetting{Columns: []string{"A", "B"}},
+			vs2: config.ViewSetting{Columns: []string{"B", "A"}},
+		},
+		"sort": {
+			vs1: config.ViewSetting{Columns: []string{"A"}, SortColumn: "A:asc"},
+			vs2: config.ViewSetting{Columns: []string{"A"}, SortColumn: "A:desc"},
+		},
+		"transform": {
+			vs1:  config.ViewSetting{Transform: map[string]string{"A": "quantity", "B": "quantity"}},
+			vs2:  config.ViewSetting{Transform: map[string]string{"B": "quantity", "A": "quantity"}},
+			same: true,
+		},
+		"other": {
+			vs1: config.ViewSetting{Columns: []string{"A"}},
+			vs2: config.ViewSetting{Columns: []string{"A"}, DefaultFilter: "fred"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.NotEmpty(t, u.vs1.Hash())
+			assert.Equal(t, u.same, u.vs1.Hash() == u.vs2.Hash())
+		})
+	}
+}
+
+func TestCustomViewHash(t *testing.T) {
+	cfg1, cfg2 := config.NewCustomView(), config.NewCustomView()
+	assert.Equal(t, cfg1.Hash(), cfg2.Hash())
+
+	assert.NoError(t, cfg1.Load("testdata/views/views.yaml"))
+	assert.NoError(t, cfg2.Load("testdata/views/views.yaml"))
+	assert.Equal(t, cfg1.Hash(), cfg2.Hash())
+
+	assert.NoError(t, cfg2.SetSort("v1/pods", "", "NAME:asc"))
+	assert.NotEqual(t, cfg1.Hash(), cfg2.Hash())
+}
+
 func TestViewSetting_Equals(t *testing.T) {
 	tests := []struct {
 		v1, v2 *config.ViewSetting
@@ -32,9 +1371,68 @@ func TestViewSetting_Equals(t *testing.T) {
 		{&config.ViewSetting{Columns: []string{"A"}}, &config.ViewSetting{Columns: []string{"B"}}, false},
 		{&config.ViewSetting{SortColumn: "A"}, &config.ViewSetting{SortColumn: "B"}, false},
 		{&config.ViewSetting{SortColumn: "A"}, &config.ViewSetting{SortColumn: "A"}, true},
+		{&config.ViewSetting{Theme: "A"}, &config.ViewSetting{Theme: "B"}, false},
+		{&config.ViewSetting{Theme: "A"}, &config.ViewSetting{Theme: "A"}, true},
+		{&config.ViewSetting{GroupBy: "A"}, &config.ViewSetting{GroupBy: "B"}, false},
+		{&config.ViewSetting{GroupBy: "A", GroupSum: []string{"B"}}, &config.ViewSetting{GroupBy: "A"}, false},
 	}
 
 	for _, tt := range tests {
 		assert.Equalf(t, tt.equals, tt.v1.Equals(tt.v2), "%#v and %#v", tt.v1, tt.v2)
 	}
 }
+
+// Helpers...
+
+type viewListener struct {
+	vs    config.ViewSetting
+	count int
+}
+
+func (l *viewListener) ViewSettingsChanged(vs config.ViewSetting) {
+	l.vs = vs
+	l.count++
+}
+
+type nsListener struct {
+	viewListener
+	ns string
+}
+
+func (l *nsListener) ViewNamespace() string {
+	return l.ns
+}
+
+type syncListener struct {
+	vs    config.ViewSetting
+	count int
+	mx    sync.Mutex
+}
+
+func (l *syncListener) ViewSettingsChanged(vs config.ViewSetting) {
+	l.mx.Lock()
+	defer l.mx.Unlock()
+
+	l.vs = vs
+	l.count++
+}
+
+func (l *syncListener) get() (config.ViewSetting, int) {
+	l.mx.Lock()
+	defer l.mx.Unlock()
+
+	return l.vs, l.count
+}
+
+func yamlFields(t reflect.Type) []string {
+	ff := make([]string, 0, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		tag := t.Field(i).Tag.Get("yaml")
+		if tag == "" || tag == "-" {
+			continue
+		}
+		ff = append(ff, strings.Split(tag, ",")[0])
+	}
+
+	return ff
+}
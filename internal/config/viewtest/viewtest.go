@@ -0,0 +1,38 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+// Package viewtest provides testing helpers for views configurations.
+package viewtest
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+// AssertView asserts a gvr in a given namespace resolves to the expected
+// columns. Expecting no columns asserts defaults apply.
+func AssertView(t testing.TB, cv *config.CustomView, gvr, ns string, want []string) bool {
+	t.Helper()
+
+	cols := cv.EffectiveColumns(gvr, ns)
+	if len(want) == 0 {
+		return assert.Empty(t, cols, "expected default columns for %q in %q", gvr, ns)
+	}
+
+	return assert.Equal(t, want, cols, "columns mismatch for %q in %q", gvr, ns)
+}
+
+// LoadAndAssertView loads a views file and asserts a gvr in a given namespace
+// resolves to the expected columns.
+func LoadAndAssertView(t testing.TB, path, gvr, ns string, want []string) bool {
+	t.Helper()
+
+	cv := config.NewCustomView()
+	if !assert.NoError(t, cv.Load(path)) {
+		return false
+	}
+
+	return AssertView(t, cv, gvr, ns, want)
+}
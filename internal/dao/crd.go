@@ -3,6 +3,15 @@
 
 package dao
 
+import (
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
 var (
 	_ Accessor = (*CustomResourceDefinition)(nil)
 	_ Nuker    = (*CustomResourceDefinition)(nil)
@@ -12,3 +21,49 @@ var (
 type CustomResourceDefinition struct {
 	Resource
 }
+
+// PrinterColumns returns the column names of a custom resource printer columns
+// as rendered by the server side tables. Namespaced resources lead with a
+// namespace column.
+func PrinterColumns(f Factory, gvr client.GVR) ([]string, error) {
+	path := gvr.R() + "." + gvr.G()
+	o, err := f.Get(crdGVR, path, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	if o == nil {
+		return nil, fmt.Errorf("no crd found for %q", gvr)
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("expecting *Unstructured but got %T", o)
+	}
+	vv, _, _ := unstructured.NestedSlice(u.Object, "spec", "versions")
+	for _, v := range vv {
+		m, ok := v.(map[string]interface{})
+		if !ok || m["name"] != gvr.V() {
+			continue
+		}
+		pp, _, _ := unstructured.NestedSlice(m, "additionalPrinterColumns")
+		if len(pp) == 0 {
+			break
+		}
+		cc := make([]string, 0, len(pp)+2)
+		if scope, _, _ := unstructured.NestedString(u.Object, "spec", "scope"); scope != "Cluster" {
+			cc = append(cc, "NAMESPACE")
+		}
+		cc = append(cc, "NAME")
+		for _, p := range pp {
+			pm, ok := p.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if n, _ := pm["name"].(string); n != "" {
+				cc = append(cc, strings.ToUpper(n))
+			}
+		}
+		return cc, nil
+	}
+
+	return nil, fmt.Errorf("no printer columns found for %q", gvr)
+}
@@ -0,0 +1,88 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package dao_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestPrinterColumns(t *testing.T) {
+	f := &testFactory{
+		inventory: map[string]map[string][]runtime.Object{
+			"": {
+				"apiextensions.k8s.io/v1/customresourcedefinitions": {
+					makeCRD("blees", "Namespaced"),
+					makeCRD("zorgs", "Cluster"),
+				},
+			},
+		},
+	}
+
+	uu := map[string]struct {
+		gvr string
+		e   []string
+		err bool
+	}{
+		"printer": {
+			gvr: "fred.io/v1/blees",
+			e:   []string{"NAMESPACE", "NAME", "READY", "REPLICAS", "AGE"},
+		},
+		"cluster": {
+			gvr: "fred.io/v1/zorgs",
+			e:   []string{"NAME", "READY", "REPLICAS", "AGE"},
+		},
+		"no-printer": {
+			gvr: "fred.io/v1beta1/blees",
+			err: true,
+		},
+		"no-crd": {
+			gvr: "fred.io/v1/blahs",
+			err: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			cc, err := dao.PrinterColumns(f, client.NewGVR(u.gvr))
+			if u.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, u.e, cc)
+		})
+	}
+}
+
+// Helpers...
+
+func makeCRD(res, scope string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apiextensions.k8s.io/v1",
+		"kind":       "CustomResourceDefinition",
+		"metadata":   map[string]interface{}{"name": res + ".fred.io"},
+		"spec": map[string]interface{}{
+			"group": "fred.io",
+			"scope": scope,
+			"versions": []interface{}{
+				map[string]interface{}{"name": "v1beta1"},
+				map[string]interface{}{
+					"name": "v1",
+					"additionalPrinterColumns": []interface{}{
+						map[string]interface{}{"name": "Ready", "type": "string", "jsonPath": ".status.ready"},
+						map[string]interface{}{"name": "Replicas", "type": "integer", "jsonPath": ".spec.replicas"},
+						map[string]interface{}{"name": "Age", "type": "date", "jsonPath": ".metadata.creationTimestamp"},
+					},
+				},
+			},
+		},
+	}}
+}
@@ -16,6 +16,7 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/cli-runtime/pkg/printers"
+	"sigs.k8s.io/yaml"
 )
 
 const (
@@ -100,6 +101,29 @@ func ToYAML(o runtime.Object, showManaged bool) (string, error) {
 	return buff.String(), nil
 }
 
+// StripYAML removes managed fields and/or status from a resource YAML.
+func StripYAML(raw string, managed, status bool) (string, error) {
+	if !managed && !status {
+		return raw, nil
+	}
+	var o map[string]interface{}
+	if err := yaml.Unmarshal([]byte(raw), &o); err != nil {
+		return "", err
+	}
+	if meta, ok := o["metadata"].(map[string]interface{}); ok && managed {
+		delete(meta, "managedFields")
+	}
+	if status {
+		delete(o, "status")
+	}
+	bb, err := yaml.Marshal(o)
+	if err != nil {
+		return "", err
+	}
+
+	return string(bb), nil
+}
+
 // serviceAccountMatches validates that the ServiceAccount referenced in the PodSpec matches the incoming
 // ServiceAccount. If the PodSpec ServiceAccount is blank kubernetes will use the "default" ServiceAccount
 // when deploying the pod, so if the incoming SA is "default" and podSA is an empty string that is also a match.
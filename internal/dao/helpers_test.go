@@ -92,3 +92,61 @@ func TestContinuousRanges(t *testing.T) {
 		assert.Equal(t, tt.Ranges, ContinuousRanges(tt.Indexes))
 	}
 }
+
+func TestStripYAML(t *testing.T) {
+	raw := `apiVersion: v1
+kind: Pod
+metadata:
+  managedFields:
+  - manager: kubectl
+    operation: Update
+  name: fred
+spec:
+  containers:
+  - name: c1
+status:
+  phase: Running
+`
+	uu := map[string]struct {
+		managed, status bool
+		e               string
+	}{
+		"none": {
+			e: raw,
+		},
+		"managed": {
+			managed: true,
+			e: `apiVersion: v1
+kind: Pod
+metadata:
+  name: fred
+spec:
+  containers:
+  - name: c1
+status:
+  phase: Running
+`,
+		},
+		"all": {
+			managed: true,
+			status:  true,
+			e: `apiVersion: v1
+kind: Pod
+metadata:
+  name: fred
+spec:
+  containers:
+  - name: c1
+`,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			s, err := StripYAML(raw, u.managed, u.status)
+			assert.NoError(t, err)
+			assert.Equal(t, u.e, s)
+		})
+	}
+}
@@ -6,9 +6,12 @@ package dao
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
+	"github.com/rs/zerolog/log"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -76,9 +79,98 @@ func (t *Table) List(ctx context.Context, ns string) ([]runtime.Object, error) {
 	return []runtime.Object{o}, nil
 }
 
+// ListPages lists resources using limit/continue requests.
+func (t *Table) ListPages(ctx context.Context, ns string, size, pages int) ([]runtime.Object, bool, error) {
+	labelSel, _ := ctx.Value(internal.KeyLabels).(string)
+	fieldSel, _ := ctx.Value(internal.KeyFields).(string)
+
+	f, p := t.codec()
+	c, err := t.getClient(f)
+	if err != nil {
+		return nil, false, err
+	}
+	a := fmt.Sprintf(gvFmt, metav1.SchemeGroupVersion.Version, metav1.GroupName)
+	opts := metav1.ListOptions{
+		LabelSelector: labelSel,
+		FieldSelector: fieldSel,
+		Limit:         int64(size),
+	}
+	var res *metav1.Table
+	for i := 0; i < pages; i++ {
+		o, err := c.Get().
+			SetHeader("Accept", a).
+			Namespace(ns).
+			Resource(t.gvr.R()).
+			VersionedParams(&opts, p).
+			Do(ctx).Get()
+		if err != nil {
+			if apierrors.IsResourceExpired(err) {
+				log.Debug().Msgf("Continue token expired for %q. Falling back to full list", t.gvr)
+				oo, err := t.List(ctx, ns)
+				return oo, false, err
+			}
+			return nil, false, err
+		}
+		tt, ok := o.(*metav1.Table)
+		if !ok {
+			return []runtime.Object{o}, false, nil
+		}
+		if res == nil {
+			res = tt
+			if tt.Continue == "" && len(tt.Rows) > size {
+				log.Debug().Msgf("Pagination not supported for %q. Using full list", t.gvr)
+			}
+		} else {
+			res.Rows = append(res.Rows, tt.Rows...)
+			res.Continue = tt.Continue
+		}
+		if tt.Continue == "" {
+			break
+		}
+		opts.Continue = tt.Continue
+	}
+
+	return []runtime.Object{res}, res.Continue != "", nil
+}
+
+// KubectlColumns returns the column names of a resource server side table in
+// the order kubectl get -o wide renders them.
+func KubectlColumns(f Factory, gvr client.GVR, ns string) ([]string, error) {
+	var t Table
+	t.Init(f, gvr)
+	if ns = client.CleanseNamespace(ns); client.IsClusterScoped(ns) {
+		ns = client.BlankNamespace
+	}
+	oo, _, err := t.ListPages(context.Background(), ns, 1, 1)
+	if err != nil {
+		return nil, err
+	}
+	if len(oo) == 0 {
+		return nil, fmt.Errorf("no table returned for %q", gvr)
+	}
+	tt, ok := oo[0].(*metav1.Table)
+	if !ok {
+		return nil, fmt.Errorf("expecting *Table but got %T", oo[0])
+	}
+
+	return tableColumns(tt, gvr)
+}
+
 // ----------------------------------------------------------------------------
 // Helpers...
 
+func tableColumns(tt *metav1.Table, gvr client.GVR) ([]string, error) {
+	if len(tt.ColumnDefinitions) == 0 {
+		return nil, fmt.Errorf("no printer columns found for %q", gvr)
+	}
+	cc := make([]string, 0, len(tt.ColumnDefinitions))
+	for _, d := range tt.ColumnDefinitions {
+		cc = append(cc, strings.ToUpper(d.Name))
+	}
+
+	return cc, nil
+}
+
 func (t *Table) getClient(f serializer.CodecFactory) (*rest.RESTClient, error) {
 	cfg, err := t.Client().RestConfig()
 	if err != nil {
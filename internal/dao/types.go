@@ -69,6 +69,12 @@ type Lister interface {
 	List(ctx context.Context, ns string) ([]runtime.Object, error)
 }
 
+// Pager represents a resource lister supporting server side pagination.
+type Pager interface {
+	// ListPages returns up to pages of the given size and whether more resources are available.
+	ListPages(ctx context.Context, ns string, size, pages int) ([]runtime.Object, bool, error)
+}
+
 // Accessor represents an accessible k8s resource.
 type Accessor interface {
 	Lister
@@ -5,6 +5,7 @@ package internal
 
 import (
 	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/derailed/k9s/internal/view/cmd"
@@ -14,8 +15,16 @@ var (
 	inverseRx = regexp.MustCompile(`\A\!`)
 	fuzzyRx   = regexp.MustCompile(`\A-f\s?([\w-]+)\b`)
 	labelRx   = regexp.MustCompile(`\A\-l`)
+	compareRx = regexp.MustCompile(`\A!?([\w%/-]+)\s*(>=|<=|>|<)\s*(-?\d+(?:\.\d+)?)\s*\z`)
+	columnRx  = regexp.MustCompile(`\A(!?)([A-Za-z%][\w%/-]*):(\S+)\z`)
 )
 
+// ColumnSelector represents a filter scoped to a column ie node:gke-.
+type ColumnSelector struct {
+	Column, Value string
+	Inverse       bool
+}
+
 // Helpers...
 
 // IsInverseSelector checks if inverse char has been provided.
@@ -31,10 +40,50 @@ func IsLabelSelector(s string) bool {
 	if labelRx.MatchString(s) {
 		return true
 	}
+	if _, _, _, ok := IsCompareSelector(s); ok {
+		return false
+	}
+	if _, ok := IsColumnSelector(s); ok {
+		return false
+	}
 
 	return !strings.Contains(s, " ") && cmd.ToLabels(s) != nil
 }
 
+// IsCompareSelector checks if query is a numeric column comparison ie restarts>5.
+// Returns the column name, operator and value.
+func IsCompareSelector(s string) (string, string, float64, bool) {
+	mm := compareRx.FindStringSubmatch(s)
+	if len(mm) != 4 {
+		return "", "", 0, false
+	}
+	n, err := strconv.ParseFloat(mm[3], 64)
+	if err != nil {
+		return "", "", 0, false
+	}
+
+	return mm[1], mm[2], n, true
+}
+
+// IsColumnSelector checks if query is made of space separated column scoped
+// filters ie NODE:gke- !STATUS:Running.
+func IsColumnSelector(s string) ([]ColumnSelector, bool) {
+	ff := strings.Fields(s)
+	if len(ff) == 0 {
+		return nil, false
+	}
+	cc := make([]ColumnSelector, 0, len(ff))
+	for _, f := range ff {
+		mm := columnRx.FindStringSubmatch(f)
+		if len(mm) != 4 {
+			return nil, false
+		}
+		cc = append(cc, ColumnSelector{Column: mm[2], Value: mm[3], Inverse: mm[1] != ""})
+	}
+
+	return cc, true
+}
+
 // IsFuzzySelector checks if query is fuzzy.
 func IsFuzzySelector(s string) (string, bool) {
 	mm := fuzzyRx.FindStringSubmatch(s)
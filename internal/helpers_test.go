@@ -22,6 +22,8 @@ func TestIsLabelSelector(t *testing.T) {
 		"wrong-flag":  {s: "-f app=fred,env=blee"},
 		"missing-key": {s: "=fred"},
 		"missing-val": {s: "fred="},
+		"compare":     {s: "restarts>=5"},
+		"column":      {s: "LABELS:app=fred"},
 	}
 
 	for k := range uu {
@@ -31,3 +33,69 @@ func TestIsLabelSelector(t *testing.T) {
 		})
 	}
 }
+
+func TestIsColumnSelector(t *testing.T) {
+	uu := map[string]struct {
+		s  string
+		cc []internal.ColumnSelector
+		ok bool
+	}{
+		"empty": {s: ""},
+		"plain": {s: "fred"},
+		"cool": {
+			s:  "NODE:gke-",
+			cc: []internal.ColumnSelector{{Column: "NODE", Value: "gke-"}},
+			ok: true,
+		},
+		"multi": {
+			s: "node:gke- !STATUS:Running",
+			cc: []internal.ColumnSelector{
+				{Column: "node", Value: "gke-"},
+				{Column: "STATUS", Value: "Running", Inverse: true},
+			},
+			ok: true,
+		},
+		"mixed":     {s: "NODE:gke- fred"},
+		"no-value":  {s: "NODE:"},
+		"no-column": {s: ":gke-"},
+		"time":      {s: "10:30"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			cc, ok := internal.IsColumnSelector(u.s)
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.cc, cc)
+		})
+	}
+}
+
+func TestIsCompareSelector(t *testing.T) {
+	uu := map[string]struct {
+		s       string
+		col, op string
+		n       float64
+		ok      bool
+	}{
+		"empty":    {s: ""},
+		"plain":    {s: "fred"},
+		"gt":       {s: "RESTARTS>5", col: "RESTARTS", op: ">", n: 5, ok: true},
+		"ge":       {s: "restarts >= 5", col: "restarts", op: ">=", n: 5, ok: true},
+		"le":       {s: "%CPU/R<=-1.5", col: "%CPU/R", op: "<=", n: -1.5, ok: true},
+		"inverse":  {s: "!RESTARTS<5", col: "RESTARTS", op: "<", n: 5, ok: true},
+		"not-num":  {s: "RESTARTS>fred"},
+		"trailing": {s: "RESTARTS>5 fred"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			col, op, n, ok := internal.IsCompareSelector(u.s)
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.col, col)
+			assert.Equal(t, u.op, op)
+			assert.Equal(t, u.n, n)
+		})
+	}
+}
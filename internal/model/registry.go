@@ -114,8 +114,9 @@ var Registry = map[string]ResourceMeta{
 		Renderer: &render.ConfigMap{},
 	},
 	"v1/nodes": {
-		DAO:      &dao.Node{},
-		Renderer: &render.Node{},
+		DAO:          &dao.Node{},
+		Renderer:     &render.Node{},
+		TreeRenderer: &xray.Node{},
 	},
 	"v1/services": {
 		DAO:          &dao.Service{},
@@ -133,7 +134,8 @@ var Registry = map[string]ResourceMeta{
 		Renderer: &render.PersistentVolume{},
 	},
 	"v1/persistentvolumeclaims": {
-		Renderer: &render.PersistentVolumeClaim{},
+		Renderer:     &render.PersistentVolumeClaim{},
+		TreeRenderer: &xray.PersistentVolumeClaim{},
 	},
 
 	// Apps...
@@ -162,14 +164,27 @@ var Registry = map[string]ResourceMeta{
 		Renderer: &render.NetworkPolicy{},
 	},
 
+	// Gateway API...
+	xray.GatewayGVR: {
+		DAO:          &dao.Table{},
+		Renderer:     &render.Generic{},
+		TreeRenderer: &xray.Gateway{},
+	},
+	xray.HTTPRouteGVR: {
+		DAO:          &dao.Table{},
+		Renderer:     &render.Generic{},
+		TreeRenderer: &xray.HTTPRoute{},
+	},
+
 	// Batch...
 	"batch/v1/cronjobs": {
 		DAO:      &dao.CronJob{},
 		Renderer: &render.CronJob{},
 	},
-	"batch/v1/jobs": {
-		DAO:      &dao.Job{},
-		Renderer: &render.Job{},
+	xray.JobGVR: {
+		DAO:          &dao.Job{},
+		Renderer:     &render.Job{},
+		TreeRenderer: &xray.Job{},
 	},
 
 	// CRDs...
@@ -13,6 +13,7 @@ import (
 	backoff "github.com/cenkalti/backoff/v4"
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/model1"
 	"github.com/rs/zerolog/log"
@@ -40,6 +41,9 @@ type Table struct {
 	refreshRate time.Duration
 	instance    string
 	labelFilter string
+	pageSize    int
+	pages       int
+	hasMore     bool
 	mx          sync.RWMutex
 }
 
@@ -68,6 +72,51 @@ func (t *Table) GetLabelFilter() string {
 	return t.labelFilter
 }
 
+// SetJSONPathCols sets columns computed from the raw resources.
+func (t *Table) SetJSONPathCols(cc []config.JSONPathCol) {
+	t.data.SetJSONPathCols(cc)
+}
+
+// SetConditionCols toggles columns computed from the resources status conditions.
+func (t *Table) SetConditionCols(b bool) {
+	t.data.SetConditionCols(b)
+}
+
+// SetStatusFrom sets a status column derived from the resources status conditions.
+func (t *Table) SetStatusFrom(s *config.StatusFrom) {
+	t.data.SetStatusFrom(s)
+}
+
+// SetTimeStamps toggles recording the resources raw timestamps.
+func (t *Table) SetTimeStamps(b bool) {
+	t.data.SetTimeStamps(b)
+}
+
+// SetPageSize sets the server side listing page size. Zero lists all resources.
+func (t *Table) SetPageSize(n int) {
+	t.mx.Lock()
+	defer t.mx.Unlock()
+
+	if t.pageSize != n {
+		t.pageSize, t.pages, t.hasMore = n, 1, false
+	}
+}
+
+// NextPage requests an additional page on the next refresh.
+// Returns false if no more resources are available.
+func (t *Table) NextPage() bool {
+	t.mx.Lock()
+	defer t.mx.Unlock()
+
+	if t.pageSize <= 0 || !t.hasMore {
+		return false
+	}
+	t.pages++
+	t.hasMore = false
+
+	return true
+}
+
 // SetInstance sets a single entry table.
 func (t *Table) SetInstance(path string) {
 	t.instance = path
@@ -231,14 +280,31 @@ func (t *Table) list(ctx context.Context, a dao.Accessor) ([]runtime.Object, err
 
 	t.mx.RLock()
 	ctx = context.WithValue(ctx, internal.KeyLabels, t.labelFilter)
+	size, pages := t.pageSize, t.pages
 	t.mx.RUnlock()
 
 	ns := client.CleanseNamespace(t.data.GetNamespace())
 	if client.IsClusterScoped(ns) {
 		ns = client.BlankNamespace
 	}
+	if size <= 0 {
+		return a.List(ctx, ns)
+	}
+
+	pager, ok := a.(dao.Pager)
+	if !ok {
+		log.Debug().Msgf("Pagination not supported for %q. Using full list", t.gvr)
+		return a.List(ctx, ns)
+	}
+	oo, more, err := pager.ListPages(ctx, ns, size, pages)
+	if err != nil {
+		return nil, err
+	}
+	t.mx.Lock()
+	t.hasMore = more
+	t.mx.Unlock()
 
-	return a.List(ctx, ns)
+	return oo, nil
 }
 
 func (t *Table) reconcile(ctx context.Context) error {
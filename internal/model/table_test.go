@@ -43,6 +43,21 @@ func TestTableRefresh(t *testing.T) {
 	assert.Equal(t, 0, l.errs)
 }
 
+func TestTablePageSizeFallback(t *testing.T) {
+	ta := model.NewTable(client.NewGVR("v1/pods"))
+	ta.SetNamespace(client.NamespaceAll)
+	ta.SetPageSize(1)
+
+	f := makeTableFactory()
+	f.rows = []runtime.Object{mustLoad("p1")}
+	ctx := context.WithValue(context.Background(), internal.KeyFactory, f)
+	ctx = context.WithValue(ctx, internal.KeyFields, "")
+	ctx = context.WithValue(ctx, internal.KeyWithMetrics, false)
+	assert.NoError(t, ta.Refresh(ctx))
+	assert.Equal(t, 1, ta.Peek().RowCount())
+	assert.False(t, ta.NextPage())
+}
+
 func TestTableNS(t *testing.T) {
 	ta := model.NewTable(client.NewGVR("v1/pods"))
 	ta.SetNamespace("blee")
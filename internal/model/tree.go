@@ -41,6 +41,7 @@ type Tree struct {
 	inUpdate    int32
 	refreshRate time.Duration
 	query       string
+	focus       *xray.NodeSpec
 }
 
 // NewTree returns a new model.
@@ -61,6 +62,26 @@ func (t *Tree) SetFilter(q string) {
 	t.query = q
 }
 
+// SetFocus roots the tree at a given node. The node subtree is resolved anew
+// using the node resource renderer if any.
+func (t *Tree) SetFocus(gvr, path string) {
+	t.focus = &xray.NodeSpec{GVRs: []string{gvr}, Paths: []string{path}}
+}
+
+// ClearFocus restores the full tree.
+func (t *Tree) ClearFocus() {
+	t.focus = nil
+}
+
+// Focus returns the focused node spec if any.
+func (t *Tree) Focus() (xray.NodeSpec, bool) {
+	if t.focus == nil {
+		return xray.NodeSpec{}, false
+	}
+
+	return *t.focus, true
+}
+
 // AddListener adds a listener.
 func (t *Tree) AddListener(l TreeListener) {
 	t.listeners = append(t.listeners, l)
@@ -223,6 +244,21 @@ func (t *Tree) reconcile(ctx context.Context) error {
 	} else if err := treeHydrate(ctx, ns, oo, meta.TreeRenderer); err != nil {
 		return err
 	}
+	if f, ok := ctx.Value(internal.KeyFactory).(dao.Factory); ok {
+		xray.AddWebhooks(ctx, f, root, t.gvr)
+		xray.AddAdmissionPolicies(ctx, f, root, t.gvr)
+		xray.AddEvents(ctx, f, root)
+		xray.AddQuotas(ctx, f, root)
+	}
+
+	if t.focus != nil {
+		if n, err := t.focusRoot(ctx, ns, root); err == nil {
+			root = n
+		} else {
+			t.focus = nil
+			t.fireTreeLoadFailed(err)
+		}
+	}
 
 	root.Sort()
 	if t.query != "" {
@@ -236,19 +272,23 @@ func (t *Tree) reconcile(ctx context.Context) error {
 	return nil
 }
 
-func (t *Tree) resourceMeta() ResourceMeta {
-	meta, ok := Registry[t.gvr.String()]
-	if !ok {
-		meta = ResourceMeta{
-			DAO:      &dao.Table{},
-			Renderer: &render.Generic{},
-		}
+// focusRoot returns the focused node subtree. Resources with a renderer are
+// resolved again from the resource itself rather than from the full tree.
+func (t *Tree) focusRoot(ctx context.Context, ns string, root *xray.TreeNode) (*xray.TreeNode, error) {
+	gvr, path := t.focus.GVR(), t.focus.Path()
+	n := root.Find(gvr, path)
+	if n == nil {
+		return nil, fmt.Errorf("focused %s %q no longer exists", client.NewGVR(gvr).R(), path)
 	}
-	if meta.DAO == nil {
-		meta.DAO = &dao.Resource{}
+	if sub := resolveFocus(ctx, ns, gvr, path); sub != nil {
+		return sub, nil
 	}
 
-	return meta
+	return root.Subtree(n), nil
+}
+
+func (t *Tree) resourceMeta() ResourceMeta {
+	return resourceMetaFor(t.gvr.String())
 }
 
 func (t *Tree) fireTreeChanged(root *xray.TreeNode) {
@@ -294,7 +334,7 @@ func treeHydrate(ctx context.Context, ns string, oo []runtime.Object, re TreeRen
 		return fmt.Errorf("no tree renderer defined for this resource")
 	}
 	for _, o := range oo {
-		if err := re.Render(ctx, ns, o); err != nil {
+		if err := xray.Resolve(ctx, re, ns, o); err != nil {
 			return err
 		}
 	}
@@ -318,3 +358,57 @@ func genericTreeHydrate(ctx context.Context, ns string, table *metav1.Table, re
 
 	return nil
 }
+
+func resourceMetaFor(gvr string) ResourceMeta {
+	meta, ok := Registry[gvr]
+	if !ok {
+		meta = ResourceMeta{
+			DAO:      &dao.Table{},
+			Renderer: &render.Generic{},
+		}
+	}
+	if meta.DAO == nil {
+		meta.DAO = &dao.Resource{}
+	}
+	// Non generic tree renderers hydrate raw resources rather than tables.
+	if _, ok := meta.DAO.(*dao.Table); ok && meta.TreeRenderer != nil {
+		if _, ok := meta.TreeRenderer.(*xray.Generic); !ok {
+			meta.DAO = &dao.Resource{}
+		}
+	}
+
+	return meta
+}
+
+// resolveFocus renders a resource subtree using its own tree renderer. Returns
+// nil if the resource has no renderer or can not be resolved.
+func resolveFocus(ctx context.Context, ns, gvr, path string) *xray.TreeNode {
+	meta := resourceMetaFor(gvr)
+	if meta.TreeRenderer == nil {
+		return nil
+	}
+	if _, ok := meta.TreeRenderer.(*xray.Generic); ok {
+		return nil
+	}
+	f, ok := ctx.Value(internal.KeyFactory).(dao.Factory)
+	if !ok {
+		return nil
+	}
+	meta.DAO.Init(f, client.NewGVR(gvr))
+	o, err := meta.DAO.Get(ctx, path)
+	if err != nil {
+		log.Warn().Err(err).Msgf("Unable to resolve focused %s %q", gvr, path)
+		return nil
+	}
+	scratch := xray.NewTreeNode(gvr, gvr)
+	if err := xray.Resolve(context.WithValue(ctx, xray.KeyParent, scratch), meta.TreeRenderer, ns, o); err != nil {
+		log.Warn().Err(err).Msgf("Unable to resolve focused %s %q", gvr, path)
+		return nil
+	}
+	sub := scratch.Subtree(scratch.Find(gvr, path))
+	if sub != nil {
+		xray.AddEvents(ctx, f, sub)
+	}
+
+	return sub
+}
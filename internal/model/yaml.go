@@ -20,8 +20,16 @@ import (
 	"github.com/sahilm/fuzzy"
 )
 
-// ManagedFieldsOpts tracks managed fields.
-const ManagedFieldsOpts = "ManagedFields"
+const (
+	// ManagedFieldsOpts tracks managed fields.
+	ManagedFieldsOpts = "ManagedFields"
+
+	// StripManagedFieldsOpts always strips out managed fields.
+	StripManagedFieldsOpts = "StripManagedFields"
+
+	// OmitStatusOpts strips out the resource status.
+	OmitStatusOpts = "OmitStatus"
+)
 
 // YAML tracks yaml resource representations.
 type YAML struct {
@@ -160,10 +168,14 @@ func (y *YAML) refresh(ctx context.Context) error {
 }
 
 func (y *YAML) reconcile(ctx context.Context) error {
-	s, err := y.ToYAML(ctx, y.gvr, y.path, y.options[ManagedFieldsOpts])
+	strip := y.options[StripManagedFieldsOpts]
+	s, err := y.ToYAML(ctx, y.gvr, y.path, y.options[ManagedFieldsOpts] && !strip)
 	if err != nil {
 		return err
 	}
+	if s, err = dao.StripYAML(s, strip, y.options[OmitStatusOpts]); err != nil {
+		return err
+	}
 	lines := strings.Split(s, "\n")
 	if reflect.DeepEqual(lines, y.lines) {
 		return nil
@@ -0,0 +1,68 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package model1
+
+import "sync"
+
+// RowDecorator represents an extension augmenting a resource rows before
+// they get customized and sorted.
+type RowDecorator interface {
+	// DecorateHeader returns the header augmented with the decorator columns.
+	DecorateHeader(Header) Header
+
+	// DecorateRow appends or modifies a row cells given its raw resource.
+	// The raw resource may be nil if not available.
+	DecorateRow(raw interface{}, row *Row)
+}
+
+var (
+	rowDecorators = make(map[string]RowDecorator)
+	decoratorsMx  sync.RWMutex
+)
+
+// AddRowDecorator registers a row decorator for a given gvr.
+func AddRowDecorator(gvr string, d RowDecorator) {
+	decoratorsMx.Lock()
+	defer decoratorsMx.Unlock()
+
+	rowDecorators[gvr] = d
+}
+
+// RemoveRowDecorator unregisters a gvr row decorator.
+func RemoveRowDecorator(gvr string) {
+	decoratorsMx.Lock()
+	defer decoratorsMx.Unlock()
+
+	delete(rowDecorators, gvr)
+}
+
+func rowDecorator(gvr string) (RowDecorator, bool) {
+	decoratorsMx.RLock()
+	defer decoratorsMx.RUnlock()
+
+	d, ok := rowDecorators[gvr]
+
+	return d, ok
+}
+
+// decorateHydrate runs a decorator over the header and rows. Rows are padded
+// or truncated to match the decorated header.
+func decorateHydrate(d RowDecorator, h Header, rows Rows, raws []interface{}) Header {
+	h = d.DecorateHeader(h.Clone())
+	for i := range rows {
+		var raw interface{}
+		if i < len(raws) {
+			raw = raws[i]
+		}
+		d.DecorateRow(raw, &rows[i])
+		switch n := len(h) - len(rows[i].Fields); {
+		case n > 0:
+			rows[i].Fields = append(rows[i].Fields, make(Fields, n)...)
+		case n < 0:
+			rows[i].Fields = rows[i].Fields[:len(h)]
+		}
+	}
+
+	return h
+}
@@ -0,0 +1,79 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package model1
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestTableDataReconcileDecorator(t *testing.T) {
+	oo := []runtime.Object{
+		makeLabeled("a", map[string]interface{}{"team": "blee"}),
+		makeLabeled("b", nil),
+	}
+	td := NewTableData(client.NewGVR("v1/fred"))
+
+	AddRowDecorator("v1/fred", ownerDecorator{})
+	assert.NoError(t, td.Reconcile(context.Background(), nameRenderer{}, oo))
+	assert.Equal(t, []string{"NAME", "OWNER"}, td.Header().ColumnNames(true))
+	assert.Equal(t, Fields{"A", "blee"}, findRow(t, td, "a").Fields)
+	assert.Equal(t, Fields{"B", ""}, findRow(t, td, "b").Fields)
+
+	RemoveRowDecorator("v1/fred")
+	assert.NoError(t, td.Reconcile(context.Background(), nameRenderer{}, oo))
+	assert.Equal(t, []string{"NAME"}, td.Header().ColumnNames(true))
+	assert.Equal(t, Fields{"a"}, findRow(t, td, "a").Fields)
+}
+
+// Helpers...
+
+type nameRenderer struct{}
+
+func (nameRenderer) IsGeneric() bool          { return false }
+func (nameRenderer) Header(string) Header     { return Header{{Name: "NAME"}} }
+func (nameRenderer) ColorerFunc() ColorerFunc { return DefaultColorer }
+func (nameRenderer) Render(o interface{}, _ string, r *Row) error {
+	u := o.(*unstructured.Unstructured)
+	r.ID, r.Fields = u.GetName(), Fields{u.GetName()}
+
+	return nil
+}
+
+type ownerDecorator struct{}
+
+func (ownerDecorator) DecorateHeader(h Header) Header {
+	return append(h, HeaderColumn{Name: "OWNER"})
+}
+
+func (ownerDecorator) DecorateRow(raw interface{}, r *Row) {
+	r.Fields[0] = strings.ToUpper(r.Fields[0])
+	m, _ := raw.(map[string]interface{})
+	team, _, _ := unstructured.NestedString(m, "metadata", "labels", "team")
+	if team != "" {
+		r.Fields = append(r.Fields, team)
+	}
+}
+
+func makeLabeled(n string, ll map[string]interface{}) *unstructured.Unstructured {
+	md := map[string]interface{}{"name": n}
+	if ll != nil {
+		md["labels"] = ll
+	}
+
+	return &unstructured.Unstructured{Object: map[string]interface{}{"metadata": md}}
+}
+
+func findRow(t *testing.T, td *TableData, id string) Row {
+	re, ok := td.FindRow(id)
+	assert.True(t, ok)
+
+	return re.Row
+}
@@ -0,0 +1,147 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package model1
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// RowGroup represents a collection of rows sharing a group by column value.
+type RowGroup struct {
+	Name string
+	Rows []RowEvent
+	Sums map[string]string
+
+	// Badges tracks badge columns distinct values counts ie Running:3 Pending:1.
+	Badges map[string]string
+}
+
+// Count returns the number of rows in the group.
+func (g RowGroup) Count() int {
+	return len(g.Rows)
+}
+
+// Group returns the rows grouped by a given column in their current order.
+// Sum columns are totaled per group whenever their values are numeric and
+// badge columns distinct values are counted per group.
+func (t *TableData) Group(col string, sums, badges []string) ([]RowGroup, error) {
+	t.mx.RLock()
+	defer t.mx.RUnlock()
+
+	idx, ok := t.header.IndexOf(col, true)
+	if !ok {
+		return nil, fmt.Errorf("group by column %q not found", col)
+	}
+	sumIdx := make([]int, 0, len(sums))
+	for _, s := range sums {
+		i, ok := t.header.IndexOf(s, true)
+		if !ok {
+			return nil, fmt.Errorf("group sum column %q not found", s)
+		}
+		sumIdx = append(sumIdx, i)
+	}
+	badgeIdx := make([]int, 0, len(badges))
+	for _, b := range badges {
+		i, ok := t.header.IndexOf(b, true)
+		if !ok {
+			return nil, fmt.Errorf("group badge column %q not found", b)
+		}
+		badgeIdx = append(badgeIdx, i)
+	}
+
+	var (
+		gg    []RowGroup
+		index = make(map[string]int)
+	)
+	for _, re := range t.rowEvents.events {
+		if idx >= len(re.Row.Fields) {
+			continue
+		}
+		name := re.Row.Fields[idx]
+		i, ok := index[name]
+		if !ok {
+			i = len(gg)
+			index[name] = i
+			gg = append(gg, RowGroup{Name: name})
+		}
+		gg[i].Rows = append(gg[i].Rows, re)
+	}
+	for i := range gg {
+		gg[i].Sums = groupSums(gg[i].Rows, sums, sumIdx)
+		gg[i].Badges = groupBadges(gg[i].Rows, badges, badgeIdx)
+	}
+
+	return gg, nil
+}
+
+func groupSums(rr []RowEvent, cols []string, ids []int) map[string]string {
+	if len(cols) == 0 {
+		return nil
+	}
+	ss := make(map[string]string, len(cols))
+	for i, idx := range ids {
+		var (
+			total resource.Quantity
+			found bool
+		)
+		for _, re := range rr {
+			if idx >= len(re.Row.Fields) {
+				continue
+			}
+			q, err := resource.ParseQuantity(strings.ReplaceAll(re.Row.Fields[idx], ",", ""))
+			if err != nil {
+				continue
+			}
+			total.Add(q)
+			found = true
+		}
+		if found {
+			ss[cols[i]] = total.String()
+		}
+	}
+
+	return ss
+}
+
+// groupBadges counts the distinct values of the badge columns, most frequent
+// values first.
+func groupBadges(rr []RowEvent, cols []string, ids []int) map[string]string {
+	if len(cols) == 0 {
+		return nil
+	}
+	bb := make(map[string]string, len(cols))
+	for i, idx := range ids {
+		counts := make(map[string]int)
+		for _, re := range rr {
+			if idx >= len(re.Row.Fields) || re.Row.Fields[idx] == "" {
+				continue
+			}
+			counts[re.Row.Fields[idx]]++
+		}
+		vv := make([]string, 0, len(counts))
+		for v := range counts {
+			vv = append(vv, v)
+		}
+		slices.SortFunc(vv, func(a, b string) int {
+			if c := cmp.Compare(counts[b], counts[a]); c != 0 {
+				return c
+			}
+			return cmp.Compare(a, b)
+		})
+		ss := make([]string, 0, len(vv))
+		for _, v := range vv {
+			ss = append(ss, fmt.Sprintf("%s:%d", v, counts[v]))
+		}
+		if len(ss) > 0 {
+			bb[cols[i]] = strings.Join(ss, " ")
+		}
+	}
+
+	return bb
+}
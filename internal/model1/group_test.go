@@ -0,0 +1,120 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package model1
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTableDataGroup(t *testing.T) {
+	uu := map[string]struct {
+		col    string
+		sums   []string
+		badges []string
+		err    string
+		e      []RowGroup
+	}{
+		"plain": {
+			col: "NODE",
+			e: []RowGroup{
+				{Name: "n1", Rows: []RowEvent{groupEvt("A", "n1", "100", "1Gi"), groupEvt("C", "n1", "n/a", "512Mi")}},
+				{Name: "n2", Rows: []RowEvent{groupEvt("B", "n2", "200", "1Gi")}},
+			},
+		},
+		"sums": {
+			col:  "NODE",
+			sums: []string{"CPU", "MEM"},
+			e: []RowGroup{
+				{
+					Name: "n1",
+					Rows: []RowEvent{groupEvt("A", "n1", "100", "1Gi"), groupEvt("C", "n1", "n/a", "512Mi")},
+					Sums: map[string]string{"CPU": "100", "MEM": "1536Mi"},
+				},
+				{
+					Name: "n2",
+					Rows: []RowEvent{groupEvt("B", "n2", "200", "1Gi")},
+					Sums: map[string]string{"CPU": "200", "MEM": "1Gi"},
+				},
+			},
+		},
+		"badges": {
+			col:    "NODE",
+			badges: []string{"MEM"},
+			e: []RowGroup{
+				{
+					Name:   "n1",
+					Rows:   []RowEvent{groupEvt("A", "n1", "100", "1Gi"), groupEvt("C", "n1", "n/a", "512Mi")},
+					Badges: map[string]string{"MEM": "1Gi:1 512Mi:1"},
+				},
+				{
+					Name:   "n2",
+					Rows:   []RowEvent{groupEvt("B", "n2", "200", "1Gi")},
+					Badges: map[string]string{"MEM": "1Gi:1"},
+				},
+			},
+		},
+		"no-badge-col": {
+			col:    "NODE",
+			badges: []string{"ZORG"},
+			err:    `group badge column "ZORG" not found`,
+		},
+		"no-group-col": {
+			col: "ZORG",
+			err: `group by column "ZORG" not found`,
+		},
+		"no-sum-col": {
+			col:  "NODE",
+			sums: []string{"ZORG"},
+			err:  `group sum column "ZORG" not found`,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			td := NewTableDataWithRows(
+				client.NewGVR("test"),
+				Header{
+					HeaderColumn{Name: "NAME"},
+					HeaderColumn{Name: "NODE"},
+					HeaderColumn{Name: "CPU"},
+					HeaderColumn{Name: "MEM", Capacity: true},
+				},
+				NewRowEventsWithEvts(
+					groupEvt("A", "n1", "100", "1Gi"),
+					groupEvt("B", "n2", "200", "1Gi"),
+					groupEvt("C", "n1", "n/a", "512Mi"),
+				),
+			)
+			gg, err := td.Group(u.col, u.sums, u.badges)
+			if u.err != "" {
+				assert.EqualError(t, err, u.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, u.e, gg)
+		})
+	}
+}
+
+func TestGroupBadges(t *testing.T) {
+	rr := []RowEvent{
+		groupEvt("A", "n1", "100", "Pending"),
+		groupEvt("B", "n1", "200", "Running"),
+		groupEvt("C", "n1", "", "Running"),
+		groupEvt("D", "n1", "", ""),
+	}
+
+	assert.Nil(t, groupBadges(rr, nil, nil))
+	assert.Equal(t, map[string]string{"MEM": "Running:2 Pending:1", "CPU": "100:1 200:1"}, groupBadges(rr, []string{"MEM", "CPU"}, []int{3, 2}))
+}
+
+// Helpers...
+
+func groupEvt(id, node, cpu, mem string) RowEvent {
+	return RowEvent{Row: Row{ID: id, Fields: Fields{id, node, cpu, mem}}}
+}
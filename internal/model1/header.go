@@ -5,12 +5,19 @@ package model1
 
 import (
 	"reflect"
+	"slices"
+	"strings"
+	"sync"
 
+	"github.com/derailed/k9s/internal/config"
 	"github.com/rs/zerolog/log"
 )
 
 const ageCol = "AGE"
 
+// missingWideCols tracks wide columns already logged as missing.
+var missingWideCols sync.Map
+
 // HeaderColumn represent a table header.
 type HeaderColumn struct {
 	Name      string
@@ -22,6 +29,9 @@ type HeaderColumn struct {
 	Time      bool
 	Capacity  bool
 	VS        bool
+	SortAs    string
+	Condition bool
+	Layout    string
 }
 
 // Clone copies a header.
@@ -29,6 +39,21 @@ func (h HeaderColumn) Clone() HeaderColumn {
 	return h
 }
 
+// Decorate renders a row cell. Time columns with a layout render the row raw
+// timestamp if known, then the column decorator applies if any.
+func (h HeaderColumn) Decorate(r Row, s string) string {
+	if h.Layout != "" {
+		if ts, ok := r.Stamps[h.Name]; ok {
+			s = ts.Format(h.Layout)
+		}
+	}
+	if h.Decorator != nil {
+		s = h.Decorator(s)
+	}
+
+	return s
+}
+
 // ----------------------------------------------------------------------------
 
 // Header represents a table header.
@@ -132,6 +157,38 @@ func (h Header) Diff(header Header) bool {
 	return !reflect.DeepEqual(h, header)
 }
 
+// availableCols returns the given columns present on the header and not
+// already listed in cols. Missing columns are logged once.
+func (h Header) availableCols(cols, cc []string) []string {
+	out := make([]string, 0, len(cc))
+	for _, c := range cc {
+		if slices.Contains(cols, c) || slices.Contains(out, c) {
+			continue
+		}
+		if _, ok := h.IndexOf(c, true); !ok {
+			if _, ok := missingWideCols.LoadOrStore(c, struct{}{}); !ok {
+				log.Warn().Msgf("Wide column %q not found on resource", c)
+			}
+			continue
+		}
+		out = append(out, c)
+	}
+
+	return out
+}
+
+// ConditionColumns returns the names of columns computed from status conditions.
+func (h Header) ConditionColumns() []string {
+	var cc []string
+	for _, c := range h {
+		if c.Condition {
+			cc = append(cc, c.Name)
+		}
+	}
+
+	return cc
+}
+
 // ColumnNames return header col names
 func (h Header) ColumnNames(wide bool) []string {
 	if len(h) == 0 {
@@ -182,6 +239,125 @@ func (h Header) IsCapacityCol(col int) bool {
 	return h[col].Capacity
 }
 
+// Transform decorates columns using the named column transformers.
+func (h Header) Transform(tt map[string]string) {
+	for col, name := range tt {
+		idx, ok := h.IndexOf(col, true)
+		if !ok {
+			continue
+		}
+		fn, ok := Transformers[name]
+		if !ok {
+			log.Warn().Msgf("Unknown column transformer %q for column %q", name, col)
+			continue
+		}
+		h[idx].Decorator = fn
+	}
+}
+
+// MultiValue decorates list valued columns using the named join styles.
+// Styles apply after any other column decorator.
+func (h Header) MultiValue(mm map[string]string) {
+	for col, style := range mm {
+		idx, ok := h.IndexOf(col, true)
+		if !ok {
+			continue
+		}
+		fn, ok := MultiValuers[style]
+		if !ok {
+			log.Warn().Msgf("Unknown multi value style %q for column %q", style, col)
+			continue
+		}
+		if d := h[idx].Decorator; d != nil {
+			h[idx].Decorator = func(s string) string { return fn(d(s)) }
+			continue
+		}
+		h[idx].Decorator = fn
+	}
+}
+
+// nativeUnit returns the unit metrics columns are rendered in without a unit.
+func (h HeaderColumn) nativeUnit() string {
+	if !h.MX {
+		return ""
+	}
+	switch {
+	case strings.HasPrefix(h.Name, "CPU"):
+		return "m"
+	case strings.HasPrefix(h.Name, "MEM"):
+		return "Mi"
+	default:
+		return ""
+	}
+}
+
+// Normalize decorates quantity columns converting cells to the given units.
+// Units apply after any column transformer. Unitless metrics cells are read
+// in their native unit ie MiB for MEM columns.
+func (h Header) Normalize(nn map[string]string) {
+	for col, unit := range nn {
+		idx, ok := h.IndexOf(col, true)
+		if !ok {
+			continue
+		}
+		if _, ok := config.NormalizeUnits[unit]; !ok {
+			log.Warn().Msgf("Unknown normalize unit %q for column %q", unit, col)
+			continue
+		}
+		var native string
+		if h[idx].Decorator == nil {
+			native = h[idx].nativeUnit()
+		}
+		fn := ToUnit(col, native, unit)
+		if d := h[idx].Decorator; d != nil {
+			h[idx].Decorator = func(s string) string { return fn(d(s)) }
+			continue
+		}
+		h[idx].Decorator = fn
+	}
+}
+
+// GroupDigits decorates numeric columns grouping digits by thousands.
+// Grouping applies after any column transformer or normalization.
+func (h Header) GroupDigits(cc []string, sep string) {
+	fn := ToGroupDigits(sep)
+	for _, col := range cc {
+		idx, ok := h.IndexOf(col, true)
+		if !ok {
+			continue
+		}
+		if d := h[idx].Decorator; d != nil {
+			h[idx].Decorator = func(s string) string { return fn(d(s)) }
+			continue
+		}
+		h[idx].Decorator = fn
+	}
+}
+
+// SortTypes sets columns sort types overriding the columns defaults. Once a
+// view specifies column types, unspecified columns sort as strings.
+func (h Header) SortTypes(vs *config.ViewSetting) {
+	if vs == nil || len(vs.ColumnTypes) == 0 {
+		return
+	}
+	for i := range h {
+		h[i].SortAs = vs.ColumnType(h[i].Name)
+	}
+}
+
+// TimeFormat renders time columns as timestamps using a given time layout.
+// A blank layout leaves relative times as is.
+func (h Header) TimeFormat(layout string) {
+	if layout == "" {
+		return
+	}
+	for i := range h {
+		if h[i].Time {
+			h[i].Layout = layout
+		}
+	}
+}
+
 // IndexOf returns the col index or -1 if none.
 func (h Header) IndexOf(colName string, includeWide bool) (int, bool) {
 	for i, c := range h {
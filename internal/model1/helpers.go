@@ -4,14 +4,22 @@
 package model1
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
 	"math"
+	"net"
 	"sort"
+	"strconv"
 	"strings"
+	"time"
 
+	"github.com/derailed/k9s/internal/config"
 	"github.com/fvbommel/sortorder"
+	"github.com/rs/zerolog/log"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -148,6 +156,95 @@ func Less(isNumber, isDuration, isCapacity bool, id1, id2, v1, v2 string) bool {
 	return less
 }
 
+// LessAs returns true if v1 <= v2 using a given column sort type.
+func LessAs(kind, id1, id2, v1, v2 string) bool {
+	if v1 == v2 {
+		return sortorder.NaturalLess(id1, id2)
+	}
+	switch kind {
+	case config.NumericColumn:
+		return lessFloat(v1, v2)
+	case config.DurationColumn:
+		return lessDuration(v1, v2)
+	case config.IPColumn:
+		return lessIP(v1, v2)
+	case config.SemverColumn:
+		return lessSemver(v1, v2)
+	default:
+		return v1 < v2
+	}
+}
+
+// lessFloat compares numbers. Non numeric values sort last.
+func lessFloat(s1, s2 string) bool {
+	f1, err1 := strconv.ParseFloat(strings.ReplaceAll(s1, ",", ""), 64)
+	f2, err2 := strconv.ParseFloat(strings.ReplaceAll(s2, ",", ""), 64)
+	switch {
+	case err1 != nil && err2 != nil:
+		return s1 < s2
+	case err1 != nil:
+		return false
+	case err2 != nil:
+		return true
+	}
+
+	return f1 <= f2
+}
+
+// lessIP compares ip addresses. Invalid addresses sort last.
+func lessIP(s1, s2 string) bool {
+	ip1, ip2 := net.ParseIP(s1), net.ParseIP(s2)
+	switch {
+	case ip1 == nil && ip2 == nil:
+		return s1 < s2
+	case ip1 == nil:
+		return false
+	case ip2 == nil:
+		return true
+	}
+
+	return bytes.Compare(ip1.To16(), ip2.To16()) <= 0
+}
+
+// lessSemver compares semantic versions ie v1.2.3-rc.1. Pre-releases sort
+// before their release.
+func lessSemver(s1, s2 string) bool {
+	c1, p1 := semverParts(s1)
+	c2, p2 := semverParts(s2)
+	for i := range c1 {
+		if c1[i] != c2[i] {
+			return c1[i] < c2[i]
+		}
+	}
+	switch {
+	case p1 == p2:
+		return true
+	case p1 == "":
+		return false
+	case p2 == "":
+		return true
+	}
+
+	return sortorder.NaturalLess(p1, p2)
+}
+
+func semverParts(s string) ([3]int64, string) {
+	var cc [3]int64
+	s = strings.TrimPrefix(s, "v")
+	if i := strings.Index(s, "+"); i >= 0 {
+		s = s[:i]
+	}
+	var pre string
+	if i := strings.Index(s, "-"); i >= 0 {
+		s, pre = s[:i], s[i+1:]
+	}
+	for i, p := range strings.SplitN(s, ".", 3) {
+		cc[i], _ = strconv.ParseInt(p, 10, 64)
+	}
+
+	return cc, pre
+}
+
 func lessDuration(s1, s2 string) bool {
 	d1, d2 := durationToSeconds(s1), durationToSeconds(s2)
 	return d1 <= d2
@@ -164,3 +261,243 @@ func lessNumber(s1, s2 string) bool {
 
 	return sortorder.NaturalLess(v1, v2)
 }
+
+// jsonPathHydrate appends jsonpath computed columns to the header and rows.
+func jsonPathHydrate(cc []config.JSONPathCol, h Header, rows Rows, raws []interface{}) Header {
+	h = h.Clone()
+	for _, c := range cc {
+		h = append(h, HeaderColumn{Name: c.Name, Wide: true})
+	}
+	for i := range rows {
+		var raw interface{}
+		if i < len(raws) {
+			raw = raws[i]
+		}
+		for _, c := range cc {
+			var v string
+			if raw != nil {
+				var err error
+				if v, err = c.Eval(raw); err != nil {
+					log.Debug().Err(err).Msgf("JSONPath %q eval failed", c.Expr)
+				}
+			}
+			rows[i].Fields = append(rows[i].Fields, v)
+		}
+	}
+
+	return h
+}
+
+// timeStamps tracks the raw resources timestamps rendered by time columns.
+var timeStamps = map[string][]string{
+	"AGE":           {"metadata", "creationTimestamp"},
+	"LAST_SCHEDULE": {"status", "lastScheduleTime"},
+}
+
+// stampsHydrate records the rows raw timestamps of the header time columns.
+func stampsHydrate(h Header, rows Rows, raws []interface{}) {
+	for i := range rows {
+		if i >= len(raws) {
+			return
+		}
+		m, ok := raws[i].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for _, c := range h {
+			path, ok := timeStamps[c.Name]
+			if !c.Time || !ok {
+				continue
+			}
+			s, _, _ := unstructured.NestedString(m, path...)
+			ts, err := time.Parse(time.RFC3339, s)
+			if err != nil {
+				continue
+			}
+			if rows[i].Stamps == nil {
+				rows[i].Stamps = make(map[string]time.Time, 1)
+			}
+			rows[i].Stamps[c.Name] = ts
+		}
+	}
+}
+
+// statusFromHydrate sets a status column derived from the raw resources
+// status conditions. An existing column by the same name is overridden.
+func statusFromHydrate(s *config.StatusFrom, h Header, rows Rows, raws []interface{}) Header {
+	idx, ok := h.IndexOf(s.ColName(), true)
+	if !ok {
+		h = append(h.Clone(), HeaderColumn{Name: s.ColName()})
+		idx = len(h) - 1
+	}
+	for i := range rows {
+		var raw interface{}
+		if i < len(raws) {
+			raw = raws[i]
+		}
+		v := s.Eval(raw)
+		if ok && idx < len(rows[i].Fields) {
+			rows[i].Fields[idx] = v
+			continue
+		}
+		rows[i].Fields = append(rows[i].Fields, v)
+	}
+
+	return h
+}
+
+// WellKnownConditions represents condition types listed ahead of others.
+var WellKnownConditions = []string{"Ready", "Available", "Progressing"}
+
+// conditionsHydrate appends a column per status condition type observed
+// across the raw resources to the header and rows. Condition types clashing
+// with existing columns are skipped.
+func conditionsHydrate(h Header, rows Rows, raws []interface{}) Header {
+	tt := conditionTypes(raws)
+	if len(tt) == 0 {
+		return h
+	}
+	h = h.Clone()
+	cols := make([]string, 0, len(tt))
+	for _, t := range tt {
+		if _, ok := h.IndexOf(strings.ToUpper(t), true); ok {
+			continue
+		}
+		h = append(h, HeaderColumn{Name: strings.ToUpper(t), Wide: true, Condition: true})
+		cols = append(cols, t)
+	}
+	for i := range rows {
+		var ss map[string]string
+		if i < len(raws) {
+			ss = conditionStatuses(raws[i])
+		}
+		for _, c := range cols {
+			rows[i].Fields = append(rows[i].Fields, ss[c])
+		}
+	}
+
+	return h
+}
+
+// conditionTypes returns the condition types observed across the raw
+// resources. Well known types come first followed by others in lexical order.
+func conditionTypes(raws []interface{}) []string {
+	set := make(map[string]struct{})
+	for _, raw := range raws {
+		for t := range conditionStatuses(raw) {
+			set[t] = struct{}{}
+		}
+	}
+	tt := make([]string, 0, len(set))
+	for _, t := range WellKnownConditions {
+		if _, ok := set[t]; ok {
+			tt = append(tt, t)
+			delete(set, t)
+		}
+	}
+	rest := make([]string, 0, len(set))
+	for t := range set {
+		rest = append(rest, t)
+	}
+	sort.Strings(rest)
+
+	return append(tt, rest...)
+}
+
+// conditionStatuses returns a raw resource status conditions keyed by type.
+func conditionStatuses(raw interface{}) map[string]string {
+	m, ok := raw.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	cc, _, _ := unstructured.NestedSlice(m, "status", "conditions")
+	ss := make(map[string]string, len(cc))
+	for _, c := range cc {
+		cm, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		t, _ := cm["type"].(string)
+		if t == "" {
+			continue
+		}
+		st, _ := cm["status"].(string)
+		ss[t] = st
+	}
+
+	return ss
+}
+
+func objectRaws(oo []runtime.Object) []interface{} {
+	raws := make([]interface{}, 0, len(oo))
+	for _, o := range oo {
+		raws = append(raws, toRaw(o))
+	}
+
+	return raws
+}
+
+func tableRaws(table *metav1.Table) []interface{} {
+	raws := make([]interface{}, 0, len(table.Rows))
+	for _, r := range table.Rows {
+		if r.Object.Object != nil {
+			raws = append(raws, toRaw(r.Object.Object))
+			continue
+		}
+		var m map[string]interface{}
+		if err := json.Unmarshal(r.Object.Raw, &m); err != nil {
+			raws = append(raws, nil)
+			continue
+		}
+		raws = append(raws, m)
+	}
+
+	return raws
+}
+
+func toRaw(o runtime.Object) interface{} {
+	if r, ok := o.(RawObjecter); ok {
+		if u := r.RawObject(); u != nil {
+			return u.Object
+		}
+		return nil
+	}
+	if u, ok := o.(*unstructured.Unstructured); ok {
+		return u.Object
+	}
+	m, err := runtime.DefaultUnstructuredConverter.ToUnstructured(o)
+	if err != nil {
+		return nil
+	}
+
+	return m
+}
+
+// cellNumber parses the leading number of a given cell ie 3 (1m ago) -> 3.
+func cellNumber(ff Fields, idx int) (float64, bool) {
+	if idx < 0 || idx >= len(ff) {
+		return 0, false
+	}
+	s, _, _ := strings.Cut(strings.TrimSpace(ff[idx]), " ")
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, false
+	}
+
+	return v, true
+}
+
+func compare(v float64, op string, n float64) bool {
+	switch op {
+	case ">":
+		return v > n
+	case ">=":
+		return v >= n
+	case "<":
+		return v < n
+	case "<=":
+		return v <= n
+	default:
+		return false
+	}
+}
@@ -7,7 +7,9 @@ import (
 	"math"
 	"testing"
 
+	"github.com/derailed/k9s/internal/config"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestSortLabels(t *testing.T) {
@@ -87,3 +89,92 @@ func BenchmarkDurationToSecond(b *testing.B) {
 		durationToSeconds(t)
 	}
 }
+
+func TestJSONPathHydrate(t *testing.T) {
+	c, err := config.ParseJSONPathCol("jsonpath:{.spec.replicas}|REPLICAS")
+	require.NoError(t, err)
+
+	h := Header{{Name: "NAME"}}
+	rows := Rows{
+		{ID: "a", Fields: Fields{"a"}},
+		{ID: "b", Fields: Fields{"b"}},
+	}
+	raws := []interface{}{
+		map[string]interface{}{"spec": map[string]interface{}{"replicas": int64(3)}},
+		nil,
+	}
+	h = jsonPathHydrate([]config.JSONPathCol{c}, h, rows, raws)
+
+	assert.Equal(t, Header{{Name: "NAME"}, {Name: "REPLICAS", Wide: true}}, h)
+	assert.Equal(t, Fields{"a", "3"}, rows[0].Fields)
+	assert.Equal(t, Fields{"b", ""}, rows[1].Fields)
+}
+
+func TestConditionsHydrate(t *testing.T) {
+	h := Header{{Name: "NAME"}, {Name: "SYNCED"}}
+	rows := Rows{
+		{ID: "a", Fields: Fields{"a", "x"}},
+		{ID: "b", Fields: Fields{"b", "y"}},
+		{ID: "c", Fields: Fields{"c", "z"}},
+	}
+	raws := []interface{}{
+		makeConditions("Zeta", "True", "Synced", "True", "Ready", "False"),
+		makeConditions("Progressing", "Unknown", "Alpha", "True"),
+		nil,
+	}
+	h = conditionsHydrate(h, rows, raws)
+
+	assert.Equal(t, []string{"READY", "PROGRESSING", "ALPHA", "ZETA"}, h.ConditionColumns())
+	assert.Equal(t, Fields{"a", "x", "False", "", "", "True"}, rows[0].Fields)
+	assert.Equal(t, Fields{"b", "y", "", "Unknown", "True", ""}, rows[1].Fields)
+	assert.Equal(t, Fields{"c", "z", "", "", "", ""}, rows[2].Fields)
+}
+
+func TestStatusFromHydrate(t *testing.T) {
+	s := config.StatusFrom{Conditions: []string{"Ready", "Synced"}}
+	uu := map[string]struct {
+		h    Header
+		e    Header
+		rows Rows
+		ee   []Fields
+	}{
+		"append": {
+			h:    Header{{Name: "NAME"}},
+			e:    Header{{Name: "NAME"}, {Name: "STATUS"}},
+			rows: Rows{{ID: "a", Fields: Fields{"a"}}, {ID: "b", Fields: Fields{"b"}}},
+			ee:   []Fields{{"a", "Ready"}, {"b", "Synced"}},
+		},
+		"override": {
+			h:    Header{{Name: "STATUS"}, {Name: "NAME"}},
+			e:    Header{{Name: "STATUS"}, {Name: "NAME"}},
+			rows: Rows{{ID: "a", Fields: Fields{"x", "a"}}, {ID: "b", Fields: Fields{"y", "b"}}},
+			ee:   []Fields{{"Ready", "a"}, {"Synced", "b"}},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			raws := []interface{}{
+				makeConditions("Ready", "True", "Synced", "True"),
+				makeConditions("Ready", "True"),
+			}
+			h := statusFromHydrate(&s, u.h, u.rows, raws)
+			assert.Equal(t, u.e, h)
+			for i, r := range u.rows {
+				assert.Equal(t, u.ee[i], r.Fields)
+			}
+		})
+	}
+}
+
+// Helpers...
+
+func makeConditions(kv ...string) map[string]interface{} {
+	cc := make([]interface{}, 0, len(kv)/2)
+	for i := 0; i < len(kv); i += 2 {
+		cc = append(cc, map[string]interface{}{"type": kv[i], "status": kv[i+1]})
+	}
+
+	return map[string]interface{}{"status": map[string]interface{}{"conditions": cc}}
+}
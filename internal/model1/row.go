@@ -3,10 +3,17 @@
 
 package model1
 
-// Row represents a collection of columns.
+import (
+	"maps"
+	"time"
+)
+
+// Row represents a collection of columns. Stamps tracks the raw timestamps of
+// time columns by column name when known.
 type Row struct {
 	ID     string
 	Fields Fields
+	Stamps map[string]time.Time
 }
 
 // NewRow returns a new row with initialized fields.
@@ -20,6 +27,7 @@ func (r Row) Labelize(cols []int, labelCol int, labels []string) Row {
 	for _, col := range cols {
 		out.Fields = append(out.Fields, r.Fields[col])
 	}
+	out.Stamps = r.Stamps
 	m := labelize(r.Fields[labelCol])
 	for _, label := range labels {
 		out.Fields = append(out.Fields, m[label])
@@ -32,7 +40,7 @@ func (r Row) Labelize(cols []int, labelCol int, labels []string) Row {
 func (r Row) Customize(cols []int) Row {
 	out := NewRow(len(cols))
 	r.Fields.Customize(cols, out.Fields)
-	out.ID = r.ID
+	out.ID, out.Stamps = r.ID, r.Stamps
 
 	return out
 }
@@ -50,6 +58,7 @@ func (r Row) Clone() Row {
 	return Row{
 		ID:     r.ID,
 		Fields: r.Fields.Clone(),
+		Stamps: maps.Clone(r.Stamps),
 	}
 }
 
@@ -254,26 +254,86 @@ func (r *RowEvents) FindIndex(id string) (int, bool) {
 }
 
 // Sort rows based on column index and order.
-func (r *RowEvents) Sort(ns string, sortCol int, isDuration, numCol, isCapacity, asc bool) {
-	if sortCol == -1 {
+func (r *RowEvents) Sort(ns string, sortCol int, isDuration, numCol, isCapacity, asc bool, ties ...SortKey) {
+	r.SortBy(ns, SortKey{
+		Index:      sortCol,
+		IsNumber:   numCol,
+		IsDuration: isDuration,
+		IsCapacity: isCapacity,
+		Asc:        asc,
+	}, ties...)
+}
+
+// SortBy sorts rows based on a given sort key and tie breaks.
+func (r *RowEvents) SortBy(ns string, k SortKey, ties ...SortKey) {
+	if k.Index == -1 {
 		return
 	}
 
 	t := RowEventSorter{
 		NS:         ns,
 		Events:     r,
-		Index:      sortCol,
-		Asc:        asc,
-		IsNumber:   numCol,
-		IsDuration: isDuration,
-		IsCapacity: isCapacity,
+		Index:      k.Index,
+		Asc:        k.Asc,
+		IsNumber:   k.IsNumber,
+		IsDuration: k.IsDuration,
+		IsCapacity: k.IsCapacity,
+		SortAs:     k.SortAs,
+		TieBreaks:  ties,
 	}
 	sort.Sort(t)
 	r.reindex()
 }
 
+// Pin orders rows per the given ids. Unknown rows follow in their current order.
+func (r *RowEvents) Pin(ids []string) {
+	pos := make(map[string]int, len(ids))
+	for i, id := range ids {
+		pos[id] = i
+	}
+	sort.SliceStable(r.events, func(i, j int) bool {
+		pi, oki := pos[r.events[i].Row.ID]
+		pj, okj := pos[r.events[j].Row.ID]
+		switch {
+		case oki && okj:
+			return pi < pj
+		default:
+			return oki && !okj
+		}
+	})
+	r.reindex()
+}
+
+// IDs returns the rows ids in order.
+func (r *RowEvents) IDs() []string {
+	ids := make([]string, 0, len(r.events))
+	for _, re := range r.events {
+		ids = append(ids, re.Row.ID)
+	}
+
+	return ids
+}
+
 // ----------------------------------------------------------------------------
 
+// SortKey represents a tie-break sort column.
+type SortKey struct {
+	Index      int
+	IsNumber   bool
+	IsDuration bool
+	IsCapacity bool
+	Asc        bool
+	SortAs     string
+}
+
+func (k SortKey) less(id1, id2, v1, v2 string) bool {
+	if k.SortAs != "" {
+		return LessAs(k.SortAs, id1, id2, v1, v2)
+	}
+
+	return Less(k.IsNumber, k.IsDuration, k.IsCapacity, id1, id2, v1, v2)
+}
+
 // RowEventSorter sorts row events by a given colon.
 type RowEventSorter struct {
 	Events     *RowEvents
@@ -283,6 +343,8 @@ type RowEventSorter struct {
 	IsDuration bool
 	IsCapacity bool
 	Asc        bool
+	SortAs     string
+	TieBreaks  []SortKey
 }
 
 func (r RowEventSorter) Len() int {
@@ -296,7 +358,20 @@ func (r RowEventSorter) Swap(i, j int) {
 func (r RowEventSorter) Less(i, j int) bool {
 	f1, f2 := r.Events.events[i].Row.Fields, r.Events.events[j].Row.Fields
 	id1, id2 := r.Events.events[i].Row.ID, r.Events.events[j].Row.ID
-	less := Less(r.IsNumber, r.IsDuration, r.IsCapacity, id1, id2, f1[r.Index], f2[r.Index])
+	if f1[r.Index] == f2[r.Index] {
+		for _, k := range r.TieBreaks {
+			if f1[k.Index] == f2[k.Index] {
+				continue
+			}
+			less := k.less(id1, id2, f1[k.Index], f2[k.Index])
+			if k.Asc {
+				return less
+			}
+			return !less
+		}
+	}
+	k := SortKey{IsNumber: r.IsNumber, IsDuration: r.IsDuration, IsCapacity: r.IsCapacity, SortAs: r.SortAs}
+	less := k.less(id1, id2, f1[r.Index], f2[r.Index])
 	if r.Asc {
 		return less
 	}
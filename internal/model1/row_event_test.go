@@ -418,6 +418,7 @@ func TestRowEventsSort(t *testing.T) {
 		col                int
 		duration, num, asc bool
 		capacity           bool
+		ties               []model1.SortKey
 	}{
 		"age_time": {
 			re: model1.NewRowEventsWithEvts(
@@ -489,12 +490,32 @@ func TestRowEventsSort(t *testing.T) {
 				model1.RowEvent{Row: model1.Row{ID: "ns2/C", Fields: model1.Fields{"C", "2", "3", "0.1Ei"}}},
 			),
 		},
+		"tie_breaks": {
+			re: model1.NewRowEventsWithEvts(
+				model1.RowEvent{Row: model1.Row{ID: "A", Fields: model1.Fields{"Running", "1", "5"}}},
+				model1.RowEvent{Row: model1.Row{ID: "B", Fields: model1.Fields{"Pending", "2", "3"}}},
+				model1.RowEvent{Row: model1.Row{ID: "C", Fields: model1.Fields{"Running", "1", "10"}}},
+				model1.RowEvent{Row: model1.Row{ID: "D", Fields: model1.Fields{"Running", "0", "1"}}},
+			),
+			col: 0,
+			asc: true,
+			ties: []model1.SortKey{
+				{Index: 1, Asc: false},
+				{Index: 2, IsNumber: true, Asc: true},
+			},
+			e: model1.NewRowEventsWithEvts(
+				model1.RowEvent{Row: model1.Row{ID: "B", Fields: model1.Fields{"Pending", "2", "3"}}},
+				model1.RowEvent{Row: model1.Row{ID: "A", Fields: model1.Fields{"Running", "1", "5"}}},
+				model1.RowEvent{Row: model1.Row{ID: "C", Fields: model1.Fields{"Running", "1", "10"}}},
+				model1.RowEvent{Row: model1.Row{ID: "D", Fields: model1.Fields{"Running", "0", "1"}}},
+			),
+		},
 	}
 
 	for k := range uu {
 		u := uu[k]
 		t.Run(k, func(t *testing.T) {
-			u.re.Sort("", u.col, u.duration, u.num, u.capacity, u.asc)
+			u.re.Sort("", u.col, u.duration, u.num, u.capacity, u.asc, u.ties...)
 			assert.Equal(t, u.e, u.re)
 		})
 	}
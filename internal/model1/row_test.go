@@ -518,3 +518,29 @@ func TestLess(t *testing.T) {
 		})
 	}
 }
+
+func TestLessAs(t *testing.T) {
+	uu := map[string]struct {
+		kind, v1, v2 string
+		e            bool
+	}{
+		"numeric":          {kind: "numeric", v1: "9", v2: "10", e: true},
+		"numeric-float":    {kind: "numeric", v1: "1.5", v2: "1,000", e: true},
+		"numeric-bad":      {kind: "numeric", v1: "n/a", v2: "10", e: false},
+		"duration":         {kind: "duration", v1: "2d", v2: "19h", e: false},
+		"ip":               {kind: "ip", v1: "10.0.0.9", v2: "10.0.0.10", e: true},
+		"ip-v6":            {kind: "ip", v1: "10.0.0.9", v2: "::1", e: false},
+		"ip-bad":           {kind: "ip", v1: "<none>", v2: "10.0.0.1", e: false},
+		"semver":           {kind: "semver", v1: "v1.9.0", v2: "v1.10.0", e: true},
+		"semver-pre":       {kind: "semver", v1: "1.10.0", v2: "1.10.0-rc.1", e: false},
+		"semver-pre-order": {kind: "semver", v1: "1.10.0-rc.1", v2: "1.10.0-rc.2", e: true},
+		"string":           {kind: "string", v1: "a10", v2: "a9", e: true},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, model1.LessAs(u.kind, "id1", "id2", u.v1, u.v2))
+		})
+	}
+}
@@ -7,7 +7,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"path/filepath"
 	"regexp"
+	"slices"
 	"strings"
 	"sync"
 
@@ -28,6 +30,9 @@ type (
 	SortColumn struct {
 		Name string
 		ASC  bool
+
+		// TieBreaks tracks secondary sort columns in order of precedence.
+		TieBreaks []SortColumn
 	}
 )
 
@@ -37,6 +42,10 @@ type FilterOpts struct {
 	Toast  bool
 	Filter string
 	Invert bool
+
+	// Columns lists the view effective columns column scoped filters may
+	// target. Defaults to all columns.
+	Columns []string
 }
 
 // TableData tracks a K8s resource for tabular display.
@@ -45,6 +54,10 @@ type TableData struct {
 	rowEvents *RowEvents
 	namespace string
 	gvr       client.GVR
+	jpCols    []config.JSONPathCol
+	condCols  bool
+	stamps    bool
+	status    *config.StatusFrom
 	mx        sync.RWMutex
 }
 
@@ -104,16 +117,45 @@ func (t *TableData) Sort(sc SortColumn) {
 	if idx < 0 {
 		return
 	}
-	t.rowEvents.Sort(
+	ties := make([]SortKey, 0, len(sc.TieBreaks))
+	for _, tb := range sc.TieBreaks {
+		tcol, tidx := t.HeadCol(tb.Name, false)
+		if tidx < 0 {
+			continue
+		}
+		ties = append(ties, SortKey{
+			Index:      tidx,
+			IsNumber:   tcol.MX,
+			IsDuration: tcol.Time,
+			IsCapacity: tcol.Capacity,
+			Asc:        tb.ASC,
+			SortAs:     tcol.SortAs,
+		})
+	}
+	t.rowEvents.SortBy(
 		t.GetNamespace(),
-		idx,
-		col.Time,
-		col.MX,
-		col.Capacity,
-		sc.ASC,
+		SortKey{
+			Index:      idx,
+			IsNumber:   col.MX,
+			IsDuration: col.Time,
+			IsCapacity: col.Capacity,
+			Asc:        sc.ASC,
+			SortAs:     col.SortAs,
+		},
+		ties...,
 	)
 }
 
+// Pin orders rows per the given ids. Unknown rows follow in their current order.
+func (t *TableData) Pin(ids []string) {
+	t.rowEvents.Pin(ids)
+}
+
+// RowIDs returns the rows ids in order.
+func (t *TableData) RowIDs() []string {
+	return t.rowEvents.IDs()
+}
+
 func (t *TableData) Header() Header {
 	return t.header
 }
@@ -148,6 +190,16 @@ func (t *TableData) Filter(f FilterOpts) *TableData {
 		td.rowEvents = t.fuzzyFilter(f)
 		return td
 	}
+	if col, op, n, ok := internal.IsCompareSelector(f.Filter); ok {
+		td.rowEvents = t.cmpFilter(f.Filter, col, op, n, internal.IsInverseSelector(f.Filter))
+		return td
+	}
+	if cc, ok := internal.IsColumnSelector(f.Filter); ok {
+		if rr, ok := t.colFilter(cc, f.Columns); ok {
+			td.rowEvents = rr
+			return td
+		}
+	}
 	rr, err := t.rxFilter(f.Filter, internal.IsInverseSelector(f.Filter))
 	if err == nil {
 		td.rowEvents = rr
@@ -186,6 +238,66 @@ func (t *TableData) rxFilter(q string, inverse bool) (*RowEvents, error) {
 	return rr, nil
 }
 
+// cmpFilter keeps rows whose column value satisfies a numeric comparison.
+// Non numeric values fall back to a substring match on the whole query.
+func (t *TableData) cmpFilter(q, col, op string, n float64, inverse bool) *RowEvents {
+	if inverse {
+		q = q[1:]
+	}
+	idx, ok := t.header.IndexOf(strings.ToUpper(col), true)
+	rr := NewRowEvents(t.RowCount() / 2)
+	t.rowEvents.Range(func(_ int, re RowEvent) bool {
+		var match bool
+		if v, cok := cellNumber(re.Row.Fields, idx); ok && cok {
+			match = compare(v, op, n)
+		} else {
+			match = strings.Contains(strings.ToLower(strings.Join(re.Row.Fields, spacer)), strings.ToLower(q))
+		}
+		if match != inverse {
+			rr.Add(re)
+		}
+		return true
+	})
+
+	return rr
+}
+
+// colFilter keeps rows whose scoped columns all contain the given substrings.
+// Returns false if any column is not one of the effective columns so the
+// query may be evaluated as a regular expression instead.
+func (t *TableData) colFilter(cc []internal.ColumnSelector, cols []string) (*RowEvents, bool) {
+	ii := make([]int, 0, len(cc))
+	for _, c := range cc {
+		if len(cols) > 0 && !slices.ContainsFunc(cols, func(s string) bool {
+			return strings.EqualFold(s, c.Column)
+		}) {
+			return nil, false
+		}
+		idx, ok := t.header.IndexOf(strings.ToUpper(c.Column), true)
+		if !ok {
+			return nil, false
+		}
+		ii = append(ii, idx)
+	}
+
+	rr := NewRowEvents(t.RowCount() / 2)
+	t.rowEvents.Range(func(_ int, re RowEvent) bool {
+		for i, c := range cc {
+			var cell string
+			if ii[i] < len(re.Row.Fields) {
+				cell = re.Row.Fields[ii[i]]
+			}
+			if strings.Contains(strings.ToLower(cell), strings.ToLower(c.Value)) == c.Inverse {
+				return true
+			}
+		}
+		rr.Add(re)
+		return true
+	})
+
+	return rr, true
+}
+
 func (t *TableData) fuzzyFilter(q string) *RowEvents {
 	q = strings.TrimSpace(q)
 	ss := make([]string, 0, t.RowCount()/2)
@@ -208,6 +320,30 @@ func (t *TableData) fuzzyFilter(q string) *RowEvents {
 	return rr
 }
 
+// ExcludeNamespaces returns a table without rows from namespaces matching any
+// of the given glob patterns. Only applies to all namespaces tables.
+func (t *TableData) ExcludeNamespaces(pp []string) *TableData {
+	if len(pp) == 0 || !client.IsAllNamespaces(t.GetNamespace()) {
+		return t
+	}
+
+	td := NewTableDataFromTable(t)
+	rr := NewRowEvents(t.RowCount())
+	t.rowEvents.Range(func(_ int, re RowEvent) bool {
+		ns, _ := client.Namespaced(re.Row.ID)
+		for _, p := range pp {
+			if ok, _ := filepath.Match(p, ns); ok {
+				return true
+			}
+		}
+		rr.Add(re)
+		return true
+	})
+	td.rowEvents = rr
+
+	return td
+}
+
 func (t *TableData) filterToast() *RowEvents {
 	idx, ok := t.header.IndexOf("VALID", true)
 	if !ok {
@@ -243,7 +379,14 @@ func (t *TableData) Reset(ns string) {
 }
 
 func (t *TableData) Reconcile(ctx context.Context, r Renderer, oo []runtime.Object) error {
-	var rows Rows
+	var (
+		rows Rows
+		raws []interface{}
+	)
+	cc, conds, sf, stamps := t.getJSONPathCols(), t.hasConditionCols(), t.getStatusFrom(), t.hasTimeStamps()
+	d, decorated := rowDecorator(t.gvr.String())
+	// Raw resources are only needed by view settings hydrators.
+	needRaws := len(cc) > 0 || conds || sf != nil || decorated || stamps
 	if len(oo) > 0 {
 		if r.IsGeneric() {
 			table, ok := oo[0].(*metav1.Table)
@@ -254,16 +397,38 @@ func (t *TableData) Reconcile(ctx context.Context, r Renderer, oo []runtime.Obje
 			if err := GenericHydrate(t.namespace, table, rows, r); err != nil {
 				return err
 			}
+			if needRaws {
+				raws = tableRaws(table)
+			}
 		} else {
 			rows = make(Rows, len(oo))
 			if err := Hydrate(t.namespace, oo, rows, r); err != nil {
 				return err
 			}
+			if needRaws {
+				raws = objectRaws(oo)
+			}
 		}
 	}
 
+	h := r.Header(t.namespace)
+	if len(cc) > 0 {
+		h = jsonPathHydrate(cc, h, rows, raws)
+	}
+	if conds {
+		h = conditionsHydrate(h, rows, raws)
+	}
+	if sf != nil {
+		h = statusFromHydrate(sf, h, rows, raws)
+	}
+	if decorated {
+		h = decorateHydrate(d, h, rows, raws)
+	}
+	if stamps {
+		stampsHydrate(h, rows, raws)
+	}
 	t.Update(rows)
-	t.SetHeader(t.namespace, r.Header(t.namespace))
+	t.SetHeader(t.namespace, h)
 	if t.HeaderCount() == 0 {
 		return fmt.Errorf("fail to list resource %s", t.gvr)
 	}
@@ -321,7 +486,15 @@ func (t *TableData) Labelize(labels []string) *TableData {
 
 // Customize returns a new model with customized column layout.
 func (t *TableData) Customize(vs *config.ViewSetting, sc SortColumn, manual, wide bool) (*TableData, SortColumn) {
-	if vs.IsBlank() {
+	if vs.HasConditionColumns() {
+		evs := vs.ExpandConditionColumns(t.header.ConditionColumns())
+		vs = &evs
+	}
+	if vs.IsBlank() && (vs == nil || len(vs.WideColumns) == 0) && !vs.UsesKubectlOrder() {
+		t := t.transform(vs).hideEmpty(vs).hideUnmatched(vs, t)
+		if _, err := vs.SortCols(); !manual && errors.Is(err, config.ErrNoSort) {
+			return t, SortColumn{}
+		}
 		if sc.Name != "" {
 			return t, sc
 		}
@@ -332,23 +505,154 @@ func (t *TableData) Customize(vs *config.ViewSetting, sc SortColumn, manual, wid
 		return t, sc
 	}
 
-	cols := vs.Columns
+	cols := vs.ColNames()
+	if len(cols) == 0 {
+		cols = t.header.ColumnNames(wide && vs.UsesKubectlOrder() && len(vs.WideColumns) == 0)
+	}
+	cols = vs.KubectlOrderCols(cols)
 	cdata := TableData{
 		gvr:       t.gvr,
 		namespace: t.namespace,
-		header:    t.header.Customize(cols, wide),
 	}
-	ids := t.header.MapIndices(cols, wide)
+	var ids []int
+	if wide && len(vs.WideColumns) > 0 {
+		all := append(slices.Clip(cols), t.header.availableCols(cols, vs.WideColumns)...)
+		cdata.header = t.header.Customize(all, false)
+		for i := len(cols); i < len(cdata.header); i++ {
+			cdata.header[i].Wide = true
+		}
+		ids = t.header.MapIndices(all, false)
+	} else {
+		cdata.header = t.header.Customize(cols, wide)
+		ids = t.header.MapIndices(cols, wide)
+	}
 	cdata.rowEvents = t.rowEvents.Customize(ids)
+	cdata.header.Transform(vs.Transform)
+	cdata.header.Normalize(vs.Normalize)
+	cdata.header.GroupDigits(vs.GroupDigits, vs.DigitSep())
+	cdata.header.SortTypes(vs)
+	if layout, err := vs.TimeLayout(); err == nil {
+		cdata.header.TimeFormat(layout)
+	}
+	cdata.header.MultiValue(vs.MultiValue)
+	out := cdata.hideEmpty(vs).hideUnmatched(vs, t)
 	if manual || vs == nil {
-		return &cdata, sc
+		return out, sc
 	}
-	psc, err := cdata.sortCol(vs)
+	psc, err := out.sortCol(vs)
 	if err != nil {
-		return &cdata, sc
+		return out, sc
 	}
 
-	return &cdata, psc
+	return out, psc
+}
+
+// hideEmpty returns a model without the columns whose cells are all blank if
+// the view auto hides empty columns. Locked columns are always kept.
+func (t *TableData) hideEmpty(vs *config.ViewSetting) *TableData {
+	if vs == nil || !vs.AutoHideEmpty {
+		return t
+	}
+	t.mx.RLock()
+	defer t.mx.RUnlock()
+
+	if t.rowEvents.Len() == 0 {
+		return t
+	}
+	blank := make([]bool, len(t.header))
+	for i := range blank {
+		blank[i] = true
+	}
+	t.rowEvents.Range(func(_ int, re RowEvent) bool {
+		for i, f := range re.Row.Fields {
+			if i < len(blank) && strings.TrimSpace(f) != "" {
+				blank[i] = false
+			}
+		}
+		return true
+	})
+	locked := vs.LockedCols()
+	ids := make([]int, 0, len(t.header))
+	for i, b := range blank {
+		if !b || slices.Contains(locked, t.header[i].Name) {
+			ids = append(ids, i)
+		}
+	}
+	if len(ids) == len(t.header) {
+		return t
+	}
+
+	return t.keepCols(ids)
+}
+
+// hideUnmatched returns a model without the conditional columns whose
+// predicate holds for none of the source rows.
+func (t *TableData) hideUnmatched(vs *config.ViewSetting, src *TableData) *TableData {
+	if vs == nil || len(vs.ConditionalColumns) == 0 {
+		return t
+	}
+	matched := make(map[string]bool, len(vs.ConditionalColumns))
+	for c, q := range vs.ConditionalColumns {
+		matched[c] = src.Filter(FilterOpts{Filter: q}).RowCount() > 0
+	}
+	t.mx.RLock()
+	defer t.mx.RUnlock()
+
+	ids := make([]int, 0, len(t.header))
+	for i, h := range t.header {
+		if m, ok := matched[h.Name]; !ok || m {
+			ids = append(ids, i)
+		}
+	}
+	if len(ids) == len(t.header) {
+		return t
+	}
+
+	return t.keepCols(ids)
+}
+
+// keepCols returns a model retaining only the given columns. Callers must
+// hold the lock.
+func (t *TableData) keepCols(ids []int) *TableData {
+	h := make(Header, 0, len(ids))
+	for _, i := range ids {
+		h = append(h, t.header[i].Clone())
+	}
+
+	return &TableData{
+		gvr:       t.gvr,
+		namespace: t.namespace,
+		header:    h,
+		rowEvents: t.rowEvents.Customize(ids),
+	}
+}
+
+// transform returns a model with column transformers applied if any.
+func (t *TableData) transform(vs *config.ViewSetting) *TableData {
+	if vs == nil {
+		return t
+	}
+	layout, _ := vs.TimeLayout()
+	if len(vs.Transform) == 0 && len(vs.ColumnTypes) == 0 && len(vs.MultiValue) == 0 && len(vs.Normalize) == 0 && len(vs.GroupDigits) == 0 && layout == "" {
+		return t
+	}
+	t.mx.RLock()
+	defer t.mx.RUnlock()
+
+	h := t.header.Clone()
+	h.Transform(vs.Transform)
+	h.Normalize(vs.Normalize)
+	h.GroupDigits(vs.GroupDigits, vs.DigitSep())
+	h.SortTypes(vs)
+	h.TimeFormat(layout)
+	h.MultiValue(vs.MultiValue)
+
+	return &TableData{
+		gvr:       t.gvr,
+		namespace: t.namespace,
+		header:    h,
+		rowEvents: t.rowEvents,
+	}
 }
 
 func (t *TableData) sortCol(vs *config.ViewSetting) (SortColumn, error) {
@@ -357,11 +661,21 @@ func (t *TableData) sortCol(vs *config.ViewSetting) (SortColumn, error) {
 	if t.HeaderCount() == 0 {
 		return psc, errors.New("no header found")
 	}
-	name, order, _ := vs.SortCol()
-	if _, ok := t.header.IndexOf(name, false); ok {
-		psc.Name, psc.ASC = name, order
+	specs, err := vs.SortCols()
+	if errors.Is(err, config.ErrNoSort) {
 		return psc, nil
 	}
+	if len(specs) > 0 {
+		if _, ok := t.header.IndexOf(specs[0].Name, false); ok {
+			psc.Name, psc.ASC = specs[0].Name, !specs[0].Desc
+			for _, s := range specs[1:] {
+				if _, ok := t.header.IndexOf(s.Name, false); ok {
+					psc.TieBreaks = append(psc.TieBreaks, SortColumn{Name: s.Name, ASC: !s.Desc})
+				}
+			}
+			return psc, nil
+		}
+	}
 	if client.IsAllNamespaces(t.GetNamespace()) {
 		if _, ok := t.header.IndexOf("NAMESPACE", false); ok {
 			psc.Name = "NAMESPACE"
@@ -417,6 +731,67 @@ func (t *TableData) GetHeader() Header {
 	return t.header
 }
 
+// SetJSONPathCols sets columns computed from the raw resources.
+func (t *TableData) SetJSONPathCols(cc []config.JSONPathCol) {
+	t.mx.Lock()
+	defer t.mx.Unlock()
+
+	t.jpCols = cc
+}
+
+func (t *TableData) getJSONPathCols() []config.JSONPathCol {
+	t.mx.RLock()
+	defer t.mx.RUnlock()
+
+	return t.jpCols
+}
+
+// SetConditionCols toggles columns computed from the resources status conditions.
+func (t *TableData) SetConditionCols(b bool) {
+	t.mx.Lock()
+	defer t.mx.Unlock()
+
+	t.condCols = b
+}
+
+func (t *TableData) hasConditionCols() bool {
+	t.mx.RLock()
+	defer t.mx.RUnlock()
+
+	return t.condCols
+}
+
+// SetTimeStamps toggles recording the resources raw timestamps rendered by
+// time columns.
+func (t *TableData) SetTimeStamps(b bool) {
+	t.mx.Lock()
+	defer t.mx.Unlock()
+
+	t.stamps = b
+}
+
+func (t *TableData) hasTimeStamps() bool {
+	t.mx.RLock()
+	defer t.mx.RUnlock()
+
+	return t.stamps
+}
+
+// SetStatusFrom sets a status column derived from the resources status conditions.
+func (t *TableData) SetStatusFrom(s *config.StatusFrom) {
+	t.mx.Lock()
+	defer t.mx.Unlock()
+
+	t.status = s
+}
+
+func (t *TableData) getStatusFrom() *config.StatusFrom {
+	t.mx.RLock()
+	defer t.mx.RUnlock()
+
+	return t.status
+}
+
 // SetHeader sets table header.
 func (t *TableData) SetHeader(ns string, h Header) {
 	t.mx.Lock()
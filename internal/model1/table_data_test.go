@@ -4,6 +4,7 @@
 package model1
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/derailed/k9s/internal/client"
@@ -16,6 +17,38 @@ func init() {
 	zerolog.SetGlobalLevel(zerolog.FatalLevel)
 }
 
+// Column resolution ie view columns lookup against the header accounts for a
+// small fraction of Customize, rows projection dominating. Hashing the view
+// setting to key a resolved columns cache costs more than resolving them.
+func BenchmarkTableDataCustomize(b *testing.B) {
+	td, vs := benchTableData()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		_, _ = td.Customize(vs, SortColumn{}, true, false)
+	}
+}
+
+func BenchmarkHeaderResolveColumns(b *testing.B) {
+	td, vs := benchTableData()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		cols := vs.ColNames()
+		_ = td.header.Customize(cols, false)
+		_ = td.header.MapIndices(cols, false)
+	}
+}
+
+func BenchmarkViewSettingHash(b *testing.B) {
+	_, vs := benchTableData()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		_ = vs.Hash()
+	}
+}
+
 func TestTableDataCustomize(t *testing.T) {
 	uu := map[string]struct {
 		t1, e        *TableData
@@ -122,6 +155,352 @@ func TestTableDataCustomize(t *testing.T) {
 	}
 }
 
+func TestTableDataSortColDirection(t *testing.T) {
+	uu := map[string]struct {
+		spec string
+		e    SortColumn
+	}{
+		"bare": {
+			spec: "AGE",
+			e:    SortColumn{Name: "NAME", ASC: true},
+		},
+		"asc": {
+			spec: "AGE:asc",
+			e:    SortColumn{Name: "AGE", ASC: true},
+		},
+		"desc": {
+			spec: "AGE:desc",
+			e:    SortColumn{Name: "AGE"},
+		},
+		"chained": {
+			spec: "AGE:desc,NAME:asc",
+			e:    SortColumn{Name: "AGE", TieBreaks: []SortColumn{{Name: "NAME", ASC: true}}},
+		},
+	}
+
+	td := NewTableDataWithRows(
+		client.NewGVR("test"),
+		Header{
+			HeaderColumn{Name: "NAME"},
+			HeaderColumn{Name: "AGE", Time: true},
+		},
+		NewRowEventsWithEvts(),
+	)
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			sc, err := td.sortCol(&config.ViewSetting{SortColumn: u.spec})
+			assert.NoError(t, err)
+			assert.Equal(t, u.e, sc)
+		})
+	}
+}
+
+func TestTableDataCustomizeNoSort(t *testing.T) {
+	td := NewTableDataWithRows(
+		client.NewGVR("test"),
+		Header{
+			HeaderColumn{Name: "NAME"},
+			HeaderColumn{Name: "AGE", Time: true},
+		},
+		NewRowEventsWithEvts(
+			RowEvent{Row: Row{ID: "C", Fields: Fields{"C", "1m"}}},
+			RowEvent{Row: Row{ID: "A", Fields: Fields{"A", "3m"}}},
+			RowEvent{Row: Row{ID: "B", Fields: Fields{"B", "2m"}}},
+		),
+	)
+	vs := config.ViewSetting{Columns: []string{"NAME", "AGE"}, SortColumn: config.NoSortColumn}
+
+	cdata, sc := td.Customize(&vs, SortColumn{Name: "AGE", ASC: true}, false, true)
+	assert.Equal(t, SortColumn{}, sc)
+	cdata.Sort(sc)
+
+	ids := make([]string, 0, cdata.RowCount())
+	cdata.RowsRange(func(_ int, re RowEvent) bool {
+		ids = append(ids, re.Row.ID)
+		return true
+	})
+	assert.Equal(t, []string{"C", "A", "B"}, ids)
+}
+
+func TestTableDataCustomizeBlankNoSort(t *testing.T) {
+	td := NewTableDataWithRows(
+		client.NewGVR("test"),
+		Header{
+			HeaderColumn{Name: "NAME"},
+			HeaderColumn{Name: "AGE", Time: true},
+		},
+		NewRowEventsWithEvts(
+			RowEvent{Row: Row{ID: "C", Fields: Fields{"C", "1m"}}},
+			RowEvent{Row: Row{ID: "A", Fields: Fields{"A", "3m"}}},
+		),
+	)
+	vs := config.ViewSetting{
+		Active:  "raw",
+		Presets: map[string]config.ViewSetting{"raw": {SortColumn: config.NoSortColumn}},
+	}
+	pvs := vs.Preset()
+
+	_, sc := td.Customize(&pvs, SortColumn{Name: "AGE", ASC: true}, false, false)
+	assert.Equal(t, SortColumn{}, sc)
+
+	_, sc = td.Customize(&pvs, SortColumn{Name: "AGE", ASC: true}, true, false)
+	assert.Equal(t, SortColumn{Name: "AGE", ASC: true}, sc)
+}
+
+func TestTableDataCustomizeColumnTypes(t *testing.T) {
+	td := NewTableDataWithRows(
+		client.NewGVR("test"),
+		Header{
+			HeaderColumn{Name: "NAME"},
+			HeaderColumn{Name: "IP"},
+		},
+		NewRowEventsWithEvts(
+			RowEvent{Row: Row{ID: "A", Fields: Fields{"A", "10.0.0.10"}}},
+			RowEvent{Row: Row{ID: "B", Fields: Fields{"B", "10.0.0.9"}}},
+			RowEvent{Row: Row{ID: "C", Fields: Fields{"C", "10.0.0.100"}}},
+		),
+	)
+	vs := config.ViewSetting{
+		Columns:     []string{"NAME", "IP"},
+		SortColumn:  "IP:asc",
+		ColumnTypes: map[string]string{"IP": config.IPColumn},
+	}
+
+	cdata, sc := td.Customize(&vs, SortColumn{}, false, false)
+	cdata.Sort(sc)
+
+	ids := make([]string, 0, cdata.RowCount())
+	cdata.RowsRange(func(_ int, re RowEvent) bool {
+		ids = append(ids, re.Row.ID)
+		return true
+	})
+	assert.Equal(t, []string{"B", "A", "C"}, ids)
+	assert.Equal(t, config.StringColumn, cdata.Header()[0].SortAs)
+}
+
+func TestTableDataCustomizeConditions(t *testing.T) {
+	td := NewTableDataWithRows(
+		client.NewGVR("test"),
+		Header{
+			HeaderColumn{Name: "NAME"},
+			HeaderColumn{Name: "AGE", Time: true},
+			HeaderColumn{Name: "READY", Wide: true, Condition: true},
+			HeaderColumn{Name: "SYNCED", Wide: true, Condition: true},
+		},
+		NewRowEventsWithEvts(
+			RowEvent{Row: Row{ID: "A", Fields: Fields{"A", "1m", "True", "False"}}},
+		),
+	)
+	vs := config.ViewSetting{Columns: []string{"NAME", config.ConditionColumns, "AGE"}}
+
+	cdata, _ := td.Customize(&vs, SortColumn{}, true, false)
+	assert.Equal(t, []string{"NAME", "READY", "SYNCED", "AGE"}, cdata.ColumnNames(true))
+	re, ok := cdata.RowAt(0)
+	assert.True(t, ok)
+	assert.Equal(t, Fields{"A", "True", "False", "1m"}, re.Row.Fields)
+}
+
+func TestTableDataCustomizeWideColumns(t *testing.T) {
+	uu := map[string]struct {
+		cols, wcols []string
+		e           Header
+	}{
+		"all-wide": {
+			cols: []string{"NAME", "AGE"},
+			e: Header{
+				HeaderColumn{Name: "NAME"},
+				HeaderColumn{Name: "AGE"},
+				HeaderColumn{Name: "STATUS", Wide: true},
+				HeaderColumn{Name: "IP", Wide: true},
+				HeaderColumn{Name: "NODE", Wide: true},
+			},
+		},
+		"picked": {
+			cols:  []string{"NAME", "AGE"},
+			wcols: []string{"NODE", "NAME", "BOZO"},
+			e: Header{
+				HeaderColumn{Name: "NAME"},
+				HeaderColumn{Name: "AGE"},
+				HeaderColumn{Name: "NODE", Wide: true},
+			},
+		},
+		"default-cols": {
+			wcols: []string{"NODE"},
+			e: Header{
+				HeaderColumn{Name: "NAME"},
+				HeaderColumn{Name: "STATUS"},
+				HeaderColumn{Name: "AGE"},
+				HeaderColumn{Name: "NODE", Wide: true},
+			},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			td := NewTableDataWithRows(
+				client.NewGVR("test"),
+				Header{
+					HeaderColumn{Name: "NAME"},
+					HeaderColumn{Name: "STATUS"},
+					HeaderColumn{Name: "IP", Wide: true},
+					HeaderColumn{Name: "NODE", Wide: true},
+					HeaderColumn{Name: "AGE"},
+				},
+				NewRowEventsWithEvts(
+					RowEvent{Row: Row{ID: "A", Fields: Fields{"A", "Running", "10.0.0.1", "n1", "1m"}}},
+				),
+			)
+			vs := config.ViewSetting{Columns: u.cols, WideColumns: u.wcols}
+
+			cdata, _ := td.Customize(&vs, SortColumn{}, true, true)
+			assert.Equal(t, u.e, cdata.Header())
+			re, ok := cdata.RowAt(0)
+			assert.True(t, ok)
+			assert.Equal(t, len(u.e), len(re.Row.Fields))
+			idx, _ := cdata.IndexOfHeader("AGE")
+			assert.Equal(t, "1m", re.Row.Fields[idx])
+		})
+	}
+}
+
+func TestTableDataExcludeNamespaces(t *testing.T) {
+	uu := map[string]struct {
+		ns  string
+		pp  []string
+		ids []string
+	}{
+		"all": {
+			ns:  client.NamespaceAll,
+			pp:  []string{"kube-*"},
+			ids: []string{"default/p1", "fred/p3"},
+		},
+		"many": {
+			ns:  client.BlankNamespace,
+			pp:  []string{"kube-*", "fr?d"},
+			ids: []string{"default/p1"},
+		},
+		"namespaced": {
+			ns:  "kube-system",
+			pp:  []string{"kube-*"},
+			ids: []string{"default/p1", "kube-system/p2", "fred/p3"},
+		},
+		"none": {
+			ns:  client.NamespaceAll,
+			ids: []string{"default/p1", "kube-system/p2", "fred/p3"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			td := NewTableDataFull(
+				client.NewGVR("v1/pods"),
+				u.ns,
+				Header{HeaderColumn{Name: "NAME"}},
+				NewRowEventsWithEvts(
+					RowEvent{Row: Row{ID: "default/p1", Fields: Fields{"p1"}}},
+					RowEvent{Row: Row{ID: "kube-system/p2", Fields: Fields{"p2"}}},
+					RowEvent{Row: Row{ID: "fred/p3", Fields: Fields{"p3"}}},
+				),
+			)
+
+			ids := make([]string, 0, td.RowCount())
+			td.ExcludeNamespaces(u.pp).RowsRange(func(_ int, re RowEvent) bool {
+				ids = append(ids, re.Row.ID)
+				return true
+			})
+			assert.Equal(t, u.ids, ids)
+		})
+	}
+}
+
+func TestTableDataFilterCompare(t *testing.T) {
+	td := NewTableDataWithRows(
+		client.NewGVR("test"),
+		Header{
+			HeaderColumn{Name: "NAME"},
+			HeaderColumn{Name: "RESTARTS"},
+			HeaderColumn{Name: "STATUS"},
+		},
+		NewRowEventsWithEvts(
+			RowEvent{Row: Row{ID: "A", Fields: Fields{"A", "0", "Running"}}},
+			RowEvent{Row: Row{ID: "B", Fields: Fields{"B", "5", "Running"}}},
+			RowEvent{Row: Row{ID: "C", Fields: Fields{"C", "12 (2m ago)", "Error"}}},
+		),
+	)
+
+	uu := map[string]struct {
+		q   string
+		ids []string
+	}{
+		"gt":          {q: "restarts>5", ids: []string{"C"}},
+		"ge":          {q: "RESTARTS >= 5", ids: []string{"B", "C"}},
+		"lt":          {q: "RESTARTS<5", ids: []string{"A"}},
+		"inverse":     {q: "!RESTARTS>5", ids: []string{"A", "B"}},
+		"non-numeric": {q: "STATUS>5", ids: []string{}},
+		"missing-col": {q: "FRED<1", ids: []string{}},
+		"regex":       {q: "Error", ids: []string{"C"}},
+		"inverse-rx":  {q: "!Error", ids: []string{"A", "B"}},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			ids := []string{}
+			td.Filter(FilterOpts{Filter: u.q}).RowsRange(func(_ int, re RowEvent) bool {
+				ids = append(ids, re.Row.ID)
+				return true
+			})
+			assert.Equal(t, u.ids, ids)
+		})
+	}
+}
+
+func TestTableDataFilterColumns(t *testing.T) {
+	td := NewTableDataWithRows(
+		client.NewGVR("test"),
+		Header{
+			HeaderColumn{Name: "NAME"},
+			HeaderColumn{Name: "STATUS"},
+			HeaderColumn{Name: "NODE"},
+		},
+		NewRowEventsWithEvts(
+			RowEvent{Row: Row{ID: "gke-a", Fields: Fields{"gke-a", "Running", "n1"}}},
+			RowEvent{Row: Row{ID: "B", Fields: Fields{"B", "Running", "gke-n2"}}},
+			RowEvent{Row: Row{ID: "C", Fields: Fields{"C", "Error", "GKE-n3"}}},
+		),
+	)
+
+	uu := map[string]struct {
+		q    string
+		cols []string
+		ids  []string
+	}{
+		"scoped":      {q: "NODE:gke-", ids: []string{"B", "C"}},
+		"lower-col":   {q: "node:gke-", ids: []string{"B", "C"}},
+		"and":         {q: "NODE:gke- STATUS:run", ids: []string{"B"}},
+		"inverse":     {q: "NODE:gke- !STATUS:Running", ids: []string{"C"}},
+		"no-match":    {q: "STATUS:Pending", ids: []string{}},
+		"visible":     {q: "NODE:gke-", cols: []string{"NAME", "NODE"}, ids: []string{"B", "C"}},
+		"not-visible": {q: "NODE:gke-", cols: []string{"NAME", "STATUS"}, ids: []string{}},
+		"unknown-col": {q: "FRED:gke-", ids: []string{}},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			ids := []string{}
+			td.Filter(FilterOpts{Filter: u.q, Columns: u.cols}).RowsRange(func(_ int, re RowEvent) bool {
+				ids = append(ids, re.Row.ID)
+				return true
+			})
+			assert.Equal(t, u.ids, ids)
+		})
+	}
+}
+
 func TestTableDataDiff(t *testing.T) {
 	uu := map[string]struct {
 		t1, t2 *TableData
@@ -402,3 +781,240 @@ func TestTableDataDelete(t *testing.T) {
 		})
 	}
 }
+
+func TestTableDataCustomizeAutoHideEmpty(t *testing.T) {
+	uu := map[string]struct {
+		vs   config.ViewSetting
+		rows []RowEvent
+		e    []string
+	}{
+		"off": {
+			vs: config.ViewSetting{Columns: []string{"NAME", "OWNER", "PHASE", "AGE"}},
+			rows: []RowEvent{
+				{Row: Row{ID: "A", Fields: Fields{"A", "", "Ready", "1m"}}},
+			},
+			e: []string{"NAME", "OWNER", "PHASE", "AGE"},
+		},
+		"hidden": {
+			vs: config.ViewSetting{Columns: []string{"NAME", "OWNER", "PHASE", "AGE"}, AutoHideEmpty: true},
+			rows: []RowEvent{
+				{Row: Row{ID: "A", Fields: Fields{"A", "", "", "1m"}}},
+				{Row: Row{ID: "B", Fields: Fields{"B", " ", "Ready", "2m"}}},
+			},
+			e: []string{"NAME", "PHASE", "AGE"},
+		},
+		"locked": {
+			vs: config.ViewSetting{Columns: []string{"NAME", "OWNER!", "PHASE", "AGE"}, AutoHideEmpty: true},
+			rows: []RowEvent{
+				{Row: Row{ID: "A", Fields: Fields{"A", "", "", "1m"}}},
+			},
+			e: []string{"NAME", "OWNER", "AGE"},
+		},
+		"no-rows": {
+			vs: config.ViewSetting{Columns: []string{"NAME", "OWNER", "PHASE", "AGE"}, AutoHideEmpty: true},
+			e:  []string{"NAME", "OWNER", "PHASE", "AGE"},
+		},
+		"default-cols": {
+			vs: config.ViewSetting{AutoHideEmpty: true},
+			rows: []RowEvent{
+				{Row: Row{ID: "A", Fields: Fields{"A", "", "Ready", "1m"}}},
+			},
+			e: []string{"NAME", "PHASE", "AGE"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			td := NewTableDataWithRows(
+				client.NewGVR("test"),
+				Header{
+					HeaderColumn{Name: "NAME"},
+					HeaderColumn{Name: "OWNER"},
+					HeaderColumn{Name: "PHASE"},
+					HeaderColumn{Name: "AGE"},
+				},
+				NewRowEventsWithEvts(u.rows...),
+			)
+
+			cdata, _ := td.Customize(&u.vs, SortColumn{}, true, false)
+			assert.Equal(t, u.e, cdata.Header().ColumnNames(true))
+			if len(u.rows) > 0 {
+				re, ok := cdata.RowAt(0)
+				assert.True(t, ok)
+				assert.Len(t, re.Row.Fields, len(u.e))
+			}
+		})
+	}
+}
+
+func TestTableDataCustomizeConditionalColumns(t *testing.T) {
+	uu := map[string]struct {
+		vs   config.ViewSetting
+		rows []RowEvent
+		e    []string
+	}{
+		"none": {
+			vs: config.ViewSetting{Columns: []string{"NAME", "PHASE", "REASON"}},
+			rows: []RowEvent{
+				{Row: Row{ID: "A", Fields: Fields{"A", "Running", "", "1m"}}},
+			},
+			e: []string{"NAME", "PHASE", "REASON"},
+		},
+		"unmatched": {
+			vs: config.ViewSetting{
+				Columns:            []string{"NAME", "PHASE", "REASON"},
+				ConditionalColumns: map[string]string{"REASON": "PHASE:Failed"},
+			},
+			rows: []RowEvent{
+				{Row: Row{ID: "A", Fields: Fields{"A", "Running", "", "1m"}}},
+				{Row: Row{ID: "B", Fields: Fields{"B", "Pending", "", "2m"}}},
+			},
+			e: []string{"NAME", "PHASE"},
+		},
+		"matched": {
+			vs: config.ViewSetting{
+				Columns:            []string{"NAME", "PHASE", "REASON"},
+				ConditionalColumns: map[string]string{"REASON": "PHASE:Failed"},
+			},
+			rows: []RowEvent{
+				{Row: Row{ID: "A", Fields: Fields{"A", "Running", "", "1m"}}},
+				{Row: Row{ID: "B", Fields: Fields{"B", "Failed", "Evicted", "2m"}}},
+			},
+			e: []string{"NAME", "PHASE", "REASON"},
+		},
+		"off-view": {
+			vs: config.ViewSetting{
+				Columns:            []string{"NAME", "REASON"},
+				ConditionalColumns: map[string]string{"REASON": "!Running"},
+			},
+			rows: []RowEvent{
+				{Row: Row{ID: "A", Fields: Fields{"A", "Running", "", "1m"}}},
+			},
+			e: []string{"NAME"},
+		},
+		"no-rows": {
+			vs: config.ViewSetting{
+				Columns:            []string{"NAME", "PHASE", "REASON"},
+				ConditionalColumns: map[string]string{"REASON": "PHASE:Failed"},
+			},
+			e: []string{"NAME", "PHASE"},
+		},
+		"default-cols": {
+			vs: config.ViewSetting{ConditionalColumns: map[string]string{"AGE": "AGE:h"}},
+			rows: []RowEvent{
+				{Row: Row{ID: "A", Fields: Fields{"A", "Running", "", "1m"}}},
+			},
+			e: []string{"NAME", "PHASE", "REASON"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			td := NewTableDataWithRows(
+				client.NewGVR("test"),
+				Header{
+					HeaderColumn{Name: "NAME"},
+					HeaderColumn{Name: "PHASE"},
+					HeaderColumn{Name: "REASON"},
+					HeaderColumn{Name: "AGE"},
+				},
+				NewRowEventsWithEvts(u.rows...),
+			)
+
+			cdata, _ := td.Customize(&u.vs, SortColumn{}, true, false)
+			assert.Equal(t, u.e, cdata.Header().ColumnNames(true))
+			if len(u.rows) > 0 {
+				re, ok := cdata.RowAt(0)
+				assert.True(t, ok)
+				assert.Len(t, re.Row.Fields, len(u.e))
+			}
+		})
+	}
+}
+
+func TestTableDataCustomizeKubectlOrder(t *testing.T) {
+	kubectl := []string{"NAME", "READY", "STATUS", "AGE", "IP"}
+	uu := map[string]struct {
+		vs   config.ViewSetting
+		wide bool
+		e    []string
+	}{
+		"off": {
+			vs: config.ViewSetting{KubectlColumns: kubectl},
+			e:  []string{"NAMESPACE", "NAME", "READY", "STATUS", "CPU", "IP", "AGE"},
+		},
+		"unresolved": {
+			vs: config.ViewSetting{KubectlOrder: true},
+			e:  []string{"NAMESPACE", "NAME", "READY", "STATUS", "CPU", "IP", "AGE"},
+		},
+		"default-cols": {
+			vs: config.ViewSetting{KubectlOrder: true, KubectlColumns: kubectl},
+			e:  []string{"NAMESPACE", "NAME", "READY", "STATUS", "CPU", "AGE", "IP"},
+		},
+		"wide": {
+			vs:   config.ViewSetting{KubectlOrder: true, KubectlColumns: []string{"NAME", "READY", "STATUS", "AGE", "IP", "NODE"}},
+			wide: true,
+			e:    []string{"NAMESPACE", "NAME", "READY", "STATUS", "CPU", "AGE", "IP", "NODE"},
+		},
+		"columns": {
+			vs: config.ViewSetting{KubectlOrder: true, KubectlColumns: kubectl, Columns: []string{"AGE", "CPU", "STATUS", "NAME"}},
+			e:  []string{"NAME", "CPU", "STATUS", "AGE"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			td := NewTableDataWithRows(
+				client.NewGVR("test"),
+				Header{
+					HeaderColumn{Name: "NAMESPACE"},
+					HeaderColumn{Name: "NAME"},
+					HeaderColumn{Name: "READY"},
+					HeaderColumn{Name: "STATUS"},
+					HeaderColumn{Name: "CPU"},
+					HeaderColumn{Name: "IP"},
+					HeaderColumn{Name: "NODE", Wide: true},
+					HeaderColumn{Name: "AGE"},
+				},
+				NewRowEventsWithEvts(RowEvent{Row: Row{ID: "A", Fields: Fields{"ns", "A", "1/1", "Running", "10", "1.1.1.1", "n1", "1m"}}}),
+			)
+
+			cdata, _ := td.Customize(&u.vs, SortColumn{}, true, u.wide)
+			hh := cdata.Header().ColumnNames(true)
+			if !u.wide {
+				hh = cdata.Header().ColumnNames(false)
+			}
+			assert.Equal(t, u.e, hh)
+			re, ok := cdata.RowAt(0)
+			assert.True(t, ok)
+			idx, _ := cdata.Header().IndexOf("AGE", true)
+			assert.Equal(t, "1m", re.Row.Fields[idx])
+		})
+	}
+}
+
+// Helpers...
+
+func benchTableData() (*TableData, *config.ViewSetting) {
+	h := make(Header, 0, 20)
+	for i := 0; i < 20; i++ {
+		h = append(h, HeaderColumn{Name: fmt.Sprintf("C%d", i)})
+	}
+	ee := make([]RowEvent, 0, 200)
+	for r := 0; r < 200; r++ {
+		ff := make(Fields, len(h))
+		for i := range ff {
+			ff[i] = "v"
+		}
+		ee = append(ee, RowEvent{Row: Row{ID: fmt.Sprintf("r%d", r), Fields: ff}})
+	}
+	vs := config.ViewSetting{
+		Columns:    []string{"C3", "C1", "C2", "C5", "C9", "C10", "C12", "C0"},
+		SortColumn: "C1:asc",
+	}
+
+	return NewTableDataWithRows(client.NewGVR("v1/pods"), h, NewRowEventsWithEvts(ee...)), &vs
+}
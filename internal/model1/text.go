@@ -0,0 +1,137 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package model1
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+	"github.com/mattn/go-runewidth"
+)
+
+const (
+	textColSep = "  "
+	ansiReset  = "\x1b[0m"
+	ansiBold   = "\x1b[1m"
+)
+
+// TextOpts represents options to render a table as text.
+type TextOpts struct {
+	// Sort overrides the view sort column when set.
+	Sort SortColumn
+
+	// Filter overrides the view default filter when set.
+	Filter string
+
+	// Wide renders wide columns.
+	Wide bool
+
+	// ANSI colors rows using the colorer or the default colorer if none.
+	ANSI    bool
+	Colorer ColorerFunc
+}
+
+// RenderText renders the table customized by a view setting as a plain text
+// table. Rows are filtered, sorted and decorated as in the table view. Rows
+// are sorted by name unless the view specifies a sort or disables sorting.
+func (t *TableData) RenderText(vs *config.ViewSetting, opts TextOpts) string {
+	data := t
+	q := opts.Filter
+	if vs != nil {
+		data = data.ExcludeNamespaces(vs.ExcludeNamespaces)
+		if q == "" {
+			q = vs.DefaultFilter
+		}
+	}
+	if q != "" {
+		var cols []string
+		if vs != nil && !opts.Wide && len(vs.Columns) > 0 {
+			cols = vs.ColNames()
+		}
+		data = data.Filter(FilterOpts{Filter: q, Columns: cols})
+	}
+	cdata, sc := data.Customize(vs, opts.Sort, opts.Sort.Name != "", opts.Wide)
+	if _, err := vs.SortCols(); sc.Name == "" && !errors.Is(err, config.ErrNoSort) {
+		sc = SortColumn{Name: "NAME", ASC: true}
+	}
+	cdata.Sort(sc)
+
+	h := cdata.Header()
+	idx := make([]int, 0, len(h))
+	for i, c := range h {
+		if !opts.Wide && c.Wide {
+			continue
+		}
+		idx = append(idx, i)
+	}
+	header := make([]string, 0, len(idx))
+	for _, i := range idx {
+		header = append(header, h[i].Name)
+	}
+	rows := [][]string{header}
+	var ee []RowEvent
+	cdata.RowsRange(func(_ int, re RowEvent) bool {
+		row := make([]string, 0, len(idx))
+		for _, i := range idx {
+			var field string
+			if i < len(re.Row.Fields) {
+				field = re.Row.Fields[i]
+			}
+			row = append(row, h[i].Decorate(re.Row, field))
+		}
+		rows, ee = append(rows, row), append(ee, re)
+		return true
+	})
+
+	widths := make([]int, len(idx))
+	for _, r := range rows {
+		for i, f := range r {
+			widths[i] = max(widths[i], runewidth.StringWidth(f))
+		}
+	}
+	colorer := opts.Colorer
+	if colorer == nil {
+		colorer = DefaultColorer
+	}
+
+	var b strings.Builder
+	for r, row := range rows {
+		cells := make([]string, 0, len(row))
+		for i, f := range row {
+			if h[idx[i]].Align == tview.AlignRight {
+				cells = append(cells, runewidth.FillLeft(f, widths[i]))
+			} else {
+				cells = append(cells, runewidth.FillRight(f, widths[i]))
+			}
+		}
+		line := strings.TrimRight(strings.Join(cells, textColSep), " ")
+		if opts.ANSI {
+			if r == 0 {
+				line = ansiBold + line + ansiReset
+			} else {
+				line = ansiColor(colorer(cdata.GetNamespace(), h, &ee[r-1]), line)
+			}
+		}
+		b.WriteString(line)
+		b.WriteByte('\n')
+	}
+
+	return b.String()
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func ansiColor(c tcell.Color, s string) string {
+	r, g, b := c.RGB()
+	if r < 0 {
+		return s
+	}
+
+	return fmt.Sprintf("\x1b[38;2;%d;%d;%dm%s%s", r, g, b, s, ansiReset)
+}
@@ -0,0 +1,96 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package model1_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTableDataRenderText(t *testing.T) {
+	uu := map[string]struct {
+		vs   *config.ViewSetting
+		opts model1.TextOpts
+		e    string
+	}{
+		"default": {
+			e: "NAME  AGE  CPU\n" +
+				"a     5m   100\n" +
+				"b     2m     5\n" +
+				"c     1h    20\n",
+		},
+		"wide": {
+			opts: model1.TextOpts{Wide: true},
+			e: "NAME  AGE  CPU  LABELS\n" +
+				"a     5m   100  app=a\n" +
+				"b     2m     5  app=b\n" +
+				"c     1h    20  app=c\n",
+		},
+		"columns": {
+			vs: &config.ViewSetting{Columns: []string{"CPU", "NAME"}, SortColumn: "CPU:desc"},
+			e: "CPU  NAME\n" +
+				"100  a\n" +
+				" 20  c\n" +
+				"  5  b\n",
+		},
+		"no-sort": {
+			vs: &config.ViewSetting{Columns: []string{"NAME", "CPU"}, SortColumn: config.NoSortColumn},
+			e: "NAME  CPU\n" +
+				"c      20\n" +
+				"a     100\n" +
+				"b       5\n",
+		},
+		"sort-override": {
+			vs:   &config.ViewSetting{Columns: []string{"NAME", "AGE"}, SortColumn: "NAME:desc"},
+			opts: model1.TextOpts{Sort: model1.SortColumn{Name: "AGE", ASC: true}},
+			e: "NAME  AGE\n" +
+				"b     2m\n" +
+				"a     5m\n" +
+				"c     1h\n",
+		},
+		"filter": {
+			vs: &config.ViewSetting{Columns: []string{"NAME"}, DefaultFilter: "b"},
+			e:  "NAME\nb\n",
+		},
+		"ansi": {
+			vs: &config.ViewSetting{Columns: []string{"NAME"}, DefaultFilter: "b"},
+			opts: model1.TextOpts{ANSI: true, Colorer: func(string, model1.Header, *model1.RowEvent) tcell.Color {
+				return tcell.NewRGBColor(1, 2, 3)
+			}},
+			e: "\x1b[1mNAME\x1b[0m\n\x1b[38;2;1;2;3mb\x1b[0m\n",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, makeTextData().RenderText(u.vs, u.opts))
+		})
+	}
+}
+
+// Helpers...
+
+func makeTextData() *model1.TableData {
+	return model1.NewTableDataWithRows(
+		client.NewGVR("test"),
+		model1.Header{
+			model1.HeaderColumn{Name: "NAME"},
+			model1.HeaderColumn{Name: "AGE", Time: true},
+			model1.HeaderColumn{Name: "CPU", Align: tview.AlignRight, MX: true},
+			model1.HeaderColumn{Name: "LABELS", Wide: true},
+		},
+		model1.NewRowEventsWithEvts(
+			model1.RowEvent{Row: model1.Row{ID: "c", Fields: model1.Fields{"c", "1h", "20", "app=c"}}},
+			model1.RowEvent{Row: model1.Row{ID: "a", Fields: model1.Fields{"a", "5m", "100", "app=a"}}},
+			model1.RowEvent{Row: model1.Row{ID: "b", Fields: model1.Fields{"b", "2m", "5", "app=b"}}},
+		),
+	)
+}
@@ -0,0 +1,167 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package model1
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/rs/zerolog/log"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// QuantityTransform names the quantity column transformer.
+const QuantityTransform = "quantity"
+
+var (
+	binaryUnits  = []string{"Ki", "Mi", "Gi", "Ti", "Pi", "Ei"}
+	decimalUnits = []string{"k", "M", "G", "T", "P", "E"}
+)
+
+// MultiValuers tracks the available list valued columns join styles.
+var MultiValuers = map[string]DecoratorFunc{
+	config.CommaValues: joinValues(", "),
+	config.SpaceValues: joinValues(" "),
+	config.FirstValue:  firstValue,
+	config.CountValues: countValues,
+}
+
+// Transformers tracks the available column transformers.
+var Transformers = map[string]DecoratorFunc{
+	QuantityTransform: ToQuantity,
+}
+
+var digitsRx = regexp.MustCompile(`^([-+]?)(\d+)(\.\d+)?([a-zA-Z%]*)$`)
+
+// normalizeSamples tracks columns already logged as failing normalization.
+var normalizeSamples sync.Map
+
+// ToQuantity formats a raw Kubernetes quantity in a human readable form.
+// Non quantity values are returned as is.
+func ToQuantity(s string) string {
+	v := strings.TrimSpace(s)
+	if v == "" {
+		return s
+	}
+	q, err := resource.ParseQuantity(v)
+	if err != nil {
+		return s
+	}
+	if m := q.MilliValue(); m%1000 != 0 {
+		return strconv.FormatInt(m, 10) + "m"
+	}
+
+	last := v[len(v)-1]
+	if q.Format == resource.BinarySI || (last >= '0' && last <= '9') {
+		return humanize(q.Value(), 1024, binaryUnits)
+	}
+
+	return humanize(q.Value(), 1000, decimalUnits)
+}
+
+// ToUnit returns a decorator converting quantities to a given unit. Unitless
+// values are read in the column native unit if any, base units otherwise.
+// Non quantity values are returned as is and a sample is logged once per column.
+func ToUnit(col, native, unit string) DecoratorFunc {
+	scale := config.NormalizeUnits[unit]
+	return func(s string) string {
+		v := strings.TrimSpace(s)
+		if v == "" || scale == 0 {
+			return s
+		}
+		if mm := digitsRx.FindStringSubmatch(v); native != "" && mm != nil && mm[4] == "" {
+			v += native
+		}
+		q, err := resource.ParseQuantity(v)
+		if err != nil {
+			if _, ok := normalizeSamples.LoadOrStore(col+"|"+unit, struct{}{}); !ok {
+				log.Warn().Msgf("Unable to normalize column %q to %q. Sample value: %q", col, unit, s)
+			}
+			return s
+		}
+
+		return strings.TrimSuffix(fmt.Sprintf("%.1f", q.AsApproximateFloat64()/scale), ".0") + unit
+	}
+}
+
+// ToGroupDigits returns a decorator grouping numbers integer digits by
+// thousands ie 1234567.5Mi -> 1,234,567.5Mi. Non numeric values are returned as is.
+func ToGroupDigits(sep string) DecoratorFunc {
+	return func(s string) string {
+		mm := digitsRx.FindStringSubmatch(strings.TrimSpace(s))
+		if mm == nil || len(mm[2]) <= 3 {
+			return s
+		}
+		dd := mm[2]
+		var b strings.Builder
+		b.WriteString(mm[1])
+		for i := range dd {
+			if i > 0 && (len(dd)-i)%3 == 0 {
+				b.WriteString(sep)
+			}
+			b.WriteByte(dd[i])
+		}
+		b.WriteString(mm[3] + mm[4])
+
+		return b.String()
+	}
+}
+
+func humanize(n int64, base float64, units []string) string {
+	f := math.Abs(float64(n))
+	if f < base {
+		return strconv.FormatInt(n, 10)
+	}
+	var unit string
+	for _, u := range units {
+		if f < base {
+			break
+		}
+		f, unit = f/base, u
+	}
+	if n < 0 {
+		f = -f
+	}
+
+	return strings.TrimSuffix(fmt.Sprintf("%.1f", f), ".0") + unit
+}
+
+// splitValues returns a list valued cell comma separated values.
+func splitValues(s string) []string {
+	var vv []string
+	for _, v := range strings.Split(s, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			vv = append(vv, v)
+		}
+	}
+
+	return vv
+}
+
+func joinValues(sep string) DecoratorFunc {
+	return func(s string) string {
+		return strings.Join(splitValues(s), sep)
+	}
+}
+
+func firstValue(s string) string {
+	vv := splitValues(s)
+	switch len(vv) {
+	case 0:
+		return ""
+	case 1:
+		return vv[0]
+	default:
+		return fmt.Sprintf("%s +%d", vv[0], len(vv)-1)
+	}
+}
+
+func countValues(s string) string {
+	return strconv.Itoa(len(splitValues(s)))
+}
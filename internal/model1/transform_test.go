@@ -0,0 +1,238 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package model1
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToQuantity(t *testing.T) {
+	uu := map[string]struct {
+		s, e string
+	}{
+		"empty":    {},
+		"blank":    {s: " ", e: " "},
+		"none":     {s: "<none>", e: "<none>"},
+		"na":       {s: "n/a", e: "n/a"},
+		"text":     {s: "Running", e: "Running"},
+		"bytes":    {s: "128974848", e: "123Mi"},
+		"small":    {s: "512", e: "512"},
+		"partial":  {s: "1610612736", e: "1.5Gi"},
+		"binary":   {s: "2048Ki", e: "2Mi"},
+		"decimal":  {s: "2000M", e: "2G"},
+		"milli":    {s: "250m", e: "250m"},
+		"cores":    {s: "2000m", e: "2"},
+		"negative": {s: "-2Gi", e: "-2Gi"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, ToQuantity(u.s))
+		})
+	}
+}
+
+func TestHeaderTransform(t *testing.T) {
+	h := Header{
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "MEM"},
+	}
+	h.Transform(map[string]string{"MEM": QuantityTransform, "NAME": "bozo", "ZORG": QuantityTransform})
+
+	assert.Nil(t, h[0].Decorator)
+	assert.NotNil(t, h[1].Decorator)
+	assert.Equal(t, "123Mi", h[1].Decorator("128974848"))
+}
+
+func TestMultiValuers(t *testing.T) {
+	uu := map[string]struct {
+		style, s, e string
+	}{
+		"comma":       {style: config.CommaValues, s: "a,b , c", e: "a, b, c"},
+		"space":       {style: config.SpaceValues, s: "80/TCP,443/TCP", e: "80/TCP 443/TCP"},
+		"first":       {style: config.FirstValue, s: "nginx:1.2,envoy:1.3,fluent:2", e: "nginx:1.2 +2"},
+		"first-one":   {style: config.FirstValue, s: "nginx:1.2", e: "nginx:1.2"},
+		"first-blank": {style: config.FirstValue},
+		"count":       {style: config.CountValues, s: "a,,b", e: "2"},
+		"count-blank": {style: config.CountValues, e: "0"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, MultiValuers[u.style](u.s))
+		})
+	}
+}
+
+func TestHeaderMultiValue(t *testing.T) {
+	h := Header{
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "PORTS"},
+		HeaderColumn{Name: "SIZES"},
+	}
+	h.Transform(map[string]string{"SIZES": QuantityTransform})
+	h.MultiValue(map[string]string{"PORTS": config.FirstValue, "NAME": "bozo", "SIZES": config.CountValues, "ZORG": config.CountValues})
+
+	assert.Nil(t, h[0].Decorator)
+	assert.Equal(t, "80 +1", h[1].Decorator("80,443"))
+	assert.Equal(t, "1", h[2].Decorator("128974848"))
+}
+
+func TestToUnit(t *testing.T) {
+	uu := map[string]struct {
+		native, unit, s, e string
+	}{
+		"empty":    {unit: "MiB"},
+		"mem":      {native: "Mi", unit: "Gi", s: "512", e: "0.5Gi"},
+		"cpu":      {native: "m", unit: "m", s: "250", e: "250m"},
+		"suffixed": {native: "Mi", unit: "Mi", s: "1Gi", e: "1024Mi"},
+		"none":     {unit: "MiB", s: "<none>", e: "<none>"},
+		"binary":   {unit: "MiB", s: "1Gi", e: "1024MiB"},
+		"decimal":  {unit: "Mi", s: "1G", e: "953.7Mi"},
+		"bytes":    {unit: "Ki", s: "2048", e: "2Ki"},
+		"humanize": {unit: "MiB", s: "1.5Gi", e: "1536MiB"},
+		"cores":    {unit: "m", s: "2", e: "2000m"},
+		"negative": {unit: "Gi", s: "-2048Mi", e: "-2Gi"},
+		"unknown":  {unit: "bozo", s: "1Gi", e: "1Gi"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, ToUnit("MEM", u.native, u.unit)(u.s))
+		})
+	}
+}
+
+func TestHeaderNormalize(t *testing.T) {
+	h := Header{
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "MEM"},
+		HeaderColumn{Name: "CPU"},
+	}
+	h.Transform(map[string]string{"MEM": QuantityTransform})
+	h.Normalize(map[string]string{"MEM": "MiB", "CPU": "bozo", "ZORG": "MiB"})
+
+	assert.Nil(t, h[0].Decorator)
+	assert.Equal(t, "123MiB", h[1].Decorator("128974848"))
+	assert.Nil(t, h[2].Decorator)
+}
+
+func TestHeaderNormalizeMetrics(t *testing.T) {
+	h := Header{
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "MEM", MX: true},
+		HeaderColumn{Name: "CPU", MX: true},
+		HeaderColumn{Name: "SIZE"},
+	}
+	h.Normalize(map[string]string{"MEM": "Gi", "CPU": "m", "SIZE": "Ki"})
+
+	assert.Equal(t, "2Gi", h[1].Decorator("2048"))
+	assert.Equal(t, "1500m", h[2].Decorator("1500"))
+	assert.Equal(t, "2Ki", h[3].Decorator("2048"))
+}
+
+func TestToGroupDigits(t *testing.T) {
+	uu := map[string]struct {
+		sep, s, e string
+	}{
+		"empty":     {sep: ","},
+		"none":      {sep: ",", s: "<none>", e: "<none>"},
+		"short":     {sep: ",", s: "123", e: "123"},
+		"thousand":  {sep: ",", s: "1234", e: "1,234"},
+		"million":   {sep: ",", s: "1234567", e: "1,234,567"},
+		"negative":  {sep: ",", s: "-123456", e: "-123,456"},
+		"decimal":   {sep: ",", s: "12345.678", e: "12,345.678"},
+		"unit":      {sep: ",", s: "20480MiB", e: "20,480MiB"},
+		"separator": {sep: ".", s: "1234567", e: "1.234.567"},
+		"grouped":   {sep: ",", s: "1,234", e: "1,234"},
+		"text":      {sep: ",", s: "fred1234", e: "fred1234"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, ToGroupDigits(u.sep)(u.s))
+		})
+	}
+}
+
+func TestHeaderGroupDigits(t *testing.T) {
+	h := Header{
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "MEM"},
+		HeaderColumn{Name: "RESTARTS"},
+	}
+	h.Normalize(map[string]string{"MEM": "KiB"})
+	h.GroupDigits([]string{"MEM", "RESTARTS", "ZORG"}, " ")
+
+	assert.Nil(t, h[0].Decorator)
+	assert.Equal(t, "1 048 576KiB", h[1].Decorator("1Gi"))
+	assert.Equal(t, "12 345", h[2].Decorator("12345"))
+}
+
+func TestHeaderTimeFormat(t *testing.T) {
+	ts := time.Date(2024, 3, 4, 5, 6, 7, 0, time.UTC)
+	h := Header{
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "AGE", Time: true, Decorator: strings.ToUpper},
+	}
+	h.TimeFormat("Jan 02 15:04")
+
+	uu := map[string]struct {
+		col  int
+		r    Row
+		s, e string
+	}{
+		"stamped": {
+			col: 1,
+			r:   Row{Stamps: map[string]time.Time{"AGE": ts}},
+			s:   "3d",
+			e:   "MAR 04 05:06",
+		},
+		"unstamped": {
+			col: 1,
+			s:   "3d",
+			e:   "3D",
+		},
+		"not-time": {
+			r: Row{Stamps: map[string]time.Time{"AGE": ts}},
+			s: "fred",
+			e: "fred",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, h[u.col].Decorate(u.r, u.s))
+		})
+	}
+}
+
+func TestStampsHydrate(t *testing.T) {
+	h := Header{
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "AGE", Time: true},
+	}
+	rows := Rows{
+		{ID: "a", Fields: Fields{"a", "3d"}},
+		{ID: "b", Fields: Fields{"b", "3d"}},
+	}
+	raws := []interface{}{
+		map[string]interface{}{"metadata": map[string]interface{}{"creationTimestamp": "2024-03-04T05:06:07Z"}},
+		map[string]interface{}{"metadata": map[string]interface{}{}},
+	}
+	stampsHydrate(h, rows, raws)
+
+	assert.Equal(t, time.Date(2024, 3, 4, 5, 6, 7, 0, time.UTC), rows[0].Stamps["AGE"])
+	assert.Nil(t, rows[1].Stamps)
+}
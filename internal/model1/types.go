@@ -6,6 +6,7 @@ package model1
 import (
 	"github.com/derailed/tcell/v2"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 )
 
 const (
@@ -33,6 +34,12 @@ type DecoratorFunc func(string) string
 // ColorerFunc represents a resource row colorer.
 type ColorerFunc func(ns string, h Header, re *RowEvent) tcell.Color
 
+// RawObjecter represents a decorated resource exposing its raw form.
+type RawObjecter interface {
+	// RawObject returns the raw resource.
+	RawObject() *unstructured.Unstructured
+}
+
 // Renderer represents a resource renderer.
 type Renderer interface {
 	// IsGeneric identifies a generic handler.
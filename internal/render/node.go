@@ -158,6 +158,11 @@ func (n *NodeWithMetrics) DeepCopyObject() runtime.Object {
 	return n
 }
 
+// RawObject returns the raw node.
+func (n *NodeWithMetrics) RawObject() *unstructured.Unstructured {
+	return n.Raw
+}
+
 type metric struct {
 	cpu, mem   int64
 	lcpu, lmem int64
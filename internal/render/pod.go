@@ -223,6 +223,11 @@ func (p *PodWithMetrics) DeepCopyObject() runtime.Object {
 	return p
 }
 
+// RawObject returns the raw pod.
+func (p *PodWithMetrics) RawObject() *unstructured.Unstructured {
+	return p.Raw
+}
+
 func gatherCoMX(cc []v1.Container, ccmx []mv1beta1.ContainerMetrics) (c, r metric) {
 	rcpu, rmem := cosRequests(cc)
 	r.cpu, r.mem = rcpu.MilliValue(), rmem.Value()
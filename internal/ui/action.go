@@ -21,11 +21,13 @@ type (
 
 	// ActionOpts tracks various action options.
 	ActionOpts struct {
-		Visible   bool
-		Shared    bool
-		Plugin    bool
-		HotKey    bool
-		Dangerous bool
+		Visible     bool
+		Shared      bool
+		Plugin      bool
+		HotKey      bool
+		ViewKey     bool
+		ViewFeature bool
+		Dangerous   bool
 	}
 
 	// KeyAction represents a keyboard action.
@@ -9,6 +9,7 @@ import (
 	"io/fs"
 	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/derailed/k9s/internal/model"
 	"github.com/derailed/k9s/internal/model1"
@@ -18,6 +19,9 @@ import (
 	"github.com/rs/zerolog/log"
 )
 
+// viewsNotifyDelay coalesces views listeners refreshes on watched reloads.
+const viewsNotifyDelay = 50 * time.Millisecond
+
 // Synchronizer manages ui event queue.
 type synchronizer interface {
 	Flash() *model.Flash
@@ -34,6 +38,7 @@ type Configurator struct {
 	CustomView *config.CustomView
 	BenchFile  string
 	skinFile   string
+	viewSkin   string
 }
 
 // HasSkin returns true if a skin file was located.
@@ -42,17 +47,24 @@ func (c *Configurator) HasSkin() bool {
 }
 
 // CustomViewsWatcher watches for view config file changes.
+// Coalesced listeners notifications are dispatched on the UI event loop.
 func (c *Configurator) CustomViewsWatcher(ctx context.Context, s synchronizer) error {
 	w, err := fsnotify.NewWatcher()
 	if err != nil {
 		return err
 	}
+	if c.CustomView == nil {
+		c.CustomView = config.NewCustomView()
+	}
+	c.CustomView.NotifyDelay, c.CustomView.Dispatch = viewsNotifyDelay, s.QueueUpdateDraw
 
 	go func() {
 		for {
 			select {
 			case evt := <-w.Events:
-				if evt.Name == config.AppViewsFile && evt.Op != fsnotify.Chmod {
+				dir := filepath.Dir(evt.Name)
+				isViews := evt.Name == config.AppViewsFile || dir == config.AppViewsDir || dir == config.AppViewProfilesDir
+				if isViews && evt.Op != fsnotify.Chmod {
 					s.QueueUpdateDraw(func() {
 						if err := c.RefreshCustomViews(); err != nil {
 							log.Warn().Err(err).Msgf("Custom views refresh failed")
@@ -75,19 +87,51 @@ func (c *Configurator) CustomViewsWatcher(ctx context.Context, s synchronizer) e
 	if err := w.Add(config.AppViewsFile); err != nil {
 		return err
 	}
+	for _, dir := range []string{config.AppViewsDir, config.AppViewProfilesDir} {
+		if _, err := os.Stat(dir); err == nil {
+			if err := w.Add(dir); err != nil {
+				return err
+			}
+		}
+	}
 
 	return c.RefreshCustomViews()
 }
 
+// CustomViewsSignalReload reloads view configurations on SIGUSR1. Reloads run
+// on the UI event loop and failed reloads keep the current views.
+func (c *Configurator) CustomViewsSignalReload(s synchronizer) {
+	if c.CustomView == nil {
+		c.CustomView = config.NewCustomView()
+	}
+	c.CustomView.InstallSignalReloadFunc(func() error {
+		s.QueueUpdateDraw(func() {
+			if err := c.RefreshCustomViews(); err != nil {
+				log.Warn().Err(err).Msgf("Custom views refresh failed")
+			}
+		})
+		return nil
+	})
+}
+
 // RefreshCustomViews load view configuration changes.
 func (c *Configurator) RefreshCustomViews() error {
 	if c.CustomView == nil {
 		c.CustomView = config.NewCustomView()
-	} else {
-		c.CustomView.Reset()
+	}
+	if c.Config != nil {
+		c.CustomView.SetContext(c.Config.ActiveContextName())
+	}
+
+	if err := config.LoadViewProfiles(config.AppViewProfilesDir); err != nil {
+		log.Warn().Err(err).Msgf("Views profiles load failed")
+	}
+
+	if c.Config != nil && c.Config.K9s != nil && c.Config.K9s.ViewsURL != "" {
+		return c.CustomView.LoadURL(context.Background(), c.Config.K9s.ViewsURL)
 	}
 
-	return c.CustomView.Load(config.AppViewsFile)
+	return c.CustomView.Refresh(config.AppViewsFile, config.AppViewsDir)
 }
 
 // SkinsDirWatcher watches for skin directory file changes.
@@ -223,13 +267,21 @@ func (c *Configurator) activeConfig() (cluster string, context string, ok bool)
 	return
 }
 
-// RefreshStyles load for skin configuration changes.
+// RefreshStyles load for skin configuration changes. The active view skin if
+// any is re-applied over the refreshed styles.
 func (c *Configurator) RefreshStyles(s synchronizer) {
 	s.UpdateClusterInfo()
+	skin := c.viewSkin
+	c.viewSkin = ""
 	if c.Styles == nil {
 		c.Styles = config.NewStyles()
 	}
-	defer c.loadSkinFile(s)
+	defer func() {
+		c.loadSkinFile(s)
+		if skin != "" {
+			c.ApplyViewSkin(s, skin)
+		}
+	}()
 
 	cl, ct, ok := c.activeConfig()
 	if !ok {
@@ -243,6 +295,35 @@ func (c *Configurator) RefreshStyles(s synchronizer) {
 	}
 }
 
+// ApplyViewSkin swaps in a view specific skin. A blank skin restores the active skin.
+func (c *Configurator) ApplyViewSkin(s synchronizer, skin string) {
+	if c.Styles == nil || skin == c.viewSkin {
+		return
+	}
+	if skin == "" {
+		c.viewSkin = ""
+		c.Styles.Reset()
+		c.loadSkinFile(s)
+		return
+	}
+
+	skinFile := config.SkinFileFromName(skin)
+	if _, err := os.Stat(skinFile); err != nil {
+		log.Warn().Msgf("View skin %q not found in skins dir: %s. Using active skin", skin, config.AppSkinsDir)
+		s.Flash().Warnf("Unknown view skin %q. Using active skin", skin)
+		return
+	}
+	c.Styles.Reset()
+	if err := c.Styles.Load(skinFile); err != nil {
+		log.Error().Msgf("Failed to parse view skin file -- %s: %s.", filepath.Base(skinFile), err)
+		c.Styles.Reset()
+		c.loadSkinFile(s)
+		return
+	}
+	c.viewSkin = skin
+	c.applyStyles()
+}
+
 func (c *Configurator) loadSkinFile(s synchronizer) {
 	skin, ok := c.activeSkin()
 	if !ok {
@@ -271,6 +352,10 @@ func (c *Configurator) updateStyles(f string) {
 	if f == "" {
 		c.Styles.Reset()
 	}
+	c.applyStyles()
+}
+
+func (c *Configurator) applyStyles() {
 	c.Styles.Update()
 
 	model1.ModColor = c.Styles.Frame().Status.ModifyColor.Color()
@@ -51,6 +51,35 @@ func TestSkinnedContext(t *testing.T) {
 	assert.Equal(t, tcell.ColorWhiteSmoke.TrueColor(), model1.ErrColor)
 }
 
+func TestApplyViewSkin(t *testing.T) {
+	os.Setenv(config.K9sEnvConfigDir, "/tmp/k9s-test")
+	assert.NoError(t, config.InitLocs())
+	defer assert.NoError(t, os.RemoveAll(config.K9sEnvConfigDir))
+
+	sf := filepath.Join("..", "config", "testdata", "skins", "black-and-wtf.yaml")
+	raw, err := os.ReadFile(sf)
+	assert.NoError(t, err)
+	tf := filepath.Join(config.AppSkinsDir, "black-and-wtf.yaml")
+	assert.NoError(t, os.WriteFile(tf, raw, data.DefaultFileMod))
+
+	var cfg ui.Configurator
+	cfg.Config = mock.NewMockConfig()
+	cfg.RefreshStyles(newMockSynchronizer())
+	stock := model1.StdColor
+
+	cfg.ApplyViewSkin(newMockSynchronizer(), "bozo")
+	assert.Equal(t, stock, model1.StdColor)
+
+	cfg.ApplyViewSkin(newMockSynchronizer(), "black-and-wtf")
+	assert.Equal(t, tcell.ColorGhostWhite.TrueColor(), model1.StdColor)
+
+	cfg.RefreshStyles(newMockSynchronizer())
+	assert.Equal(t, tcell.ColorGhostWhite.TrueColor(), model1.StdColor)
+
+	cfg.ApplyViewSkin(newMockSynchronizer(), "")
+	assert.Equal(t, stock, model1.StdColor)
+}
+
 func TestBenchConfig(t *testing.T) {
 	os.Setenv(config.K9sEnvConfigDir, "/tmp/test-config")
 	assert.NoError(t, config.InitLocs())
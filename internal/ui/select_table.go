@@ -12,11 +12,12 @@ import (
 type SelectTable struct {
 	*tview.Table
 
-	model      Tabular
-	selectedFn func(string) string
-	marks      map[string]struct{}
-	selFgColor tcell.Color
-	selBgColor tcell.Color
+	model        Tabular
+	selectedFn   func(string) string
+	selChangedFn func(r int)
+	marks        map[string]struct{}
+	selFgColor   tcell.Color
+	selBgColor   tcell.Color
 }
 
 // SetModel sets the table model.
@@ -107,7 +108,7 @@ func (s *SelectTable) SelectRow(r, c int, broadcast bool) {
 	if !broadcast {
 		s.SetSelectionChangedFunc(nil)
 	}
-	if c := s.model.RowCount(); c > 0 && r-1 > c {
+	if c := s.GetRowCount() - 1; c > 0 && r-1 > c {
 		r = c + 1
 	}
 	defer s.SetSelectionChangedFunc(s.selectionChanged)
@@ -129,6 +130,14 @@ func (s *SelectTable) selectionChanged(r, c int) {
 			tcell.StyleDefault.Foreground(s.selFgColor).
 				Background(cell.Color).Attributes(tcell.AttrBold))
 	}
+	if r > 0 && r == s.GetRowCount()-1 {
+		if p, ok := s.model.(Pager); ok {
+			p.NextPage()
+		}
+	}
+	if s.selChangedFn != nil {
+		s.selChangedFn(r)
+	}
 }
 
 // ClearMarks delete all marked items.
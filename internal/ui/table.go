@@ -6,7 +6,10 @@ package ui
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
@@ -24,6 +27,9 @@ import (
 
 const maxTruncate = 50
 
+// kubectlCols caches the resolved kubectl columns order per resource.
+var kubectlCols sync.Map
+
 type (
 	// ColorerFunc represents a row colorer.
 	ColorerFunc func(ns string, evt model1.RowEvent) tcell.Color
@@ -33,6 +39,15 @@ type (
 
 	// SelectedRowFunc a table selection callback.
 	SelectedRowFunc func(r int)
+
+	// ViewSettingFunc represents a view setting change callback.
+	ViewSettingFunc func(*config.ViewSetting)
+
+	// PrinterColsFunc resolves a resource printer columns.
+	PrinterColsFunc func(client.GVR) ([]string, error)
+
+	// QueueFunc queues an update on the UI event loop.
+	QueueFunc func(func())
 )
 
 // Table represents tabular data.
@@ -43,17 +58,43 @@ type Table struct {
 	Path       string
 	Extras     string
 	*SelectTable
-	actions     *KeyActions
-	cmdBuff     *model.FishBuff
-	styles      *config.Styles
-	viewSetting *config.ViewSetting
-	colorerFn   model1.ColorerFunc
-	decorateFn  DecorateFunc
-	wide        bool
-	toast       bool
-	hasMetrics  bool
-	ctx         context.Context
-	mx          sync.RWMutex
+	actions       *KeyActions
+	cmdBuff       *model.FishBuff
+	styles        *config.Styles
+	viewSetting   *config.ViewSetting
+	baseVS        *config.ViewSetting
+	breakpoint    string
+	width         int
+	views         *config.CustomView
+	colorerFn     model1.ColorerFunc
+	decorateFn    DecorateFunc
+	vsFn          ViewSettingFunc
+	printerFn     PrinterColsFunc
+	kubectlFn     PrinterColsFunc
+	queueFn       QueueFunc
+	kubectlOK     bool
+	toggled       map[string]struct{}
+	groupRows     map[int]string
+	rowCount      int
+	pinned        []string
+	pausedID      string
+	rendering     bool
+	filter        string
+	defaultFilter string
+	visibleCols   []string
+	wide          bool
+	toast         bool
+	hasMetrics    bool
+	scrolled      bool
+	empty         bool
+	cells         map[string]cellChange
+	nextCells     map[string]cellChange
+	highlight     time.Duration
+	masks         map[string]int
+	revealed      map[string]struct{}
+	linkCol       int
+	ctx           context.Context
+	mx            sync.RWMutex
 }
 
 // NewTable returns a new table view.
@@ -64,10 +105,13 @@ func NewTable(gvr client.GVR) *Table {
 			model: model.NewTable(gvr),
 			marks: make(map[string]struct{}),
 		},
-		gvr:     gvr,
-		actions: NewKeyActions(),
-		cmdBuff: model.NewFishBuff('/', model.FilterBuffer),
-		sortCol: model1.SortColumn{ASC: true},
+		gvr:       gvr,
+		actions:   NewKeyActions(),
+		cmdBuff:   model.NewFishBuff('/', model.FilterBuffer),
+		sortCol:   model1.SortColumn{ASC: true},
+		toggled:   make(map[string]struct{}),
+		groupRows: make(map[int]string),
+		revealed:  make(map[string]struct{}),
 	}
 }
 
@@ -125,6 +169,20 @@ func (t *Table) getVs() *config.ViewSetting {
 	return t.viewSetting
 }
 
+func (t *Table) setVisibleCols(cc []string) {
+	t.mx.Lock()
+	defer t.mx.Unlock()
+
+	t.visibleCols = cc
+}
+
+func (t *Table) getVisibleCols() []string {
+	t.mx.RLock()
+	defer t.mx.RUnlock()
+
+	return t.visibleCols
+}
+
 func (t *Table) GetContext() context.Context {
 	return t.ctx
 }
@@ -141,9 +199,11 @@ func (t *Table) Init(ctx context.Context) {
 	t.SetBorderPadding(0, 0, 1, 1)
 	t.SetSelectable(true, false)
 	t.SetSelectionChangedFunc(t.selectionChanged)
+	t.selChangedFn = t.pauseAt
 	t.SetBackgroundColor(tcell.ColorDefault)
 	t.Select(1, 0)
 	if cfg, ok := ctx.Value(internal.KeyViewConfig).(*config.CustomView); ok && cfg != nil {
+		t.views = cfg
 		cfg.AddListener(t.GVR().String(), t)
 	}
 	t.styles = mustExtractStyles(ctx)
@@ -155,12 +215,144 @@ func (t *Table) GVR() client.GVR { return t.gvr }
 
 // ViewSettingsChanged notifies listener the view configuration changed.
 func (t *Table) ViewSettingsChanged(vs config.ViewSetting) {
+	vs = t.atBreakpoint(vs)
+	if vs.HasPrinterColumns() {
+		var cc []string
+		if t.printerFn != nil {
+			var err error
+			if cc, err = t.printerFn(t.GVR()); err != nil {
+				log.Warn().Err(err).Msgf("Using default columns for %q", t.GVR())
+			}
+		}
+		vs = vs.ExpandPrinterColumns(cc)
+	}
+	if vs.KubectlOrder {
+		vs.KubectlColumns = t.resolveKubectlCols()
+	}
 	if t.setVs(&vs) {
+		if _, err := vs.TimeLayout(); err != nil {
+			log.Warn().Err(err).Msgf("Using relative times for %q", t.GVR())
+		}
 		t.setMSort(false)
+		if !vs.RememberGroupState {
+			clear(t.toggled)
+		}
+		if p, ok := t.GetModel().(Pager); ok {
+			p.SetPageSize(vs.PageSize)
+		}
+		if m, ok := t.GetModel().(JSONPathColumner); ok {
+			cc, err := vs.JSONPathCols()
+			if err != nil {
+				log.Warn().Err(err).Msgf("Skipping jsonpath columns for %q", t.GVR())
+			}
+			m.SetJSONPathCols(cc)
+		}
+		if m, ok := t.GetModel().(ConditionColumner); ok {
+			m.SetConditionCols(vs.HasConditionColumns())
+		}
+		if m, ok := t.GetModel().(StatusFromer); ok {
+			m.SetStatusFrom(vs.StatusFrom)
+		}
+		if m, ok := t.GetModel().(TimeStamper); ok {
+			layout, _ := vs.TimeLayout()
+			m.SetTimeStamps(layout != "")
+		}
+		if vs.KeepScroll && !t.scrolled {
+			t.scrolled = true
+			row, _ := t.GetOffset()
+			t.SetOffset(row, vs.ScrollOffset)
+		}
+		if vs.DefaultFilter != t.defaultFilter {
+			t.defaultFilter = vs.DefaultFilter
+			if vs.DefaultFilter != "" && t.cmdBuff.Empty() {
+				t.cmdBuff.SetText(vs.DefaultFilter, "")
+			}
+		}
+		if vs.ActiveFilter != t.filter {
+			t.filter = vs.ActiveFilter
+			if q := vs.ActiveFilterText(); q != "" {
+				t.cmdBuff.SetText(q, "")
+			}
+		}
+		if t.vsFn != nil {
+			t.vsFn(&vs)
+		}
 		t.Refresh()
 	}
 }
 
+// Draw draws the table. Resizes crossing a view breakpoint queue the view
+// setting update on the UI event loop rather than applying it mid draw.
+func (t *Table) Draw(screen tcell.Screen) {
+	_, _, w, _ := t.GetInnerRect()
+	if t.resized(w) && t.queueFn != nil {
+		t.queueFn(t.breakpointChanged)
+	}
+	t.SelectTable.Draw(screen)
+}
+
+// atBreakpoint records a base view setting and returns it with its columns
+// set from the breakpoint matching the table width.
+func (t *Table) atBreakpoint(vs config.ViewSetting) config.ViewSetting {
+	t.mx.Lock()
+	defer t.mx.Unlock()
+
+	base := vs.Clone()
+	t.baseVS, t.breakpoint = &base, vs.BreakpointFor(t.width)
+
+	return vs.ForWidth(t.width)
+}
+
+// resized records the table width and checks if a different breakpoint
+// matches the new width.
+func (t *Table) resized(w int) bool {
+	t.mx.Lock()
+	defer t.mx.Unlock()
+
+	if w == t.width {
+		return false
+	}
+	t.width = w
+
+	return t.baseVS != nil && t.baseVS.BreakpointFor(w) != t.breakpoint
+}
+
+// breakpointChanged re-applies the base view setting if the breakpoint
+// matching the current width changed.
+func (t *Table) breakpointChanged() {
+	t.mx.RLock()
+	base, bp, w := t.baseVS, t.breakpoint, t.width
+	t.mx.RUnlock()
+	if base == nil || base.BreakpointFor(w) == bp {
+		return
+	}
+
+	t.ViewSettingsChanged(base.Clone())
+}
+
+// SaveScrollOffset records the table horizontal scroll position if the view
+// keeps scroll.
+func (t *Table) SaveScrollOffset() {
+	if vs := t.getVs(); t.views == nil || vs == nil || !vs.KeepScroll {
+		return
+	}
+	_, col := t.GetOffset()
+	t.views.SetScrollOffset(t.GVR().String(), t.ViewNamespace(), col)
+}
+
+// ViewNamespaceChanged re-resolves the view setting for the table active
+// namespace.
+func (t *Table) ViewNamespaceChanged() {
+	if t.views != nil {
+		t.views.NotifyListener(t.GVR().String())
+	}
+}
+
+// ViewNamespace returns the table active namespace.
+func (t *Table) ViewNamespace() string {
+	return client.CleanseNamespace(t.GetModel().GetNamespace())
+}
+
 // StylesChanged notifies the skin changed.
 func (t *Table) StylesChanged(s *config.Styles) {
 	t.SetBackgroundColor(s.Table().BgColor.Color())
@@ -192,6 +384,34 @@ func (t *Table) ToggleWide() {
 	t.Refresh()
 }
 
+// ToggleMasked reveals masked columns or masks them back if all are revealed.
+func (t *Table) ToggleMasked() {
+	t.loadMasks()
+	t.mx.Lock()
+	var hidden bool
+	for c := range t.masks {
+		if _, ok := t.revealed[c]; !ok {
+			hidden = true
+			t.revealed[c] = struct{}{}
+		}
+	}
+	if !hidden {
+		clear(t.revealed)
+	}
+	t.mx.Unlock()
+	t.Refresh()
+}
+
+// IsRevealed checks if a masked column renders its raw values.
+func (t *Table) IsRevealed(col string) bool {
+	t.mx.RLock()
+	defer t.mx.RUnlock()
+
+	_, ok := t.revealed[col]
+
+	return ok
+}
+
 // Actions returns active menu bindings.
 func (t *Table) Actions() *KeyActions {
 	return t.actions
@@ -244,6 +464,171 @@ func (t *Table) SetDecorateFn(f DecorateFunc) {
 	t.decorateFn = f
 }
 
+// SetViewSettingFn specifies a callback for view setting changes.
+func (t *Table) SetViewSettingFn(f ViewSettingFunc) {
+	t.vsFn = f
+}
+
+// SetQueueFn specifies how updates are queued on the UI event loop.
+func (t *Table) SetQueueFn(f QueueFunc) {
+	t.queueFn = f
+}
+
+// SetPrinterColsFn specifies the printer columns resolver.
+func (t *Table) SetPrinterColsFn(f PrinterColsFunc) {
+	t.printerFn = f
+}
+
+// SetKubectlColsFn specifies the kubectl columns order resolver.
+func (t *Table) SetKubectlColsFn(f PrinterColsFunc) {
+	t.kubectlFn = f
+}
+
+// resolveKubectlCols returns the resource kubectl columns order if known.
+// Otherwise the order is resolved once per table off the UI event loop and
+// the view setting is re-applied once it is.
+func (t *Table) resolveKubectlCols() []string {
+	if cc, ok := kubectlCols.Load(t.GVR().String()); ok {
+		return cc.([]string)
+	}
+	if t.kubectlOK || t.kubectlFn == nil || t.queueFn == nil {
+		return nil
+	}
+	t.kubectlOK = true
+	go func() {
+		cc, err := t.kubectlFn(t.GVR())
+		if err != nil {
+			log.Info().Err(err).Msgf("No kubectl columns order for %q. Using default order", t.GVR())
+			return
+		}
+		kubectlCols.Store(t.GVR().String(), cc)
+		t.queueFn(t.kubectlColsResolved)
+	}()
+
+	return nil
+}
+
+// kubectlColsResolved re-applies the base view setting once the kubectl
+// columns order is known.
+func (t *Table) kubectlColsResolved() {
+	t.mx.RLock()
+	base := t.baseVS
+	t.mx.RUnlock()
+	if base == nil || !base.KubectlOrder {
+		return
+	}
+
+	t.ViewSettingsChanged(base.Clone())
+}
+
+// linkCols returns the view link columns present in the current header.
+func (t *Table) linkCols() []string {
+	vs := t.getVs()
+	if vs == nil {
+		return nil
+	}
+	h := t.GetModel().Peek().Header()
+	cc := make([]string, 0, len(vs.Links))
+	for _, c := range vs.Preset().Links {
+		if _, ok := h.IndexOf(c, true); ok {
+			cc = append(cc, c)
+		}
+	}
+
+	return cc
+}
+
+// FocusedLinkCol returns the link column currently focused if any.
+func (t *Table) FocusedLinkCol() (string, bool) {
+	cc := t.linkCols()
+	if len(cc) == 0 {
+		return "", false
+	}
+	t.mx.RLock()
+	defer t.mx.RUnlock()
+
+	return cc[t.linkCol%len(cc)], true
+}
+
+// NextLinkCol moves the link focus to the next link column.
+func (t *Table) NextLinkCol() (string, bool) {
+	cc := t.linkCols()
+	if len(cc) == 0 {
+		return "", false
+	}
+	t.mx.Lock()
+	defer t.mx.Unlock()
+	t.linkCol = (t.linkCol + 1) % len(cc)
+
+	return cc[t.linkCol], true
+}
+
+// SelectedLink returns the focused link cell value of the selected row.
+// Values are read off the model hence neither masked nor decorated.
+func (t *Table) SelectedLink() (string, bool) {
+	col, ok := t.FocusedLinkCol()
+	if !ok || t.GetSelectedRowIndex() == 0 {
+		return "", false
+	}
+	id, ok := t.GetRowID(t.GetSelectedRowIndex())
+	if !ok {
+		return "", false
+	}
+	data := t.GetModel().Peek()
+	re, ok := data.FindRow(id)
+	if !ok {
+		return "", false
+	}
+	idx, ok := data.Header().IndexOf(col, true)
+	if !ok || idx >= len(re.Row.Fields) {
+		return "", false
+	}
+	if l := strings.TrimSpace(re.Row.Fields[idx]); l != "" {
+		return l, true
+	}
+
+	return "", false
+}
+
+// ViewSetting returns the current view setting if any.
+func (t *Table) ViewSetting() *config.ViewSetting {
+	return t.getVs()
+}
+
+// ApplySavedFilter sets the filter from a saved filter of the view setting.
+// Explicit selections apply even if the saved filter is already active.
+func (t *Table) ApplySavedFilter(name string) {
+	vs := t.getVs()
+	if vs == nil {
+		return
+	}
+	q, ok := vs.SavedFilters[name]
+	if !ok {
+		return
+	}
+	t.filter = name
+	t.cmdBuff.SetText(q, "")
+}
+
+// EffectiveViewSetting returns the view setting as currently displayed ie
+// visible columns and active sort.
+func (t *Table) EffectiveViewSetting() config.ViewSetting {
+	var vs config.ViewSetting
+	if v := t.getVs(); v != nil {
+		vs = *v
+	}
+	vs.Columns = slices.Clone(t.getVisibleCols())
+	if sc := t.getSortCol(); sc.Name != "" {
+		order := "desc"
+		if sc.ASC {
+			order = "asc"
+		}
+		vs.SortColumn = sc.Name + ":" + order
+	}
+
+	return vs
+}
+
 // SetColorerFn specifies the default colorer.
 func (t *Table) SetColorerFn(f model1.ColorerFunc) {
 	t.colorerFn = f
@@ -273,6 +658,11 @@ func (t *Table) doUpdate(data *model1.TableData) *model1.TableData {
 	} else {
 		t.actions.Delete(KeyShiftP)
 	}
+	if vs := t.getVs(); vs != nil && vs.GroupBy != "" {
+		t.actions.Add(KeyShiftG, NewKeyAction("Toggle Group", t.ToggleGroupCmd, false))
+	} else {
+		t.actions.Delete(KeyShiftG)
+	}
 
 	cdata, sortCol := data.Customize(t.getVs(), t.getSortCol(), t.getMSort(), true)
 	t.setSortCol(sortCol)
@@ -281,11 +671,17 @@ func (t *Table) doUpdate(data *model1.TableData) *model1.TableData {
 }
 
 func (t *Table) UpdateUI(cdata, data *model1.TableData) {
+	t.rendering = true
+	defer func() { t.rendering = false }()
 	t.Clear()
+	t.trackCells()
+	defer t.swapCells()
+	t.loadMasks()
 	fg := t.styles.Table().Header.FgColor.Color()
 	bg := t.styles.Table().Header.BgColor.Color()
 
 	var col int
+	cols := make([]string, 0, cdata.HeaderCount())
 	for _, h := range cdata.Header() {
 		if !t.wide && h.Wide {
 			continue
@@ -304,12 +700,37 @@ func (t *Table) UpdateUI(cdata, data *model1.TableData) {
 		c := t.GetCell(0, col)
 		c.SetBackgroundColor(bg)
 		c.SetTextColor(fg)
+		cols = append(cols, h.Name)
 		col++
 	}
+	t.setVisibleCols(cols)
 	cdata.Sort(t.getSortCol())
+	t.pinRows(cdata)
 
 	pads := make(MaxyPad, cdata.HeaderCount())
 	ComputeMaxColumns(pads, t.getSortCol().Name, cdata)
+	if _, extra := t.density(); extra > 0 {
+		for i := range pads {
+			pads[i] += extra
+		}
+	}
+	clear(t.groupRows)
+	t.rowCount = cdata.RowCount()
+	if t.buildEmptyRow(cdata) {
+		t.restoreSelection()
+		t.UpdateTitle()
+		return
+	}
+	if vs := t.getVs(); vs != nil && vs.GroupBy != "" {
+		gg, err := cdata.Group(vs.GroupBy, vs.GroupSum, vs.GroupBadges)
+		if err == nil {
+			t.buildGroups(gg, data, cdata.Header(), pads)
+			t.restoreSelection()
+			t.UpdateTitle()
+			return
+		}
+		log.Warn().Err(err).Msgf("Unable to group %q rows", t.GVR())
+	}
 	cdata.RowsRange(func(row int, re model1.RowEvent) bool {
 		ore, ok := data.FindRow(re.Row.ID)
 		if !ok {
@@ -321,17 +742,135 @@ func (t *Table) UpdateUI(cdata, data *model1.TableData) {
 		return true
 	})
 
-	t.updateSelection(true)
+	t.restoreSelection()
 	t.UpdateTitle()
 }
 
+func (t *Table) buildGroups(gg []model1.RowGroup, data *model1.TableData, h model1.Header, pads MaxyPad) {
+	vs := t.getVs()
+	if !vs.RememberGroupState {
+		t.pruneToggled(gg)
+	}
+	row := 1
+	for _, g := range gg {
+		_, toggled := t.toggled[g.Name]
+		collapsed := toggled != vs.GroupsCollapsed
+		t.buildGroupRow(row, g, h, collapsed)
+		t.groupRows[row] = g.Name
+		row++
+		if collapsed {
+			continue
+		}
+		for _, re := range g.Rows {
+			ore, ok := data.FindRow(re.Row.ID)
+			if !ok {
+				log.Error().Msgf("unable to find original re: %q", re.Row.ID)
+				continue
+			}
+			t.buildRow(row, re, ore, h, pads)
+			if c := t.GetCell(row, 0); c != nil {
+				c.SetText("  " + c.Text)
+			}
+			row++
+		}
+	}
+}
+
+func (t *Table) buildGroupRow(r int, g model1.RowGroup, h model1.Header, collapsed bool) {
+	glyph := "▾"
+	if collapsed {
+		glyph = "▸"
+	}
+	fg := t.styles.Table().Header.FgColor.Color()
+
+	var col int
+	for c := range h {
+		if !t.wide && h[c].Wide {
+			continue
+		}
+		if h[c].Name == "NAMESPACE" && !t.GetModel().ClusterWide() {
+			continue
+		}
+		if h[c].MX && !t.hasMetrics {
+			continue
+		}
+		if h[c].VS && vul.ImgScanner == nil {
+			continue
+		}
+		var field string
+		if col == 0 {
+			field = fmt.Sprintf("%s %s (%d)", glyph, g.Name, g.Count())
+		}
+		if sum, ok := g.Sums[h[c].Name]; ok {
+			field = sum
+		}
+		if b, ok := g.Badges[h[c].Name]; ok {
+			field = b
+		}
+		cell := tview.NewTableCell(field)
+		cell.SetExpansion(1)
+		cell.SetAlign(h[c].Align)
+		cell.SetTextColor(fg)
+		cell.SetAttributes(tcell.AttrBold)
+		t.SetCell(r, col, cell)
+		col++
+	}
+}
+
+// ToggleGroupCmd collapses or expands the currently selected group.
+func (t *Table) ToggleGroupCmd(evt *tcell.EventKey) *tcell.EventKey {
+	name, ok := t.groupRows[t.GetSelectedRowIndex()]
+	if !ok {
+		return evt
+	}
+	if _, ok := t.toggled[name]; ok {
+		delete(t.toggled, name)
+	} else {
+		t.toggled[name] = struct{}{}
+	}
+	t.Refresh()
+
+	return nil
+}
+
+// pruneToggled forgets the toggle state of groups no longer displayed.
+func (t *Table) pruneToggled(gg []model1.RowGroup) {
+	for name := range t.toggled {
+		if !slices.ContainsFunc(gg, func(g model1.RowGroup) bool { return g.Name == name }) {
+			delete(t.toggled, name)
+		}
+	}
+}
+
 func (t *Table) buildRow(r int, re, ore model1.RowEvent, h model1.Header, pads MaxyPad) {
 	color := model1.DefaultColorer
 	if t.colorerFn != nil {
 		color = t.colorerFn
 	}
 
+	if vs := t.getVs(); vs != nil && (len(vs.MuteStatuses) > 0 || vs.StatusFrom != nil) {
+		col := "STATUS"
+		if vs.StatusFrom != nil {
+			col = vs.StatusFrom.ColName()
+		}
+		if idx, ok := h.IndexOf(col, true); ok && idx < len(re.Row.Fields) {
+			switch status := re.Row.Fields[idx]; {
+			case vs.IsMuted(status):
+				color = mutedColorer
+			case vs.StatusFrom != nil:
+				if c := vs.StatusFrom.ColorFor(status); c != "" {
+					color = statusColorer(c.Color())
+				}
+			}
+		}
+	}
+
 	marked := t.IsMarked(re.Row.ID)
+	exp, _ := t.density()
+	stripe := tcell.ColorDefault
+	if vs := t.getVs(); vs != nil && vs.ZebraStripes && r%2 == 0 {
+		stripe = stripeColor(t.styles.Table().BgColor.Color())
+	}
 	var col int
 	ns := t.GetModel().GetNamespace()
 	for c, field := range re.Row.Fields {
@@ -353,22 +892,33 @@ func (t *Table) buildRow(r int, re, ore model1.RowEvent, h model1.Header, pads M
 			continue
 		}
 
-		if !re.Deltas.IsBlank() && !h.IsTimeCol(c) {
+		flash := t.cellChanged(re.Row.ID, h[c].Name, field)
+		reveal, masked := t.masks[h[c].Name]
+		masked = masked && !t.IsRevealed(h[c].Name)
+		if !masked && !re.Deltas.IsBlank() && !h.IsTimeCol(c) {
 			field += Deltas(re.Deltas[c], field)
 		}
 
-		if h[c].Decorator != nil {
-			field = h[c].Decorator(field)
+		field = h[c].Decorate(re.Row, field)
+		if masked {
+			field = maskValue(field, reveal)
 		}
 		if h[c].Align == tview.AlignLeft {
 			field = formatCell(field, pads[c])
 		}
 
 		cell := tview.NewTableCell(field)
-		cell.SetExpansion(1)
+		cell.SetExpansion(exp)
 		cell.SetAlign(h[c].Align)
+		if stripe != tcell.ColorDefault {
+			cell.SetBackgroundColor(stripe)
+		}
 		fgColor := color(ns, h, &re)
 		cell.SetTextColor(fgColor)
+		if flash {
+			cell.SetBackgroundColor(t.styles.Frame().Status.HighlightColor.Color())
+			cell.SetTextColor(t.styles.Table().BgColor.Color())
+		}
 		if marked {
 			cell.SetTextColor(t.styles.Table().MarkColor.Color())
 		}
@@ -380,6 +930,161 @@ func (t *Table) buildRow(r int, re, ore model1.RowEvent, h model1.Header, pads M
 	}
 }
 
+// buildEmptyRow renders the view empty message if no rows are displayed.
+func (t *Table) buildEmptyRow(cdata *model1.TableData) bool {
+	t.empty = false
+	vs := t.getVs()
+	if vs == nil || vs.EmptyMessage == "" || cdata.RowCount() > 0 {
+		return false
+	}
+	msg := vs.EmptyMessage
+	if hh := t.filterHints(vs); len(hh) > 0 {
+		msg += " (" + strings.Join(hh, ", ") + ")"
+	}
+	cell := tview.NewTableCell(msg)
+	cell.SetTextColor(t.styles.Table().FgColor.Color())
+	cell.SetAttributes(tcell.AttrDim)
+	cell.SetSelectable(false)
+	t.SetCell(1, 0, cell)
+	t.empty = true
+
+	return true
+}
+
+// filterHints returns the filters currently narrowing the view rows.
+func (t *Table) filterHints(vs *config.ViewSetting) []string {
+	var hh []string
+	q := t.cmdBuff.GetText()
+	if q != "" {
+		hh = append(hh, fmt.Sprintf("filter %q", q))
+	}
+	if l := t.GetModel().GetLabelFilter(); l != "" && !internal.IsLabelSelector(q) {
+		hh = append(hh, fmt.Sprintf("labels %q", l))
+	}
+	if t.toast {
+		hh = append(hh, "faults only")
+	}
+
+	return hh
+}
+
+// trackCells starts recording cells values when the view highlights changes.
+func (t *Table) trackCells() {
+	t.nextCells, t.highlight = nil, 0
+	d, err := t.getVs().HighlightFor()
+	if err != nil {
+		log.Warn().Err(err).Msgf("Skipping changes highlight for %q", t.GVR())
+		return
+	}
+	if d > 0 {
+		t.highlight, t.nextCells = d, make(map[string]cellChange, len(t.cells))
+	}
+}
+
+// swapCells retains the cells recorded during the last update, pruning
+// rows that are gone.
+func (t *Table) swapCells() {
+	t.cells, t.nextCells = t.nextCells, nil
+}
+
+// loadMasks loads the view masked columns.
+func (t *Table) loadMasks() {
+	mm, err := t.getVs().MaskSpecs()
+	if err != nil {
+		log.Warn().Err(err).Msgf("Skipping columns mask for %q", t.GVR())
+	}
+	t.mx.Lock()
+	defer t.mx.Unlock()
+	t.masks = mm
+	for c := range t.revealed {
+		if _, ok := mm[c]; !ok {
+			delete(t.revealed, c)
+		}
+	}
+}
+
+// cellChanged records a cell value and checks if it changed within the
+// highlight duration. Cells seen for the first time are not highlighted.
+func (t *Table) cellChanged(id, col, v string) bool {
+	if t.nextCells == nil {
+		return false
+	}
+	key := id + "|" + col
+	prev, ok := t.cells[key]
+	c := cellChange{value: v, at: prev.at}
+	if ok && prev.value != v {
+		c.at = time.Now()
+	}
+	t.nextCells[key] = c
+
+	return !c.at.IsZero() && time.Since(c.at) < t.highlight
+}
+
+// density returns the cells expansion and extra padding for the view density.
+func (t *Table) density() (int, int) {
+	vs := t.getVs()
+	if vs == nil {
+		return 1, 0
+	}
+	switch vs.Density {
+	case config.CompactDensity:
+		return 0, 0
+	case config.ComfortableDensity:
+		return 1, 2
+	default:
+		return 1, 0
+	}
+}
+
+// pinRows keeps the rendered rows order while a row is selected and the view
+// pauses on select.
+func (t *Table) pinRows(cdata *model1.TableData) {
+	vs := t.getVs()
+	if vs == nil || !vs.PauseOnSelect {
+		t.pinned = nil
+		return
+	}
+	if t.pausedID != "" && t.pinned != nil {
+		cdata.Pin(t.pinned)
+	}
+	t.pinned = cdata.RowIDs()
+}
+
+// pauseAt tracks the row selected by the user. Moving the selection away or
+// clearing it resumes rows ordering on the next refresh, the selection
+// following the newly selected row. Selections restored while rendering are
+// ignored.
+func (t *Table) pauseAt(r int) {
+	if t.rendering {
+		return
+	}
+	var id string
+	if r > 0 {
+		id, _ = t.GetRowID(r)
+	}
+	if id == t.pausedID {
+		return
+	}
+	if t.pausedID != "" {
+		t.pinned = nil
+	}
+	t.pausedID = id
+}
+
+// restoreSelection keeps the paused row selected once rows are rendered.
+func (t *Table) restoreSelection() {
+	if t.pausedID != "" {
+		for i := 1; i < t.GetRowCount(); i++ {
+			if id, ok := t.GetRowID(i); ok && id == t.pausedID {
+				_, c := t.GetSelection()
+				t.Select(i, c)
+				break
+			}
+		}
+	}
+	t.updateSelection(true)
+}
+
 // SortColCmd designates a sorted column.
 func (t *Table) SortColCmd(name string, asc bool) func(evt *tcell.EventKey) *tcell.EventKey {
 	return func(evt *tcell.EventKey) *tcell.EventKey {
@@ -387,10 +1092,12 @@ func (t *Table) SortColCmd(name string, asc bool) func(evt *tcell.EventKey) *tce
 		sc.ASC = !sc.ASC
 		if sc.Name != name {
 			sc.ASC = asc
+			sc.TieBreaks = nil
 		}
 		sc.Name = name
 		t.setSortCol(sc)
 		t.setMSort(true)
+		t.pinned = nil
 		t.Refresh()
 		return nil
 	}
@@ -399,6 +1106,7 @@ func (t *Table) SortColCmd(name string, asc bool) func(evt *tcell.EventKey) *tce
 // SortInvertCmd reverses sorting order.
 func (t *Table) SortInvertCmd(evt *tcell.EventKey) *tcell.EventKey {
 	t.toggleSortCol()
+	t.pinned = nil
 	t.Refresh()
 
 	return nil
@@ -450,15 +1158,26 @@ func (t *Table) AddHeaderCell(col int, h model1.HeaderColumn) {
 	sc := t.getSortCol()
 	sortCol := h.Name == sc.Name
 	c := tview.NewTableCell(sortIndicator(sortCol, sc.ASC, t.styles.Table(), h.Name))
-	c.SetExpansion(1)
+	exp, _ := t.density()
+	c.SetExpansion(exp)
 	c.SetAlign(h.Align)
 	t.SetCell(0, col, c)
 }
 
 func (t *Table) filtered(data *model1.TableData) *model1.TableData {
+	q := t.cmdBuff.GetText()
+	var cols []string
+	if vs := t.getVs(); vs != nil {
+		data = data.ExcludeNamespaces(vs.ExcludeNamespaces)
+		if !t.wide && len(vs.Columns) > 0 {
+			cols = vs.ColNames()
+		}
+	}
+
 	return data.Filter(model1.FilterOpts{
-		Toast:  t.toast,
-		Filter: t.cmdBuff.GetText(),
+		Toast:   t.toast,
+		Filter:  q,
+		Columns: cols,
 	})
 }
 
@@ -482,9 +1201,10 @@ func (t *Table) UpdateTitle() {
 }
 
 func (t *Table) styleTitle() string {
-	rc := int64(t.GetRowCount())
-	if rc > 0 {
-		rc--
+	// Group header rows are not resources.
+	rc := int64(t.rowCount)
+	if t.empty {
+		rc = 0
 	}
 
 	base := cases.Title(language.Und, cases.NoLower).String(t.gvr.R())
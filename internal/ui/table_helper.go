@@ -7,9 +7,12 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/model1"
+	"github.com/derailed/tcell/v2"
 	"github.com/rs/zerolog/log"
 )
 
@@ -26,6 +29,9 @@ const (
 	// TitleFmt represents a standard view title.
 	TitleFmt = "[fg:bg:b] %s[fg:bg:-][[count:bg:b]%s[fg:bg:-]][fg:bg:-] "
 
+	// MaskFill represents a masked cell value.
+	MaskFill = "••••"
+
 	descIndicator = "↓"
 	ascIndicator  = "↑"
 
@@ -98,3 +104,51 @@ func formatCell(field string, padding int) string {
 
 	return field
 }
+
+// maskValue masks a cell value, leaving the last n characters visible unless
+// the value is too short to hide anything.
+func maskValue(v string, n int) string {
+	rr := []rune(strings.TrimSpace(v))
+	if n == 0 || len(rr) <= n {
+		return MaskFill
+	}
+
+	return MaskFill + string(rr[len(rr)-n:])
+}
+
+// stripeColor returns a background color slightly offset from the given one
+// to alternate rows with.
+func stripeColor(bg tcell.Color) tcell.Color {
+	const offset = 0x1a
+
+	r, g, b := bg.RGB()
+	if r < 0 {
+		r, g, b = 0, 0, 0
+	}
+	shift := func(c int32) int32 {
+		if r+g+b > 3*0x80 {
+			return max(c-offset, 0)
+		}
+		return min(c+offset, 0xff)
+	}
+
+	return tcell.NewRGBColor(shift(r), shift(g), shift(b))
+}
+
+// mutedColorer renders rows in the standard color regardless of their status.
+func mutedColorer(string, model1.Header, *model1.RowEvent) tcell.Color {
+	return model1.StdColor
+}
+
+// statusColorer renders rows in a derived status color.
+func statusColorer(c tcell.Color) model1.ColorerFunc {
+	return func(string, model1.Header, *model1.RowEvent) tcell.Color {
+		return c
+	}
+}
+
+// cellChange tracks a cell last value and when it last changed.
+type cellChange struct {
+	value string
+	at    time.Time
+}
@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/tcell/v2"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -48,3 +49,29 @@ func TestTrimLabelSelector(t *testing.T) {
 		})
 	}
 }
+
+func TestStripeColor(t *testing.T) {
+	uu := map[string]struct {
+		bg, e tcell.Color
+	}{
+		"default": {
+			bg: tcell.ColorDefault,
+			e:  tcell.NewRGBColor(0x1a, 0x1a, 0x1a),
+		},
+		"dark": {
+			bg: tcell.NewRGBColor(0x10, 0x20, 0xf0),
+			e:  tcell.NewRGBColor(0x2a, 0x3a, 0xff),
+		},
+		"light": {
+			bg: tcell.NewRGBColor(0xff, 0xff, 0xff),
+			e:  tcell.NewRGBColor(0xe5, 0xe5, 0xe5),
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, stripeColor(u.bg))
+		})
+	}
+}
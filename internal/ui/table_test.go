@@ -5,6 +5,7 @@ package ui_test
 
 import (
 	"context"
+	"strings"
 	"testing"
 	"time"
 
@@ -15,6 +16,7 @@ import (
 	"github.com/derailed/k9s/internal/model"
 	"github.com/derailed/k9s/internal/model1"
 	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
 	"github.com/stretchr/testify/assert"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -63,6 +65,608 @@ func TestTableSelection(t *testing.T) {
 	assert.Equal(t, 1, v.GetSelectedRowIndex())
 }
 
+func TestTableEffectiveViewSetting(t *testing.T) {
+	v := ui.NewTable(client.NewGVR("fred"))
+	v.Init(makeContext())
+	v.ViewSettingsChanged(config.ViewSetting{Columns: []string{"C", "A"}, SortColumn: "A:desc", Theme: "blee"})
+
+	data := makeTableData()
+	cdata := v.Update(data, false)
+	v.UpdateUI(cdata, data)
+
+	vs := v.EffectiveViewSetting()
+	assert.Equal(t, []string{"C", "A"}, vs.Columns)
+	assert.Equal(t, "A:desc", vs.SortColumn)
+	assert.Equal(t, "blee", vs.Theme)
+}
+
+func TestTableMuteStatuses(t *testing.T) {
+	v := ui.NewTable(client.NewGVR("fred"))
+	v.Init(makeContext())
+	v.SetColorerFn(func(string, model1.Header, *model1.RowEvent) tcell.Color {
+		return tcell.ColorRed
+	})
+	v.ViewSettingsChanged(config.ViewSetting{MuteStatuses: []string{"fred"}})
+
+	data := model1.NewTableDataWithRows(
+		client.NewGVR("test"),
+		model1.Header{
+			model1.HeaderColumn{Name: "A"},
+			model1.HeaderColumn{Name: "STATUS"},
+		},
+		model1.NewRowEventsWithEvts(
+			model1.RowEvent{Row: model1.Row{ID: "r1", Fields: model1.Fields{"blee", "fred"}}},
+			model1.RowEvent{Row: model1.Row{ID: "r2", Fields: model1.Fields{"duh", "zorg"}}},
+		),
+	)
+	cdata := v.Update(data, false)
+	v.UpdateUI(cdata, data)
+
+	assert.Equal(t, model1.StdColor, v.GetCell(1, 1).Color)
+	assert.Equal(t, tcell.ColorRed, v.GetCell(2, 1).Color)
+}
+
+func TestTableStatusFromColors(t *testing.T) {
+	v := ui.NewTable(client.NewGVR("fred"))
+	v.Init(makeContext())
+	v.SetColorerFn(func(string, model1.Header, *model1.RowEvent) tcell.Color {
+		return tcell.ColorRed
+	})
+	v.ViewSettingsChanged(config.ViewSetting{
+		MuteStatuses: []string{"Paused"},
+		StatusFrom: &config.StatusFrom{
+			Column:     "HEALTH",
+			Conditions: []string{"Ready"},
+			Ready:      config.StatusDisplay{Color: "green"},
+			FailColor:  "orange",
+		},
+	})
+
+	data := model1.NewTableDataWithRows(
+		client.NewGVR("test"),
+		model1.Header{
+			model1.HeaderColumn{Name: "A"},
+			model1.HeaderColumn{Name: "HEALTH"},
+		},
+		model1.NewRowEventsWithEvts(
+			model1.RowEvent{Row: model1.Row{ID: "r1", Fields: model1.Fields{"a", "Ready"}}},
+			model1.RowEvent{Row: model1.Row{ID: "r2", Fields: model1.Fields{"b", "Crashed"}}},
+			model1.RowEvent{Row: model1.Row{ID: "r3", Fields: model1.Fields{"c", "Paused"}}},
+		),
+	)
+	cdata := v.Update(data, false)
+	v.UpdateUI(cdata, data)
+
+	assert.Equal(t, config.NewColor("green").Color(), v.GetCell(1, 1).Color)
+	assert.Equal(t, config.NewColor("orange").Color(), v.GetCell(2, 1).Color)
+	assert.Equal(t, model1.StdColor, v.GetCell(3, 1).Color)
+}
+
+func TestTableEmptyMessage(t *testing.T) {
+	uu := map[string]struct {
+		vs     config.ViewSetting
+		filter string
+		rows   int
+		e      string
+	}{
+		"default": {
+			vs:   config.ViewSetting{Columns: []string{"A"}},
+			rows: 1,
+		},
+		"message": {
+			vs:   config.ViewSetting{Columns: []string{"A"}, EmptyMessage: "No blees found"},
+			rows: 2,
+			e:    "No blees found",
+		},
+		"filtered": {
+			vs:     config.ViewSetting{Columns: []string{"A"}, EmptyMessage: "No blees found"},
+			filter: "zorg",
+			rows:   2,
+			e:      `No blees found (filter "zorg")`,
+		},
+		"default-filter": {
+			vs:   config.ViewSetting{Columns: []string{"A"}, EmptyMessage: "Nothing", DefaultFilter: "zorg"},
+			rows: 2,
+			e:    `Nothing (filter "zorg")`,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			v := ui.NewTable(client.NewGVR("fred"))
+			v.Init(makeContext())
+			v.ViewSettingsChanged(u.vs)
+			if u.filter != "" {
+				v.CmdBuff().SetText(u.filter, "")
+			}
+
+			data := model1.NewTableDataWithRows(
+				client.NewGVR("test"),
+				model1.Header{model1.HeaderColumn{Name: "A"}},
+				model1.NewRowEventsWithEvts(
+					model1.RowEvent{Row: model1.Row{ID: "r1", Fields: model1.Fields{"blee"}}},
+				),
+			)
+			if u.filter == "" && u.vs.DefaultFilter == "" {
+				data = model1.NewTableDataWithRows(client.NewGVR("test"), model1.Header{model1.HeaderColumn{Name: "A"}}, model1.NewRowEvents(0))
+			}
+			cdata := v.Update(data, false)
+			v.UpdateUI(cdata, data)
+
+			assert.Equal(t, u.rows, v.GetRowCount())
+			if u.e != "" {
+				assert.Equal(t, u.e, v.GetCell(1, 0).Text)
+				assert.Contains(t, v.GetTitle(), "b]0[")
+			}
+		})
+	}
+}
+
+func TestTableDefaultFilter(t *testing.T) {
+	v := ui.NewTable(client.NewGVR("fred"))
+	v.Init(makeContext())
+	v.SetModel(&mockModel{})
+	v.ViewSettingsChanged(config.ViewSetting{Columns: []string{"A"}, DefaultFilter: "zorg"})
+	assert.Equal(t, "zorg", v.CmdBuff().GetText())
+
+	v.CmdBuff().Reset()
+	data := makeTableData()
+	v.UpdateUI(v.Update(data, false), data)
+	assert.Empty(t, v.CmdBuff().GetText())
+	assert.Equal(t, data.RowCount()+1, v.GetRowCount())
+
+	v.ViewSettingsChanged(config.ViewSetting{Columns: []string{"A", "B"}, DefaultFilter: "zorg"})
+	assert.Empty(t, v.CmdBuff().GetText())
+}
+
+func TestTableMask(t *testing.T) {
+	uu := map[string]struct {
+		mask      []string
+		e, reveal string
+	}{
+		"none": {
+			e:      "s3cr3t-t0ken",
+			reveal: "s3cr3t-t0ken",
+		},
+		"full": {
+			mask:   []string{"A"},
+			e:      ui.MaskFill,
+			reveal: "s3cr3t-t0ken",
+		},
+		"last": {
+			mask:   []string{"A:4"},
+			e:      ui.MaskFill + "0ken",
+			reveal: "s3cr3t-t0ken",
+		},
+		"short": {
+			mask:   []string{"A:20"},
+			e:      ui.MaskFill,
+			reveal: "s3cr3t-t0ken",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			v := ui.NewTable(client.NewGVR("fred"))
+			v.Init(makeContext())
+			v.ViewSettingsChanged(config.ViewSetting{Columns: []string{"A"}, Mask: u.mask})
+
+			data := model1.NewTableDataWithRows(
+				client.NewGVR("test"),
+				model1.Header{model1.HeaderColumn{Name: "A"}},
+				model1.NewRowEventsWithEvts(
+					model1.RowEvent{Row: model1.Row{ID: "r1", Fields: model1.Fields{"s3cr3t-t0ken"}}},
+				),
+			)
+			cdata := v.Update(data, false)
+			v.UpdateUI(cdata, data)
+			assert.Equal(t, u.e, strings.TrimSpace(v.GetCell(1, 0).Text))
+
+			v.ToggleMasked()
+			v.UpdateUI(cdata, data)
+			assert.Equal(t, u.reveal, strings.TrimSpace(v.GetCell(1, 0).Text))
+			assert.Equal(t, len(u.mask) > 0, v.IsRevealed("A"))
+
+			v.ToggleMasked()
+			v.UpdateUI(cdata, data)
+			assert.Equal(t, u.e, strings.TrimSpace(v.GetCell(1, 0).Text))
+		})
+	}
+}
+
+func TestTableGroupTitle(t *testing.T) {
+	for _, collapsed := range []bool{false, true} {
+		v := ui.NewTable(client.NewGVR("fred"))
+		v.Init(makeContext())
+		v.SetModel(&mockModel{})
+		v.ViewSettingsChanged(config.ViewSetting{GroupBy: "C", GroupsCollapsed: collapsed})
+		data := makeTableData()
+		v.UpdateUI(v.Update(data, false), data)
+		assert.Contains(t, v.GetTitle(), "b]2[")
+	}
+}
+
+func TestTableGroupState(t *testing.T) {
+	uu := map[string]struct {
+		collapsed, remember bool
+		initial, e          string
+	}{
+		"expanded": {
+			initial: "▾ fred (1)",
+			e:       "▾ fred (1)",
+		},
+		"collapsed": {
+			collapsed: true,
+			initial:   "▸ fred (1)",
+			e:         "▸ fred (1)",
+		},
+		"remember": {
+			remember: true,
+			initial:  "▾ fred (1)",
+			e:        "▸ fred (1)",
+		},
+		"remember-collapsed": {
+			collapsed: true,
+			remember:  true,
+			initial:   "▸ fred (1)",
+			e:         "▾ fred (1)",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			v := ui.NewTable(client.NewGVR("fred"))
+			v.Init(makeContext())
+			v.SetModel(&mockModel{})
+			v.ViewSettingsChanged(config.ViewSetting{
+				GroupBy:            "C",
+				GroupsCollapsed:    u.collapsed,
+				RememberGroupState: u.remember,
+			})
+			data := makeTableData()
+			v.UpdateUI(v.Update(data, false), data)
+			assert.Equal(t, u.initial, v.GetCell(1, 0).Text)
+
+			v.Select(1, 0)
+			assert.Nil(t, v.ToggleGroupCmd(nil))
+			assert.NotEqual(t, u.initial, v.GetCell(1, 0).Text)
+
+			// Refreshes without the toggled group then with it back.
+			data = makeTableData()
+			data.Delete(map[string]struct{}{"r2": {}})
+			v.UpdateUI(v.Update(data, false), data)
+			assert.Contains(t, v.GetCell(1, 0).Text, "zorg (1)")
+			data = makeTableData()
+			v.UpdateUI(v.Update(data, false), data)
+			assert.Equal(t, u.e, v.GetCell(1, 0).Text)
+		})
+	}
+}
+
+func TestTableBreakpoints(t *testing.T) {
+	v := ui.NewTable(client.NewGVR("fred"))
+	v.Init(makeContext())
+	v.SetModel(&mockModel{})
+	var queued []func()
+	v.SetQueueFn(func(f func()) { queued = append(queued, f) })
+	flush := func() {
+		for _, f := range queued {
+			f()
+		}
+		queued = nil
+	}
+	v.ViewSettingsChanged(config.ViewSetting{
+		Columns: []string{"A", "B", "C"},
+		Breakpoints: map[string]config.Breakpoint{
+			"<60": {Columns: []string{"C", "A"}},
+		},
+	})
+	data := makeTableData()
+	v.UpdateUI(v.Update(data, false), data)
+	assert.Equal(t, []string{"A", "B", "C"}, v.EffectiveViewSetting().Columns)
+
+	s := tcell.NewSimulationScreen("")
+	assert.NoError(t, s.Init())
+	v.SetRect(0, 0, 50, 10)
+	v.Draw(s)
+	assert.Equal(t, []string{"A", "B", "C"}, v.EffectiveViewSetting().Columns)
+	flush()
+	assert.Equal(t, []string{"C", "A"}, v.EffectiveViewSetting().Columns)
+
+	v.SetRect(0, 0, 120, 10)
+	v.Draw(s)
+	flush()
+	assert.Equal(t, []string{"A", "B", "C"}, v.EffectiveViewSetting().Columns)
+}
+
+func TestTableKubectlOrder(t *testing.T) {
+	v := ui.NewTable(client.NewGVR("v1/zorgs"))
+	v.Init(makeContext())
+	v.SetModel(&mockModel{})
+	queued := make(chan func(), 1)
+	v.SetQueueFn(func(f func()) { queued <- f })
+	var calls int
+	v.SetKubectlColsFn(func(client.GVR) ([]string, error) {
+		calls++
+		return []string{"NAME", "AGE"}, nil
+	})
+
+	v.ViewSettingsChanged(config.ViewSetting{KubectlOrder: true, Columns: []string{"A"}})
+	assert.Empty(t, v.EffectiveViewSetting().KubectlColumns)
+	(<-queued)()
+	assert.Equal(t, []string{"NAME", "AGE"}, v.EffectiveViewSetting().KubectlColumns)
+
+	v2 := ui.NewTable(client.NewGVR("v1/zorgs"))
+	v2.Init(makeContext())
+	v2.SetModel(&mockModel{})
+	v2.SetQueueFn(func(f func()) { queued <- f })
+	v2.ViewSettingsChanged(config.ViewSetting{KubectlOrder: true, Columns: []string{"A"}})
+	assert.Equal(t, []string{"NAME", "AGE"}, v2.EffectiveViewSetting().KubectlColumns)
+	assert.Equal(t, 1, calls)
+}
+
+func TestTableSelectedLink(t *testing.T) {
+	uu := map[string]struct {
+		links []string
+		mask  []string
+		next  bool
+		e     string
+		ok    bool
+	}{
+		"none": {},
+		"first": {
+			links: []string{"B", "C"},
+			e:     "duh",
+			ok:    true,
+		},
+		"next": {
+			links: []string{"B", "C"},
+			next:  true,
+			e:     "fred",
+			ok:    true,
+		},
+		"fallback": {
+			links: []string{"X", "C"},
+			e:     "fred",
+			ok:    true,
+		},
+		"masked": {
+			links: []string{"B"},
+			mask:  []string{"B"},
+			e:     "duh",
+			ok:    true,
+		},
+		"unknown": {
+			links: []string{"X"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			v := ui.NewTable(client.NewGVR("fred"))
+			v.Init(makeContext())
+			m := &mockModel{}
+			v.SetModel(m)
+			v.ViewSettingsChanged(config.ViewSetting{Links: u.links, Mask: u.mask})
+			data := m.Peek()
+			cdata := v.Update(data, false)
+			v.UpdateUI(cdata, data)
+			v.SelectRow(1, 0, true)
+			if u.next {
+				v.NextLinkCol()
+			}
+
+			l, ok := v.SelectedLink()
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.e, l)
+		})
+	}
+}
+
+func TestTableHighlightChanges(t *testing.T) {
+	uu := map[string]struct {
+		vs      config.ViewSetting
+		flashed bool
+	}{
+		"off": {
+			vs: config.ViewSetting{Columns: []string{"A"}},
+		},
+		"on": {
+			vs:      config.ViewSetting{Columns: []string{"A"}, HighlightChanges: true, HighlightDuration: "1h"},
+			flashed: true,
+		},
+		"decayed": {
+			vs: config.ViewSetting{Columns: []string{"A"}, HighlightChanges: true, HighlightDuration: "1ns"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			v := ui.NewTable(client.NewGVR("fred"))
+			v.Init(makeContext())
+			v.ViewSettingsChanged(u.vs)
+
+			render := func(a1, a2 string) {
+				data := model1.NewTableDataWithRows(
+					client.NewGVR("test"),
+					model1.Header{model1.HeaderColumn{Name: "A"}},
+					model1.NewRowEventsWithEvts(
+						model1.RowEvent{Row: model1.Row{ID: "r1", Fields: model1.Fields{a1}}},
+						model1.RowEvent{Row: model1.Row{ID: "r2", Fields: model1.Fields{a2}}},
+					),
+				)
+				cdata := v.Update(data, false)
+				v.UpdateUI(cdata, data)
+			}
+			hl := config.NewStyles().Frame().Status.HighlightColor.Color()
+
+			render("a", "b")
+			assert.NotEqual(t, hl, v.GetCell(1, 0).BackgroundColor)
+			render("c", "b")
+			assert.Equal(t, u.flashed, v.GetCell(1, 0).BackgroundColor == hl)
+			assert.NotEqual(t, hl, v.GetCell(2, 0).BackgroundColor)
+			render("c", "b")
+			assert.Equal(t, u.flashed, v.GetCell(1, 0).BackgroundColor == hl)
+		})
+	}
+}
+
+func TestTableZebraDensity(t *testing.T) {
+	uu := map[string]struct {
+		vs      config.ViewSetting
+		striped bool
+		exp     int
+		a       string
+	}{
+		"default": {
+			vs:  config.ViewSetting{Columns: []string{"A"}},
+			exp: 1,
+			a:   "blee ",
+		},
+		"zebra": {
+			vs:      config.ViewSetting{Columns: []string{"A"}, ZebraStripes: true},
+			striped: true,
+			exp:     1,
+			a:       "blee ",
+		},
+		"compact": {
+			vs: config.ViewSetting{Columns: []string{"A"}, Density: config.CompactDensity},
+			a:  "blee ",
+		},
+		"comfortable": {
+			vs:  config.ViewSetting{Columns: []string{"A"}, Density: config.ComfortableDensity},
+			exp: 1,
+			a:   "blee   ",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			v := ui.NewTable(client.NewGVR("fred"))
+			v.Init(makeContext())
+			v.SetModel(&mockModel{})
+			v.ViewSettingsChanged(u.vs)
+			data := model1.NewTableDataWithRows(
+				client.NewGVR("test"),
+				model1.Header{model1.HeaderColumn{Name: "A"}},
+				model1.NewRowEventsWithEvts(
+					model1.RowEvent{Row: model1.Row{ID: "r1", Fields: model1.Fields{"blee"}}},
+					model1.RowEvent{Row: model1.Row{ID: "r2", Fields: model1.Fields{"duh"}}},
+				),
+			)
+			cdata := v.Update(data, false)
+			v.UpdateUI(cdata, data)
+
+			assert.Equal(t, u.a, v.GetCell(1, 0).Text)
+			assert.Equal(t, u.exp, v.GetCell(0, 0).Expansion)
+			assert.Equal(t, u.exp, v.GetCell(1, 0).Expansion)
+			bg1 := v.GetCell(1, 0).BackgroundColor
+			bg2 := v.GetCell(2, 0).BackgroundColor
+			assert.Equal(t, u.striped, bg1 != bg2)
+		})
+	}
+}
+
+func TestTableActiveFilter(t *testing.T) {
+	v := ui.NewTable(client.NewGVR("fred"))
+	v.Init(makeContext())
+	v.SetModel(&mockModel{})
+	vs := config.ViewSetting{SavedFilters: map[string]string{"problems": "!Running"}}
+	v.ViewSettingsChanged(vs)
+	assert.Empty(t, v.CmdBuff().GetText())
+
+	vs.ActiveFilter = "problems"
+	v.ViewSettingsChanged(vs)
+	assert.Equal(t, "!Running", v.CmdBuff().GetText())
+
+	v.CmdBuff().ClearText(false)
+	vs.Theme = "blee"
+	v.ViewSettingsChanged(vs)
+	assert.Empty(t, v.CmdBuff().GetText())
+
+	v.ApplySavedFilter("problems")
+	assert.Equal(t, "!Running", v.CmdBuff().GetText())
+	v.ApplySavedFilter("bozo")
+	assert.Equal(t, "!Running", v.CmdBuff().GetText())
+}
+
+func TestTableKeepScroll(t *testing.T) {
+	cfg := config.NewCustomView()
+	cfg.Views = map[string]config.ViewSetting{
+		"fred": {Columns: []string{"A"}, KeepScroll: true},
+	}
+	var saved int
+	cfg.ScrollSaveFn = func(_, _ string, offset int) { saved = offset }
+	ctx := context.WithValue(makeContext(), internal.KeyViewConfig, cfg)
+
+	v := ui.NewTable(client.NewGVR("fred"))
+	v.Init(ctx)
+	_, col := v.GetOffset()
+	assert.Equal(t, 0, col)
+	v.SetOffset(0, 3)
+	v.SaveScrollOffset()
+	assert.Equal(t, 3, saved)
+
+	v = ui.NewTable(client.NewGVR("fred"))
+	v.Init(ctx)
+	_, col = v.GetOffset()
+	assert.Equal(t, 3, col)
+
+	v.ViewSettingsChanged(config.ViewSetting{Columns: []string{"A", "B"}, KeepScroll: true, ScrollOffset: 5})
+	_, col = v.GetOffset()
+	assert.Equal(t, 3, col)
+}
+
+func TestTablePauseOnSelect(t *testing.T) {
+	v := ui.NewTable(client.NewGVR("fred"))
+	v.Init(makeContext())
+	v.SetModel(&mockModel{})
+	v.ViewSettingsChanged(config.ViewSetting{Columns: []string{"A"}, SortColumn: "A:asc", PauseOnSelect: true})
+
+	render := func(a1, a2 string) {
+		data := model1.NewTableDataWithRows(
+			client.NewGVR("test"),
+			model1.Header{model1.HeaderColumn{Name: "A"}},
+			model1.NewRowEventsWithEvts(
+				model1.RowEvent{Row: model1.Row{ID: "r1", Fields: model1.Fields{a1}}},
+				model1.RowEvent{Row: model1.Row{ID: "r2", Fields: model1.Fields{a2}}},
+			),
+		)
+		cdata := v.Update(data, false)
+		v.UpdateUI(cdata, data)
+	}
+
+	render("a", "b")
+	assert.Equal(t, "a", strings.TrimSpace(v.GetCell(1, 0).Text))
+	v.SelectRow(1, 0, true)
+
+	render("c", "b")
+	assert.Equal(t, "c", strings.TrimSpace(v.GetCell(1, 0).Text))
+	assert.Equal(t, "b", strings.TrimSpace(v.GetCell(2, 0).Text))
+	assert.Equal(t, "r1", v.GetSelectedItem())
+
+	v.SelectRow(2, 0, true)
+	render("c", "b")
+	assert.Equal(t, "b", strings.TrimSpace(v.GetCell(1, 0).Text))
+	assert.Equal(t, "c", strings.TrimSpace(v.GetCell(2, 0).Text))
+	assert.Equal(t, "r2", v.GetSelectedItem())
+
+	render("a", "b")
+	assert.Equal(t, "b", strings.TrimSpace(v.GetCell(1, 0).Text))
+	assert.Equal(t, "a", strings.TrimSpace(v.GetCell(2, 0).Text))
+
+	v.ClearSelection()
+	render("a", "b")
+	assert.Equal(t, "a", strings.TrimSpace(v.GetCell(1, 0).Text))
+	assert.Equal(t, "b", strings.TrimSpace(v.GetCell(2, 0).Text))
+}
+
 // ----------------------------------------------------------------------------
 // Helpers...
 
@@ -7,6 +7,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/model"
 	"github.com/derailed/k9s/internal/model1"
@@ -35,6 +36,39 @@ type Lister interface {
 	Get(ctx context.Context, path string) (runtime.Object, error)
 }
 
+// Pager represents a model supporting paginated listing.
+type Pager interface {
+	// SetPageSize sets the listing page size.
+	SetPageSize(int)
+
+	// NextPage requests an additional page.
+	NextPage() bool
+}
+
+// JSONPathColumner represents a model supporting jsonpath columns.
+type JSONPathColumner interface {
+	// SetJSONPathCols sets columns computed from the raw resources.
+	SetJSONPathCols([]config.JSONPathCol)
+}
+
+// ConditionColumner represents a model supporting status conditions columns.
+type ConditionColumner interface {
+	// SetConditionCols toggles columns computed from the resources status conditions.
+	SetConditionCols(bool)
+}
+
+// StatusFromer represents a model supporting derived status columns.
+type StatusFromer interface {
+	// SetStatusFrom sets a status column derived from the resources status conditions.
+	SetStatusFrom(*config.StatusFrom)
+}
+
+// TimeStamper represents a model supporting time columns rendered as timestamps.
+type TimeStamper interface {
+	// SetTimeStamps toggles recording the resources raw timestamps.
+	SetTimeStamps(bool)
+}
+
 // Tabular represents a tabular model.
 type Tabular interface {
 	Namespaceable
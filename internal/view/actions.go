@@ -6,6 +6,7 @@ package view
 import (
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/derailed/k9s/internal/config"
@@ -102,6 +103,103 @@ func hotKeyActions(r Runner, aa *ui.KeyActions) error {
 	return errs
 }
 
+// reservedKeys tracks keys view settings can not rebind.
+var reservedKeys = []tcell.Key{
+	ui.KeyColon,
+	ui.KeySlash,
+	ui.KeyHelp,
+	tcell.KeyEscape,
+	tcell.KeyCtrlC,
+}
+
+// clearViewKeys removes actions bound by view settings keys.
+func clearViewKeys(aa *ui.KeyActions) {
+	aa.Range(func(k tcell.Key, a ui.KeyAction) {
+		if a.Opts.ViewKey {
+			aa.Delete(k)
+		}
+	})
+}
+
+// viewKeyActions binds view settings keys to named plugins or actions.
+func viewKeyActions(r Runner, aa *ui.KeyActions, keys map[string]string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	pp := config.NewPlugins()
+	if path, err := r.App().Config.ContextPluginsPath(); err == nil {
+		if err := pp.Load(path); err != nil {
+			log.Warn().Err(err).Msg("Plugins load failed")
+		}
+	}
+	aliases, ro := r.Aliases(), r.App().Config.K9s.IsReadOnly()
+
+	return bindViewKeys(aa, keys, func(name string) (ui.KeyAction, bool) {
+		p, ok := pp.Plugins[name]
+		if !ok || !inScope(p.Scopes, aliases) || (p.Dangerous && ro) {
+			return ui.KeyAction{}, false
+		}
+		return ui.NewKeyActionWithOpts(p.Description, pluginAction(r, p), ui.ActionOpts{
+			Visible:   true,
+			Dangerous: p.Dangerous,
+		}), true
+	})
+}
+
+// bindViewKeys binds keys to plugins resolved by name or to existing actions
+// matching their description. Reserved keys can not be rebound.
+func bindViewKeys(aa *ui.KeyActions, keys map[string]string, plugin func(string) (ui.KeyAction, bool)) error {
+	kk := make([]string, 0, len(keys))
+	for k := range keys {
+		kk = append(kk, k)
+	}
+	slices.Sort(kk)
+
+	var errs error
+	for _, k := range kk {
+		key, err := asKey(k)
+		if err != nil {
+			errs = errors.Join(errs, err)
+			continue
+		}
+		if slices.Contains(reservedKeys, key) {
+			errs = errors.Join(errs, fmt.Errorf("view key %q is reserved", k))
+			continue
+		}
+		a, ok := plugin(keys[k])
+		if !ok {
+			a, ok = namedAction(aa, keys[k])
+		}
+		if !ok {
+			errs = errors.Join(errs, fmt.Errorf("no action or plugin named %q for view key %q", keys[k], k))
+			continue
+		}
+		if _, ok := aa.Get(key); ok {
+			log.Debug().Msgf("Action %q has been overridden by view key to %q", k, keys[k])
+		}
+		a.Opts.ViewKey, a.Opts.Plugin, a.Opts.HotKey = true, false, false
+		aa.Add(key, a)
+	}
+
+	return errs
+}
+
+// namedAction returns the action matching a given description.
+func namedAction(aa *ui.KeyActions, name string) (ui.KeyAction, bool) {
+	var (
+		found ui.KeyAction
+		fkey  tcell.Key
+		ok    bool
+	)
+	aa.Range(func(k tcell.Key, a ui.KeyAction) {
+		if strings.EqualFold(a.Description, name) && (!ok || k < fkey) {
+			found, fkey, ok = a, k, true
+		}
+	})
+
+	return found, ok
+}
+
 func gotoCmd(r Runner, cmd, path string, clearStack bool) ui.ActionHandler {
 	return func(evt *tcell.EventKey) *tcell.EventKey {
 		r.App().gotoResource(cmd, path, clearStack)
@@ -174,9 +272,10 @@ func pluginAction(r Runner, p config.Plugin) ui.ActionHandler {
 			return nil
 		}
 
+		env := r.EnvFn()()
 		args := make([]string, len(p.Args))
 		for i, a := range p.Args {
-			arg, err := r.EnvFn()().Substitute(a)
+			arg, err := env.Substitute(a)
 			if err != nil {
 				log.Error().Err(err).Msg("Plugin Args match failed")
 				return nil
@@ -190,6 +289,7 @@ func pluginAction(r Runner, p config.Plugin) ui.ActionHandler {
 				background: p.Background,
 				pipes:      p.Pipes,
 				args:       args,
+				env:        env.Vars("K9S_"),
 			}
 			suspend, errChan, statusChan := run(r.App(), opts)
 			if !suspend {
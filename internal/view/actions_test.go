@@ -6,6 +6,8 @@ package view
 import (
 	"testing"
 
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
 	"github.com/rs/zerolog"
 	"github.com/stretchr/testify/assert"
 )
@@ -72,3 +74,45 @@ func TestInScope(t *testing.T) {
 		})
 	}
 }
+
+func TestBindViewKeys(t *testing.T) {
+	noop := func(*tcell.EventKey) *tcell.EventKey { return nil }
+	plugin := func(n string) (ui.KeyAction, bool) {
+		if n != "blee" {
+			return ui.KeyAction{}, false
+		}
+		return ui.NewKeyActionWithOpts("Blee", noop, ui.ActionOpts{Visible: true}), true
+	}
+	aa := ui.NewKeyActionsFromMap(ui.KeyMap{
+		ui.KeyD:      ui.NewKeyAction("Describe", noop, true),
+		ui.KeyY:      ui.NewKeyAction("YAML", noop, true),
+		ui.KeyHelp:   ui.NewKeyAction("Help", noop, true),
+		ui.KeyShiftL: ui.NewKeyActionWithOpts("Logs", noop, ui.ActionOpts{Plugin: true}),
+	})
+
+	err := bindViewKeys(aa, map[string]string{
+		"d":       "blee",
+		"Shift-D": "describe",
+		"?":       "blee",
+		"x":       "bozo",
+		"Bozo":    "blee",
+	}, plugin)
+	assert.EqualError(t, err, "view key \"?\" is reserved\ninvalid key specified: \"Bozo\"\nno action or plugin named \"bozo\" for view key \"x\"")
+
+	a, ok := aa.Get(ui.KeyD)
+	assert.True(t, ok)
+	assert.Equal(t, "Blee", a.Description)
+	assert.True(t, a.Opts.ViewKey)
+	a, ok = aa.Get(ui.KeyShiftD)
+	assert.True(t, ok)
+	assert.Equal(t, "Describe", a.Description)
+	a, _ = aa.Get(ui.KeyHelp)
+	assert.Equal(t, "Help", a.Description)
+	_, ok = aa.Get(ui.KeyX)
+	assert.False(t, ok)
+
+	clearViewKeys(aa)
+	_, ok = aa.Get(ui.KeyD)
+	assert.False(t, ok)
+	assert.Equal(t, 3, aa.Len())
+}
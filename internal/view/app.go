@@ -171,6 +171,9 @@ func (a *App) layout(ctx context.Context) {
 }
 
 func (a *App) initSignals() {
+	if a.Config.K9s.ReloadViewsOnSignal && config.ReloadSignalSupported() {
+		a.CustomViewsSignalReload(a)
+	}
 	sig := make(chan os.Signal, 1)
 	signal.Notify(sig, syscall.SIGHUP)
 
@@ -478,6 +481,9 @@ func (a *App) switchContext(ci *cmd.Interpreter, force bool) error {
 		if err := a.command.Reset(a.Config.ContextAliasesPath(), true); err != nil {
 			return err
 		}
+		if err := a.RefreshCustomViews(); err != nil {
+			log.Warn().Err(err).Msg("CustomViews load failed")
+		}
 
 		log.Debug().Msgf("--> Switching Context %q -- %q -- %q", name, ns, a.Config.ActiveView())
 		a.Flash().Infof("Switching context to %q::%q", name, ns)
@@ -14,6 +14,7 @@ import (
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/config/data"
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/model"
@@ -36,6 +37,7 @@ type Browser struct {
 	cancelFn   context.CancelFunc
 	mx         sync.RWMutex
 	updating   bool
+	enterCheck string
 }
 
 // NewBrowser returns a new browser.
@@ -291,9 +293,24 @@ func (b *Browser) TableDataChanged(data *model1.TableData) {
 		defer b.setUpdating(false)
 		b.refreshActions()
 		b.UpdateUI(cdata, data)
+		b.checkEnterAction(data.Header())
 	})
 }
 
+// checkEnterAction reports an invalid view enter action once the resource
+// header is known. Each enter action is only checked once.
+func (b *Browser) checkEnterAction(h model1.Header) {
+	vs := b.ViewSetting()
+	if vs == nil || vs.EnterAction == "" || vs.EnterAction == b.enterCheck || len(h) == 0 {
+		return
+	}
+	b.enterCheck = vs.EnterAction
+	if err := checkEnterAction(vs, h); err != nil {
+		log.Warn().Err(err).Msgf("Invalid enter action for %q", b.GVR())
+		b.app.Flash().Err(err)
+	}
+}
+
 // TableLoadFailed notifies view something went south.
 func (b *Browser) TableLoadFailed(err error) {
 	b.app.QueueUpdateDraw(func() {
@@ -310,12 +327,19 @@ func (b *Browser) viewCmd(evt *tcell.EventKey) *tcell.EventKey {
 	if path == "" {
 		return evt
 	}
+	b.showYAML(path)
 
+	return nil
+}
+
+func (b *Browser) showYAML(path string) {
 	v := NewLiveView(b.app, yamlAction, model.NewYAML(b.GVR(), path))
+	if vs := b.ViewSetting(); vs != nil {
+		v.SetYAMLOptions(vs.YAMLOptions)
+	}
 	if err := v.app.inject(v, false); err != nil {
 		v.app.Flash().Err(err)
 	}
-	return nil
 }
 
 func (b *Browser) helpCmd(evt *tcell.EventKey) *tcell.EventKey {
@@ -367,10 +391,31 @@ func (b *Browser) enterCmd(evt *tcell.EventKey) *tcell.EventKey {
 		return nil
 	}
 
+	if vs := b.ViewSetting(); vs != nil && vs.EnterAction != "" {
+		c, err := enterActionCmd(vs, b.GetModel().Peek().Header(), b.GetSelectedRow(path), path)
+		if err != nil {
+			b.app.Flash().Err(err)
+			return nil
+		}
+		b.app.gotoResource(c, "", false)
+		return nil
+	}
+
 	f := describeResource
 	if b.enterFn != nil {
 		f = b.enterFn
 	}
+	if vs := b.ViewSetting(); vs != nil {
+		switch vs.EnterView {
+		case config.DescribeEnterView:
+			f = describeResource
+		case config.YAMLEnterView:
+			b.showYAML(path)
+			return nil
+		case config.XrayEnterView:
+			f = xrayResource
+		}
+	}
 	f(b.app, b.GetModel(), b.GVR(), path)
 
 	return nil
@@ -509,6 +554,7 @@ func (b *Browser) setNamespace(ns string) {
 		ns = client.ClusterScope
 	}
 	b.GetModel().SetNamespace(ns)
+	b.ViewNamespaceChanged()
 }
 
 func (b *Browser) defaultContext() context.Context {
@@ -563,6 +609,7 @@ func (b *Browser) refreshActions() {
 		f(aa)
 	}
 	b.Actions().Merge(aa)
+	clearViewKeys(b.Actions())
 
 	if err := pluginActions(b, b.Actions()); err != nil {
 		log.Warn().Msgf("Plugins load failed: %s", err)
@@ -572,6 +619,12 @@ func (b *Browser) refreshActions() {
 		log.Warn().Msgf("Hotkeys load failed: %s", err)
 		b.app.Logo().Warn("HotKeys load failed!")
 	}
+	if vs := b.ViewSetting(); vs != nil {
+		if err := viewKeyActions(b, b.Actions(), vs.Keys); err != nil {
+			log.Warn().Msgf("View keys load failed: %s", err)
+			b.app.Logo().Warn("View keys load failed!")
+		}
+	}
 	b.app.Menu().HydrateMenu(b.Hints())
 }
 
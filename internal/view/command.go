@@ -15,6 +15,7 @@ import (
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/model"
 	"github.com/derailed/k9s/internal/view/cmd"
+	"github.com/derailed/k9s/internal/xray"
 	"github.com/rs/zerolog/log"
 )
 
@@ -71,12 +72,17 @@ func (c *Command) Reset(path string, clear bool) error {
 
 func allowedXRay(gvr client.GVR) bool {
 	gg := map[string]struct{}{
-		"v1/pods":              {},
-		"v1/services":          {},
-		"apps/v1/deployments":  {},
-		"apps/v1/daemonsets":   {},
-		"apps/v1/statefulsets": {},
-		"apps/v1/replicasets":  {},
+		"v1/pods":                   {},
+		"v1/nodes":                  {},
+		"v1/services":               {},
+		"v1/persistentvolumeclaims": {},
+		"apps/v1/deployments":       {},
+		"apps/v1/daemonsets":        {},
+		"apps/v1/statefulsets":      {},
+		"apps/v1/replicasets":       {},
+		xray.JobGVR:                 {},
+		xray.GatewayGVR:             {},
+		xray.HTTPRouteGVR:           {},
 	}
 	_, ok := gg[gvr.String()]
 
@@ -99,6 +99,7 @@ func (c *Container) k9sEnv() Env {
 	row := c.GetTable().GetSelectedRow(path)
 	env := defaultEnv(c.App().Conn().Config(), path, c.GetTable().GetModel().Peek().Header(), row)
 	env["NAMESPACE"], env["POD"] = client.Namespaced(c.GetTable().Path)
+	viewSettingEnv(env, c.GetTable().EffectiveViewSetting())
 
 	return env
 }
@@ -64,3 +64,16 @@ func (e Env) Substitute(arg string) (string, error) {
 
 	return arg, nil
 }
+
+// Vars returns the environment variables matching a given prefix as sorted key=value pairs.
+func (e Env) Vars(prefix string) []string {
+	vv := make([]string, 0, len(e))
+	for k, v := range e {
+		if strings.HasPrefix(k, prefix) {
+			vv = append(vv, k+"="+v)
+		}
+	}
+	sort.Strings(vv)
+
+	return vv
+}
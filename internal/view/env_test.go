@@ -69,3 +69,14 @@ func TestEnvReplace(t *testing.T) {
 		})
 	}
 }
+
+func TestEnvVars(t *testing.T) {
+	e := Env{
+		"NAME":        "fred",
+		"K9S_SORT":    "NAME:asc",
+		"K9S_COLUMNS": "NAME,AGE",
+	}
+
+	assert.Equal(t, []string{"K9S_COLUMNS=NAME,AGE", "K9S_SORT=NAME:asc"}, e.Vars("K9S_"))
+	assert.Empty(t, e.Vars("BLEE_"))
+}
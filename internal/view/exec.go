@@ -47,6 +47,7 @@ type shellOpts struct {
 	binary            string
 	banner            string
 	args              []string
+	env               []string
 }
 
 func (s shellOpts) String() string {
@@ -180,6 +181,9 @@ func execute(opts shellOpts, statusChan chan<- string) error {
 
 	cmds := make([]*exec.Cmd, 0, 1)
 	cmd := exec.CommandContext(ctx, opts.binary, opts.args...)
+	if len(opts.env) > 0 {
+		cmd.Env = append(os.Environ(), opts.env...)
+	}
 	log.Debug().Msgf("RUNNING> %s", opts)
 	cmds = append(cmds, cmd)
 
@@ -189,6 +193,9 @@ func execute(opts shellOpts, statusChan chan<- string) error {
 			continue
 		}
 		cmd := exec.CommandContext(ctx, tokens[0], tokens[1:]...)
+		if len(opts.env) > 0 {
+			cmd.Env = append(os.Environ(), opts.env...)
+		}
 		log.Debug().Msgf("\t| %s", cmd)
 		cmds = append(cmds, cmd)
 	}
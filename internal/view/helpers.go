@@ -7,6 +7,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/url"
+	"regexp"
+	"runtime"
 	"strconv"
 	"strings"
 
@@ -123,6 +126,63 @@ func defaultEnv(c *client.Config, path string, header model1.Header, row *model1
 	return env
 }
 
+// viewSettingEnv exposes the displayed view setting to plugins.
+func viewSettingEnv(env Env, vs config.ViewSetting) {
+	env["K9S_COLUMNS"] = strings.Join(vs.Columns, ",")
+	env["K9S_SORT"] = vs.SortColumn
+}
+
+// enterActionCmd returns the command navigating to the resource referenced
+// by the selected row as specified by a view enter action.
+func enterActionCmd(vs *config.ViewSetting, h model1.Header, row *model1.Row, path string) (string, error) {
+	gvr, col, err := vs.EnterTarget()
+	if err != nil {
+		return "", err
+	}
+	meta, err := dao.MetaAccess.MetaFor(client.NewGVR(gvr))
+	if err != nil {
+		return "", fmt.Errorf("unknown enter action resource %q", gvr)
+	}
+	idx, ok := h.IndexOf(col, true)
+	if !ok || row == nil || idx >= len(row.Fields) {
+		return "", fmt.Errorf("enter action column %q not found", col)
+	}
+	name := row.Fields[idx]
+	if name == "" {
+		return "", fmt.Errorf("no %s referenced in column %q", meta.SingularName, col)
+	}
+	if ns, _ := client.Namespaced(path); meta.Namespaced && ns != "" {
+		return fmt.Sprintf("%s %s /%s", gvr, ns, fieldRx(name)), nil
+	}
+
+	return fmt.Sprintf("%s /%s", gvr, fieldRx(name)), nil
+}
+
+// checkEnterAction checks a view enter action references a known resource
+// and a column of the given header. Blank headers skip the column check.
+func checkEnterAction(vs *config.ViewSetting, h model1.Header) error {
+	gvr, col, err := vs.EnterTarget()
+	if err != nil {
+		return err
+	}
+	if _, err := dao.MetaAccess.MetaFor(client.NewGVR(gvr)); err != nil {
+		return fmt.Errorf("unknown enter action resource %q", gvr)
+	}
+	if len(h) == 0 {
+		return nil
+	}
+	if _, ok := h.IndexOf(col, true); !ok {
+		return fmt.Errorf("enter action column %q not found", col)
+	}
+
+	return nil
+}
+
+// fieldRx returns a filter matching rows with a cell equal to the given value.
+func fieldRx(s string) string {
+	return `(^|\s)` + regexp.QuoteMeta(s) + `(\s|$)`
+}
+
 func describeResource(app *App, m ui.Tabular, gvr client.GVR, path string) {
 	v := NewLiveView(app, "Describe", model.NewDescribe(gvr, path))
 	if err := app.inject(v, false); err != nil {
@@ -130,6 +190,18 @@ func describeResource(app *App, m ui.Tabular, gvr client.GVR, path string) {
 	}
 }
 
+func xrayResource(app *App, _ ui.Tabular, gvr client.GVR, path string) {
+	if !allowedXRay(gvr) {
+		app.Flash().Warnf("Xray is not supported for %s", gvr)
+		return
+	}
+	ns, n := client.Namespaced(path)
+	app.gotoResource(strings.TrimSpace("xray "+gvr.String()+" "+ns), "", false)
+	if x, ok := app.Content.Top().(*Xray); ok {
+		x.CmdBuff().SetText("^"+regexp.QuoteMeta(client.FQN(ns, n))+"$", "")
+	}
+}
+
 func toLabelsStr(labels map[string]string) string {
 	ll := make([]string, 0, len(labels))
 	for k, v := range labels {
@@ -278,3 +350,34 @@ func linesWithRegions(lines []string, matches fuzzy.Matches) []string {
 	}
 	return ll
 }
+
+const (
+	browseOSX   = "open"
+	browseLinux = "sensible-browser"
+)
+
+// openURL opens a web link in the OS browser.
+func openURL(app *App, link string) error {
+	u, err := url.Parse(link)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("invalid link %q. must be an http(s) url", link)
+	}
+	bin := browseLinux
+	if runtime.GOOS == "darwin" {
+		bin = browseOSX
+	}
+	ok, errChan, _ := run(app, shellOpts{
+		background: true,
+		binary:     bin,
+		args:       []string{u.String()},
+	})
+	if !ok {
+		return errors.New("unable to run browser command")
+	}
+	var errs error
+	for e := range errChan {
+		errs = errors.Join(errs, e)
+	}
+
+	return errs
+}
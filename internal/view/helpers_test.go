@@ -6,6 +6,7 @@ package view
 import (
 	"context"
 	"errors"
+	"regexp"
 	"testing"
 
 	"github.com/derailed/k9s/internal"
@@ -173,6 +174,113 @@ func TestK9sEnv(t *testing.T) {
 	assert.Equal(t, "c1", env["COL-C"])
 }
 
+func TestEnterActionCmd(t *testing.T) {
+	h := model1.Header{{Name: "NAMESPACE"}, {Name: "NAME"}, {Name: "POD"}, {Name: "PRIORITY"}}
+	row := model1.Row{ID: "ns1/fred", Fields: model1.Fields{"ns1", "fred", "p1", "high"}}
+
+	uu := map[string]struct {
+		action, path, cmd string
+		err               bool
+	}{
+		"namespaced": {
+			action: "v1/pods:POD",
+			path:   "ns1/fred",
+			cmd:    `v1/pods ns1 /(^|\s)p1(\s|$)`,
+		},
+		"cluster": {
+			action: "scheduling.k8s.io/v1/priorityclasses:PRIORITY",
+			path:   "ns1/fred",
+			cmd:    `scheduling.k8s.io/v1/priorityclasses /(^|\s)high(\s|$)`,
+		},
+		"no-ns": {
+			action: "v1/pods:POD",
+			path:   "fred",
+			cmd:    `v1/pods /(^|\s)p1(\s|$)`,
+		},
+		"unknown-gvr": {
+			action: "fred/v1/blees:POD",
+			path:   "ns1/fred",
+			err:    true,
+		},
+		"unknown-col": {
+			action: "v1/pods:BLEE",
+			path:   "ns1/fred",
+			err:    true,
+		},
+		"invalid": {
+			action: "v1/pods",
+			path:   "ns1/fred",
+			err:    true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			c, err := enterActionCmd(&config.ViewSetting{EnterAction: u.action}, h, &row, u.path)
+			if u.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, u.cmd, c)
+		})
+	}
+}
+
+func TestCheckEnterAction(t *testing.T) {
+	h := model1.Header{{Name: "NAMESPACE"}, {Name: "NAME"}, {Name: "POD"}}
+
+	uu := map[string]struct {
+		action string
+		h      model1.Header
+		err    string
+	}{
+		"happy": {
+			action: "v1/pods:POD",
+			h:      h,
+		},
+		"no-header": {
+			action: "v1/pods:BLEE",
+		},
+		"unknown-gvr": {
+			action: "fred/v1/blees:POD",
+			h:      h,
+			err:    `unknown enter action resource "fred/v1/blees"`,
+		},
+		"unknown-col": {
+			action: "v1/pods:BLEE",
+			h:      h,
+			err:    `enter action column "BLEE" not found`,
+		},
+		"invalid": {
+			action: "v1/pods",
+			h:      h,
+			err:    `invalid enter action "v1/pods". must be gvr:col-name`,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			err := checkEnterAction(&config.ViewSetting{EnterAction: u.action}, u.h)
+			if u.err != "" {
+				assert.EqualError(t, err, u.err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestFieldRx(t *testing.T) {
+	rx := regexp.MustCompile(fieldRx("p1.x"))
+	assert.True(t, rx.MatchString("ns1 p1.x Running"))
+	assert.True(t, rx.MatchString("p1.x"))
+	assert.False(t, rx.MatchString("ns1 p1.x-2 Running"))
+	assert.False(t, rx.MatchString("ns1 p1-x Running"))
+}
+
 func TestIsTCPPort(t *testing.T) {
 	uu := map[string]struct {
 		p string
@@ -4,8 +4,6 @@
 package view
 
 import (
-	"errors"
-	"runtime"
 	"strings"
 
 	"github.com/derailed/k9s/internal/client"
@@ -16,8 +14,6 @@ import (
 
 const (
 	imgScanTitle = "Scans"
-	browseOSX    = "open"
-	browseLinux  = "sensible-browser"
 	cveGovURL    = "https://nvd.nist.gov/vuln/detail/"
 	ghsaURL      = "https://github.com/advisories/"
 )
@@ -53,11 +49,6 @@ func (c *ImageScan) bindKeys(aa *ui.KeyActions) {
 }
 
 func (s *ImageScan) viewCVE(app *App, _ ui.Tabular, _ client.GVR, path string) {
-	bin := browseLinux
-	if runtime.GOOS == "darwin" {
-		bin = browseOSX
-	}
-
 	tt := strings.Split(path, "|")
 	if len(tt) < 7 {
 		app.Flash().Errf("parse path failed: %s", path)
@@ -69,20 +60,7 @@ func (s *ImageScan) viewCVE(app *App, _ ui.Tabular, _ client.GVR, path string) {
 	}
 	site += cve
 
-	ok, errChan, _ := run(app, shellOpts{
-		background: true,
-		binary:     bin,
-		args:       []string{site},
-	})
-	if !ok {
-		app.Flash().Errf("unable to run browser command")
-		return
-	}
-	var errs error
-	for e := range errChan {
-		errs = errors.Join(e)
-	}
-	if errs != nil {
-		app.Flash().Err(errs)
+	if err := openURL(app, site); err != nil {
+		app.Flash().Err(err)
 	}
 }
@@ -39,6 +39,7 @@ type LiveView struct {
 	fullScreen                bool
 	managedField              bool
 	autoRefresh               bool
+	yamlOpts                  config.YAMLOptions
 }
 
 // NewLiveView returns a live viewer.
@@ -87,6 +88,9 @@ func (v *LiveView) Init(_ context.Context) error {
 	v.SetInputCapture(v.keyboard)
 	if v.model != nil {
 		v.model.AddListener(v)
+		if v.yamlOpts != (config.YAMLOptions{}) {
+			v.model.SetOptions(v.defaultCtx(), v.viewerOpts())
+		}
 	}
 
 	return nil
@@ -155,7 +159,7 @@ func (v *LiveView) bindKeys() {
 	if !v.app.Config.K9s.IsReadOnly() {
 		v.actions.Add(ui.KeyE, ui.NewKeyAction("Edit", v.editCmd, true))
 	}
-	if v.title == yamlAction {
+	if v.title == yamlAction && !v.yamlOpts.StripManagedFields {
 		v.actions.Add(ui.KeyM, ui.NewKeyAction("Toggle ManagedFields", v.toggleManagedCmd, true))
 	}
 	if v.model != nil && v.model.GVR().IsDecodable() {
@@ -271,11 +275,24 @@ func (v *LiveView) toggleManagedCmd(evt *tcell.EventKey) *tcell.EventKey {
 	}
 
 	v.managedField = !v.managedField
-	v.model.SetOptions(v.defaultCtx(), map[string]bool{model.ManagedFieldsOpts: v.managedField})
+	v.model.SetOptions(v.defaultCtx(), v.viewerOpts())
 
 	return nil
 }
 
+// SetYAMLOptions sets the resource YAML rendering options.
+func (v *LiveView) SetYAMLOptions(o config.YAMLOptions) {
+	v.yamlOpts = o
+}
+
+func (v *LiveView) viewerOpts() model.ViewerToggleOpts {
+	return model.ViewerToggleOpts{
+		model.ManagedFieldsOpts:      v.managedField,
+		model.StripManagedFieldsOpts: v.yamlOpts.StripManagedFields,
+		model.OmitStatusOpts:         v.yamlOpts.OmitStatus,
+	}
+}
+
 func (v *LiveView) toggleFullScreenCmd(evt *tcell.EventKey) *tcell.EventKey {
 	if v.app.InCmdMode() {
 		return evt
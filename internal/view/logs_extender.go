@@ -107,7 +107,9 @@ func podLogOptions(app *App, fqn string, prev bool, m metav1.ObjectMeta, spec v1
 			Previous:        prev,
 		}
 	)
-	if c, ok := dao.GetDefaultContainer(m, spec); ok {
+	if c, ok := viewDefaultContainer(app, m.Namespace, spec); ok {
+		opts.Container, opts.DefaultContainer = c, c
+	} else if c, ok := dao.GetDefaultContainer(m, spec); ok {
 		opts.Container, opts.DefaultContainer = c, c
 	} else if len(cc) == 1 {
 		opts.Container = cc[0]
@@ -368,6 +368,10 @@ func containerShellin(a *App, comp model.Component, path, co string) error {
 	if err != nil {
 		return err
 	}
+	if co, ok := viewDefaultContainer(a, pod.Namespace, pod.Spec); ok {
+		resumeShellIn(a, comp, path, co)
+		return nil
+	}
 	cc := fetchContainers(pod.ObjectMeta, pod.Spec, false)
 	if len(cc) == 1 {
 		resumeShellIn(a, comp, path, cc[0])
@@ -413,6 +417,10 @@ func containerAttachIn(a *App, comp model.Component, path, co string) error {
 	if err != nil {
 		return err
 	}
+	if co, ok := viewDefaultContainer(a, pod.Namespace, pod.Spec); ok {
+		resumeAttachIn(a, comp, path, co)
+		return nil
+	}
 	cc := fetchContainers(pod.ObjectMeta, pod.Spec, false)
 	if len(cc) == 1 {
 		resumeAttachIn(a, comp, path, cc[0])
@@ -471,6 +479,26 @@ func buildShellArgs(cmd, path, co string, kcfg *string) []string {
 	return args
 }
 
+// viewDefaultContainer returns the default container specified by the pods
+// view setting if any and present on the pod.
+func viewDefaultContainer(a *App, ns string, spec v1.PodSpec) (string, bool) {
+	if a.CustomView == nil {
+		return "", false
+	}
+	_, vs := a.CustomView.Explain("v1/pods", ns)
+	if vs == nil || vs.DefaultContainer == "" {
+		return "", false
+	}
+	for _, c := range spec.Containers {
+		if c.Name == vs.DefaultContainer {
+			return c.Name, true
+		}
+	}
+	log.Info().Msgf("View default container %q not found in pod spec. Ignoring", vs.DefaultContainer)
+
+	return "", false
+}
+
 func fetchContainers(meta metav1.ObjectMeta, spec v1.PodSpec, allContainers bool) []string {
 	nn := make([]string, 0, len(spec.Containers)+len(spec.InitContainers))
 
@@ -7,7 +7,10 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/config/mock"
 	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
 )
 
 func TestComputeShellArgs(t *testing.T) {
@@ -106,3 +109,45 @@ func TestComputeShellArgs(t *testing.T) {
 // 		})
 // 	}
 // }
+
+func TestViewDefaultContainer(t *testing.T) {
+	spec := v1.PodSpec{Containers: []v1.Container{{Name: "c1"}, {Name: "c2"}}}
+	uu := map[string]struct {
+		views map[string]config.ViewSetting
+		ns, e string
+		ok    bool
+	}{
+		"none": {},
+		"gvr": {
+			views: map[string]config.ViewSetting{"v1/pods": {DefaultContainer: "c2"}},
+			ns:    "fred",
+			e:     "c2",
+			ok:    true,
+		},
+		"ns": {
+			views: map[string]config.ViewSetting{
+				"v1/pods":      {DefaultContainer: "c1"},
+				"v1/pods@fred": {DefaultContainer: "c2"},
+			},
+			ns: "fred",
+			e:  "c2",
+			ok: true,
+		},
+		"missing": {
+			views: map[string]config.ViewSetting{"v1/pods": {DefaultContainer: "c3"}},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			app := NewApp(mock.NewMockConfig())
+			app.CustomView = config.NewCustomView()
+			app.CustomView.Views = u.views
+
+			co, ok := viewDefaultContainer(app, u.ns, spec)
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.e, co)
+		})
+	}
+}
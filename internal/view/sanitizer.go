@@ -270,7 +270,8 @@ func (s *Sanitizer) TreeLoadFailed(err error) {
 }
 
 func (s *Sanitizer) update(node *xray.TreeNode) {
-	root := makeTreeNode(node, s.ExpandNodes(), s.app.Config.K9s.UI.NoIcons, s.app.Styles)
+	gg := xrayGlyphs(s.app.Config.K9s)
+	root := makeTreeNode(node, s.ExpandNodes(), s.app.Config.K9s.UI.NoIcons, gg, s.app.Styles)
 	if node == nil {
 		s.app.QueueUpdateDraw(func() {
 			s.SetRoot(root)
@@ -279,7 +280,7 @@ func (s *Sanitizer) update(node *xray.TreeNode) {
 	}
 
 	for _, c := range node.Children {
-		s.hydrate(root, c)
+		s.hydrate(root, c, gg)
 	}
 	if s.GetSelectedItem() == "" {
 		s.SetSelectedItem(node.Spec().Path())
@@ -316,10 +317,10 @@ func (s *Sanitizer) TreeChanged(node *xray.TreeNode) {
 	s.UpdateTitle()
 }
 
-func (s *Sanitizer) hydrate(parent *tview.TreeNode, n *xray.TreeNode) {
-	node := makeTreeNode(n, s.ExpandNodes(), s.app.Config.K9s.UI.NoIcons, s.app.Styles)
+func (s *Sanitizer) hydrate(parent *tview.TreeNode, n *xray.TreeNode, gg xray.Glyphs) {
+	node := makeTreeNode(n, s.ExpandNodes(), s.app.Config.K9s.UI.NoIcons, gg, s.app.Styles)
 	for _, c := range n.Children {
-		s.hydrate(node, c)
+		s.hydrate(node, c, gg)
 	}
 	parent.AddChild(node)
 }
@@ -5,12 +5,15 @@ package view
 
 import (
 	"context"
+	"errors"
 	"path/filepath"
 	"strings"
 	"time"
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/model"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/ui"
@@ -26,6 +29,7 @@ type Table struct {
 	enterFn    EnterFunc
 	envFn      EnvFunc
 	bindKeysFn []BindKeysFunc
+	active     bool
 }
 
 // NewTable returns a new viewer.
@@ -50,11 +54,30 @@ func (t *Table) Init(ctx context.Context) (err error) {
 	if !t.app.Config.K9s.UI.Reactive {
 		if err := t.app.RefreshCustomViews(); err != nil {
 			log.Warn().Err(err).Msg("CustomViews load failed")
-			t.app.Logo().Warn("Views load failed!")
+			msg := "Views load failed!"
+			var le *config.LoadError
+			if errors.As(err, &le) {
+				msg = "Views " + le.Kind.String() + " failed!"
+			}
+			t.app.Logo().Warn(msg)
 		}
 	}
 
 	ctx = context.WithValue(ctx, internal.KeyViewConfig, t.app.CustomView)
+	t.SetViewSettingFn(t.viewSettingChanged)
+	t.SetQueueFn(t.app.QueueUpdateDraw)
+	t.SetPrinterColsFn(func(gvr client.GVR) ([]string, error) {
+		if t.app.factory == nil {
+			return nil, errors.New("no factory available")
+		}
+		return dao.PrinterColumns(t.app.factory, gvr)
+	})
+	t.SetKubectlColsFn(func(gvr client.GVR) ([]string, error) {
+		if t.app.factory == nil {
+			return nil, errors.New("no factory available")
+		}
+		return dao.KubectlColumns(t.app.factory, gvr, t.GetModel().GetNamespace())
+	})
 	t.Table.Init(ctx)
 	t.SetInputCapture(t.keyboard)
 	t.bindKeys()
@@ -127,6 +150,7 @@ func (t *Table) defaultEnv() Env {
 	env["RESOURCE_GROUP"] = t.GVR().G()
 	env["RESOURCE_VERSION"] = t.GVR().V()
 	env["RESOURCE_NAME"] = t.GVR().R()
+	viewSettingEnv(env, t.EffectiveViewSetting())
 
 	return env
 }
@@ -141,12 +165,58 @@ func (t *Table) Start() {
 	t.Stop()
 	t.CmdBuff().AddListener(t)
 	t.Styles().AddListener(t.Table)
+	t.active = true
+	t.applyTheme(t.ViewSetting())
 }
 
 // Stop terminates the component.
 func (t *Table) Stop() {
+	t.SaveScrollOffset()
 	t.CmdBuff().RemoveListener(t)
 	t.Styles().RemoveListener(t.Table)
+	if t.active {
+		t.active = false
+		t.applyTheme(nil)
+	}
+}
+
+func (t *Table) viewSettingChanged(vs *config.ViewSetting) {
+	p := vs.Preset()
+	t.featureAction(tcell.KeyCtrlO, len(vs.Presets) > 0, ui.NewKeyAction("Next Preset", t.nextPresetCmd, false))
+	t.featureAction(tcell.KeyCtrlT, len(vs.SavedFilters) > 0, ui.NewKeyAction("Next Filter", t.nextFilterCmd, false))
+	t.featureAction(tcell.KeyCtrlY, len(p.Mask) > 0, ui.NewKeyAction("Toggle Masked", t.toggleMaskedCmd, false))
+	t.featureAction(tcell.KeyCtrlN, len(p.Links) > 0, ui.NewKeyAction("Open Link", t.openLinkCmd, true))
+	t.featureAction(ui.KeyShiftH, len(p.Links) > 1, ui.NewKeyAction("Next Link", t.nextLinkCmd, true))
+	if t.active {
+		t.applyTheme(vs)
+	}
+}
+
+// featureAction binds or unbinds a view setting feature action. Keys bound
+// to plugins, hotkeys or view keys are left alone.
+func (t *Table) featureAction(k tcell.Key, on bool, a ui.KeyAction) {
+	cur, ok := t.Actions().Get(k)
+	if ok && (cur.Opts.Plugin || cur.Opts.HotKey || cur.Opts.ViewKey) {
+		return
+	}
+	switch {
+	case on:
+		a.Opts.ViewFeature = true
+		t.Actions().Add(k, a)
+	case ok && cur.Opts.ViewFeature:
+		t.Actions().Delete(k)
+	}
+}
+
+func (t *Table) applyTheme(vs *config.ViewSetting) {
+	if t.app == nil {
+		return
+	}
+	var theme string
+	if vs != nil {
+		theme = vs.Theme
+	}
+	t.app.ApplyViewSkin(t.app, theme)
 }
 
 // SetEnterFn specifies the default enter behavior.
@@ -205,11 +275,63 @@ func (t *Table) toggleFaultCmd(evt *tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+func (t *Table) nextPresetCmd(evt *tcell.EventKey) *tcell.EventKey {
+	name, err := t.app.CustomView.NextPreset(t.GVR().String(), t.ViewNamespace())
+	if err != nil {
+		t.app.Flash().Err(err)
+		return nil
+	}
+	t.app.Flash().Infof("Using view preset %q", name)
+
+	return nil
+}
+
+func (t *Table) nextFilterCmd(evt *tcell.EventKey) *tcell.EventKey {
+	name, err := t.app.CustomView.NextFilter(t.GVR().String(), t.ViewNamespace())
+	if err != nil {
+		t.app.Flash().Err(err)
+		return nil
+	}
+	t.ApplySavedFilter(name)
+	t.app.Flash().Infof("Using saved filter %q", name)
+
+	return nil
+}
+
 func (t *Table) toggleWideCmd(evt *tcell.EventKey) *tcell.EventKey {
 	t.ToggleWide()
 	return nil
 }
 
+func (t *Table) toggleMaskedCmd(evt *tcell.EventKey) *tcell.EventKey {
+	t.ToggleMasked()
+	return nil
+}
+
+func (t *Table) nextLinkCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if col, ok := t.NextLinkCol(); ok {
+		t.app.Flash().Infof("Link column %s focused", col)
+	}
+
+	return nil
+}
+
+func (t *Table) openLinkCmd(evt *tcell.EventKey) *tcell.EventKey {
+	l, ok := t.SelectedLink()
+	if !ok {
+		col, _ := t.FocusedLinkCol()
+		t.app.Flash().Warnf("No %s link found on selected row", col)
+		return nil
+	}
+	if err := openURL(t.app, l); err != nil {
+		t.app.Flash().Err(err)
+		return nil
+	}
+	t.app.Flash().Infof("Opening %s...", l)
+
+	return nil
+}
+
 func (t *Table) cpCmd(evt *tcell.EventKey) *tcell.EventKey {
 	path := t.GetSelectedItem()
 	if path == "" {
@@ -20,6 +20,7 @@ import (
 	"github.com/derailed/k9s/internal/model1"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
 	"github.com/derailed/tview"
 	"github.com/stretchr/testify/assert"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -71,6 +72,30 @@ func TestTableNew(t *testing.T) {
 	assert.Equal(t, 3, v.GetRowCount())
 }
 
+func TestTableFeatureActions(t *testing.T) {
+	v := NewTable(client.NewGVR("test"))
+	assert.NoError(t, v.Init(makeContext()))
+	v.Actions().Add(tcell.KeyCtrlT, ui.NewKeyActionWithOpts("Fred", nil, ui.ActionOpts{Plugin: true}))
+
+	v.viewSettingChanged(&config.ViewSetting{
+		Presets:      map[string]config.ViewSetting{"p1": {Columns: []string{"NAME"}}},
+		SavedFilters: map[string]string{"f1": "fred"},
+	})
+	a, ok := v.Actions().Get(tcell.KeyCtrlO)
+	assert.True(t, ok)
+	assert.Equal(t, "Next Preset", a.Description)
+	a, ok = v.Actions().Get(tcell.KeyCtrlT)
+	assert.True(t, ok)
+	assert.Equal(t, "Fred", a.Description)
+
+	v.viewSettingChanged(&config.ViewSetting{})
+	_, ok = v.Actions().Get(tcell.KeyCtrlO)
+	assert.False(t, ok)
+	a, ok = v.Actions().Get(tcell.KeyCtrlT)
+	assert.True(t, ok)
+	assert.Equal(t, "Fred", a.Description)
+}
+
 func TestTableViewFilter(t *testing.T) {
 	v := NewTable(client.NewGVR("test"))
 	assert.NoError(t, v.Init(makeContext()))
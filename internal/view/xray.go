@@ -42,6 +42,7 @@ type Xray struct {
 	model    *model.Tree
 	cancelFn context.CancelFunc
 	envFn    EnvFunc
+	faults   bool
 }
 
 // NewXray returns a new view.
@@ -93,6 +94,9 @@ func (x *Xray) Init(ctx context.Context) error {
 			return
 		}
 		x.SetSelectedItem(spec.AsPath())
+		if spec.Reason != "" {
+			x.app.Flash().Warn(spec.Reason)
+		}
 		x.refreshActions()
 	})
 	x.refreshActions()
@@ -121,9 +125,31 @@ func (x *Xray) bindKeys() {
 		ui.KeySlash:     ui.NewSharedKeyAction("Filter Mode", x.activateCmd, false),
 		tcell.KeyEscape: ui.NewSharedKeyAction("Filter Reset", x.resetCmd, false),
 		tcell.KeyEnter:  ui.NewKeyAction("Goto", x.gotoCmd, true),
+		tcell.KeyCtrlZ:  ui.NewKeyAction("Toggle Faults", x.toggleFaultCmd, false),
+		ui.KeyF:         ui.NewKeyAction("Focus", x.focusCmd, true),
 	})
 }
 
+func (x *Xray) focusCmd(evt *tcell.EventKey) *tcell.EventKey {
+	spec := x.selectedSpec()
+	if spec == nil || spec.ParentGVR() == nil {
+		return nil
+	}
+	x.model.SetFocus(spec.GVR(), spec.Path())
+	x.SetSelectedItem(spec.Path())
+	x.app.Flash().Infof("Focusing on %s %s. Press Esc to return to the full tree", client.NewGVR(spec.GVR()).R(), spec.Path())
+	x.Start()
+
+	return nil
+}
+
+func (x *Xray) toggleFaultCmd(evt *tcell.EventKey) *tcell.EventKey {
+	x.faults = !x.faults
+	x.update(x.filter(x.model.Peek()))
+
+	return nil
+}
+
 func (x *Xray) keyEntered() {
 	x.ClearSelection()
 	x.update(x.filter(x.model.Peek()))
@@ -449,6 +475,12 @@ func (x *Xray) activateCmd(evt *tcell.EventKey) *tcell.EventKey {
 func (x *Xray) resetCmd(evt *tcell.EventKey) *tcell.EventKey {
 	if !x.CmdBuff().InCmdMode() {
 		x.CmdBuff().Reset()
+		if _, ok := x.model.Focus(); ok {
+			x.model.ClearFocus()
+			x.ClearSelection()
+			x.Start()
+			return nil
+		}
 		return x.app.PrevCmd(evt)
 	}
 	x.CmdBuff().Reset()
@@ -482,6 +514,9 @@ func (x *Xray) gotoCmd(evt *tcell.EventKey) *tcell.EventKey {
 }
 
 func (x *Xray) filter(root *xray.TreeNode) *xray.TreeNode {
+	if x.faults && root != nil {
+		root = root.PruneHealthy()
+	}
 	q := x.CmdBuff().GetText()
 	if x.CmdBuff().Empty() || internal.IsLabelSelector(q) {
 		return root
@@ -515,7 +550,8 @@ func (x *Xray) TreeLoadFailed(err error) {
 }
 
 func (x *Xray) update(node *xray.TreeNode) {
-	root := makeTreeNode(node, x.ExpandNodes(), x.app.Config.K9s.UI.NoIcons, x.app.Styles)
+	gg := xrayGlyphs(x.app.Config.K9s)
+	root := makeTreeNode(node, x.ExpandNodes(), x.app.Config.K9s.UI.NoIcons, gg, x.app.Styles)
 	if node == nil {
 		x.app.QueueUpdateDraw(func() {
 			x.SetRoot(root)
@@ -524,7 +560,7 @@ func (x *Xray) update(node *xray.TreeNode) {
 	}
 
 	for _, c := range node.Children {
-		x.hydrate(root, c)
+		x.hydrate(root, c, gg)
 	}
 	if x.GetSelectedItem() == "" {
 		x.SetSelectedItem(node.Spec().Path())
@@ -561,10 +597,10 @@ func (x *Xray) TreeChanged(node *xray.TreeNode) {
 	x.UpdateTitle()
 }
 
-func (x *Xray) hydrate(parent *tview.TreeNode, n *xray.TreeNode) {
-	node := makeTreeNode(n, x.ExpandNodes(), x.app.Config.K9s.UI.NoIcons, x.app.Styles)
+func (x *Xray) hydrate(parent *tview.TreeNode, n *xray.TreeNode, gg xray.Glyphs) {
+	node := makeTreeNode(n, x.ExpandNodes(), x.app.Config.K9s.UI.NoIcons, gg, x.app.Styles)
 	for _, c := range n.Children {
-		x.hydrate(node, c)
+		x.hydrate(node, c, gg)
 	}
 	parent.AddChild(node)
 }
@@ -591,6 +627,18 @@ func (x *Xray) BufferActive(state bool, k model.BufferKind) {
 func (x *Xray) defaultContext() context.Context {
 	ctx := context.WithValue(context.Background(), internal.KeyFactory, x.app.factory)
 	ctx = context.WithValue(ctx, internal.KeyFields, "")
+	ctx = context.WithValue(ctx, xray.KeyStrictRefs, x.app.Config.K9s.StrictRefs)
+	ctx = context.WithValue(ctx, xray.KeyEvents, x.app.Config.K9s.XrayEvents)
+	ctx = context.WithValue(ctx, xray.KeyQuotas, x.app.Config.K9s.XrayQuotas)
+	ctx = context.WithValue(ctx, xray.KeyWebhooks, x.app.Config.K9s.XrayWebhooks)
+	ctx = context.WithValue(ctx, xray.KeyPolicies, x.app.Config.K9s.XrayPolicies)
+	ctx = context.WithValue(ctx, xray.KeyCache, x.app.Config.K9s.XrayCache)
+	if len(x.app.Config.K9s.XraySidecars) > 0 {
+		ctx = context.WithValue(ctx, xray.KeySidecars, x.app.Config.K9s.XraySidecars)
+	}
+	if p := x.app.Config.K9s.XrayPricing; p != nil {
+		ctx = context.WithValue(ctx, xray.KeyPricing, xray.UnitPrices{CPU: p.CPUHour, Mem: p.MemGiBHour})
+	}
 	if x.CmdBuff().Empty() {
 		ctx = context.WithValue(ctx, internal.KeyLabels, "")
 	} else {
@@ -651,6 +699,9 @@ func (x *Xray) UpdateTitle() {
 
 func (x *Xray) styleTitle() string {
 	base := fmt.Sprintf("%s-%s", xrayTitle, cases.Title(language.Und, cases.NoLower).String(x.gvr.R()))
+	if f, ok := x.model.Focus(); ok {
+		base += "@" + f.Path()
+	}
 	ns := x.model.GetNamespace()
 	if client.IsAllNamespaces(ns) {
 		ns = client.NamespaceAll
@@ -737,10 +788,19 @@ func rxInverseFilter(q, path string) bool {
 	return true
 }
 
-func makeTreeNode(node *xray.TreeNode, expanded bool, showIcons bool, styles *config.Styles) *tview.TreeNode {
+// xrayGlyphs returns the configured xray status glyphs.
+func xrayGlyphs(k *config.K9s) xray.Glyphs {
+	if k.XrayGlyphs == nil {
+		return xray.NewGlyphs(false, nil)
+	}
+
+	return xray.NewGlyphs(k.XrayGlyphs.ASCII, k.XrayGlyphs.Statuses)
+}
+
+func makeTreeNode(node *xray.TreeNode, expanded bool, showIcons bool, gg xray.Glyphs, styles *config.Styles) *tview.TreeNode {
 	n := tview.NewTreeNode("No data...")
 	if node != nil {
-		n.SetText(node.Title(showIcons))
+		n.SetText(node.Title(showIcons, gg))
 		n.SetReference(node.Spec())
 	}
 	n.SetSelectable(true)
@@ -0,0 +1,292 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package xray
+
+import (
+	"container/list"
+	"context"
+	"fmt"
+	"hash/fnv"
+	"maps"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// DefaultResolverCacheSize represents the max number of cached resources subtrees.
+const DefaultResolverCacheSize = 500
+
+// Renderer represents a resource subtree renderer.
+type Renderer interface {
+	Render(ctx context.Context, ns string, o interface{}) error
+}
+
+var resolvers = newResolverCache(DefaultResolverCacheSize)
+
+// Resolve renders a resource subtree under the context parent node. When the
+// cache is enabled, the subtree resolved by a previous render is reused if
+// neither the resource, any resource in its subtree nor the render options
+// changed.
+func Resolve(ctx context.Context, r Renderer, ns string, o interface{}) error {
+	if on, _ := ctx.Value(KeyCache).(bool); !on {
+		return r.Render(ctx, ns, o)
+	}
+
+	return resolvers.resolve(ctx, r, ns, o)
+}
+
+// resolverCache caches resolved resources subtrees keyed by resource UID and
+// render options.
+// Entries are invalidated once the resource version changes or any resource
+// listed by the subtree changes. The least recently used entries are evicted
+// past the cache size.
+type resolverCache struct {
+	size    int
+	lru     *list.List
+	entries map[string]*list.Element
+	mx      sync.Mutex
+}
+
+type cacheEntry struct {
+	key, version string
+	deps         map[string]uint64
+	nodes        []*TreeNode
+}
+
+func newResolverCache(size int) *resolverCache {
+	return &resolverCache{
+		size:    size,
+		lru:     list.New(),
+		entries: make(map[string]*list.Element),
+	}
+}
+
+func (c *resolverCache) resolve(ctx context.Context, r Renderer, ns string, o interface{}) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	parent, ok := ctx.Value(KeyParent).(*TreeNode)
+	if !ok {
+		return fmt.Errorf("Expecting a TreeNode but got %T", ctx.Value(KeyParent))
+	}
+	f, ok := ctx.Value(internal.KeyFactory).(dao.Factory)
+	if !ok {
+		return r.Render(ctx, ns, o)
+	}
+	uid, version := objectVersion(o)
+	if uid == "" || version == "" || hasMetrics(o) {
+		return r.Render(ctx, ns, o)
+	}
+
+	key := fmt.Sprintf("%T|%s|%s|%x", r, ns, uid, settings(ctx))
+	if nn, ok := c.get(f, key, version); ok {
+		graft(parent, nn)
+		return nil
+	}
+	scratch := NewTreeNode(parent.GVR, parent.ID)
+	if err := r.Render(context.WithValue(ctx, KeyParent, scratch), ns, o); err != nil {
+		return err
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	c.put(key, version, dependencies(f, scratch.Children), scratch.Children)
+	graft(parent, scratch.Children)
+
+	return nil
+}
+
+// get returns a copy of a cached subtree. Stale entries are dropped.
+func (c *resolverCache) get(f dao.Factory, key, version string) ([]*TreeNode, bool) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	e, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	entry := e.Value.(*cacheEntry)
+	if entry.version != version || !entry.fresh(f) {
+		c.lru.Remove(e)
+		delete(c.entries, key)
+		return nil, false
+	}
+	c.lru.MoveToFront(e)
+
+	return cloneNodes(entry.nodes), true
+}
+
+func (c *resolverCache) put(key, version string, deps map[string]uint64, nn []*TreeNode) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	if e, ok := c.entries[key]; ok {
+		c.lru.Remove(e)
+	}
+	c.entries[key] = c.lru.PushFront(&cacheEntry{key: key, version: version, deps: deps, nodes: cloneNodes(nn)})
+	for c.lru.Len() > c.size {
+		e := c.lru.Back()
+		c.lru.Remove(e)
+		delete(c.entries, e.Value.(*cacheEntry).key)
+	}
+}
+
+// fresh checks none of the resources the entry was resolved from changed.
+func (e *cacheEntry) fresh(f dao.Factory) bool {
+	for k, sum := range e.deps {
+		gvr, ns, _ := strings.Cut(k, "|")
+		if fingerprint(f, gvr, ns) != sum {
+			return false
+		}
+	}
+
+	return true
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// renderKeys lists the context options that change what a render produces.
+var renderKeys = []TreeRef{
+	KeyStrictRefs,
+	KeyEvents,
+	KeySidecars,
+	KeyPricing,
+	KeyQuotas,
+	KeyWebhooks,
+	KeyPolicies,
+}
+
+// settings hashes the render options so subtrees resolved under other
+// options are not reused.
+func settings(ctx context.Context) uint64 {
+	h := fnv.New64a()
+	for _, k := range renderKeys {
+		_, _ = fmt.Fprintf(h, "%v", ctx.Value(k))
+		_, _ = h.Write([]byte{0})
+	}
+
+	return h.Sum64()
+}
+
+// dependencies fingerprints the resources listed by a subtree, per resource
+// and namespace, so added, updated or deleted dependents invalidate it.
+func dependencies(f dao.Factory, nn []*TreeNode) map[string]uint64 {
+	deps := make(map[string]uint64)
+	for _, n := range nn {
+		walk(n, func(n *TreeNode) {
+			if !strings.Contains(n.GVR, "/") {
+				return
+			}
+			ns, _ := client.Namespaced(n.ID)
+			k := n.GVR + "|" + ns
+			if _, ok := deps[k]; !ok {
+				deps[k] = fingerprint(f, n.GVR, ns)
+			}
+		})
+	}
+
+	return deps
+}
+
+// fingerprint hashes the uids and versions of the cached resources in a namespace.
+func fingerprint(f dao.Factory, gvr, ns string) uint64 {
+	oo, err := f.List(gvr, ns, false, labels.Everything())
+	if err != nil {
+		return 0
+	}
+	vv := make([]string, 0, len(oo))
+	for _, o := range oo {
+		if m, err := meta.Accessor(o); err == nil {
+			vv = append(vv, string(m.GetUID())+"@"+m.GetResourceVersion())
+		}
+	}
+	sort.Strings(vv)
+	h := fnv.New64a()
+	for _, v := range vv {
+		_, _ = h.Write([]byte(v))
+		_, _ = h.Write([]byte{0})
+	}
+
+	return h.Sum64()
+}
+
+// hasMetrics checks if a resource carries metrics, which are not versioned.
+func hasMetrics(o interface{}) bool {
+	switch t := o.(type) {
+	case *render.PodWithMetrics:
+		return t.MX != nil
+	case *render.NodeWithMetrics:
+		return t.MX != nil
+	}
+
+	return false
+}
+
+// objectVersion returns a resource UID and version if any.
+func objectVersion(o interface{}) (string, string) {
+	if pwm, ok := o.(*render.PodWithMetrics); ok {
+		if pwm.Raw == nil {
+			return "", ""
+		}
+		o = pwm.Raw
+	}
+	m, err := meta.Accessor(o)
+	if err != nil {
+		return "", ""
+	}
+
+	return string(m.GetUID()), m.GetResourceVersion()
+}
+
+// graft attaches subtrees to a parent, merging them into existing parent
+// children such as namespace nodes.
+func graft(parent *TreeNode, nn []*TreeNode) {
+	for _, n := range nn {
+		if c := parent.child(n.GVR, n.ID); c != nil {
+			for _, cn := range n.Children {
+				c.Add(cn)
+			}
+			continue
+		}
+		parent.Add(n)
+	}
+}
+
+func cloneNodes(nn []*TreeNode) []*TreeNode {
+	cc := make([]*TreeNode, 0, len(nn))
+	for _, n := range nn {
+		cc = append(cc, n.deepClone())
+	}
+
+	return cc
+}
+
+// deepClone returns a detached copy of a node and its descendants.
+func (t *TreeNode) deepClone() *TreeNode {
+	n := &TreeNode{GVR: t.GVR, ID: t.ID, Extras: maps.Clone(t.Extras), Payload: t.Payload}
+	for _, c := range t.Children {
+		n.Add(c.deepClone())
+	}
+
+	return n
+}
+
+// child returns a direct child node matching a gvr/id spec.
+func (t *TreeNode) child(gvr, id string) *TreeNode {
+	for _, c := range t.Children {
+		if c.GVR == gvr && c.ID == id {
+			return c
+		}
+	}
+
+	return nil
+}
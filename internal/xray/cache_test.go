@@ -0,0 +1,166 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package xray_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/xray"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestResolve(t *testing.T) {
+	var r countRenderer
+	f := makeFactory()
+	resolve := func(o *unstructured.Unstructured) *xray.TreeNode {
+		root := xray.NewTreeNode("blees", "blees")
+		ctx := context.WithValue(context.Background(), xray.KeyParent, root)
+		ctx = context.WithValue(ctx, internal.KeyFactory, f)
+		ctx = context.WithValue(ctx, xray.KeyCache, true)
+		require.NoError(t, xray.Resolve(ctx, &r, "", o))
+		require.NoError(t, xray.Resolve(ctx, &r, "", makeBlee("b2", "u2", "1")))
+		return root
+	}
+
+	root := resolve(makeBlee("b1", "u1", "1"))
+	assert.Equal(t, 2, r.calls)
+	assert.Equal(t, 1, root.CountChildren())
+	assert.Equal(t, "1", root.Find("v1/blees", "default/b1").Extras[xray.InfoKey])
+
+	root.Find("v1/blees", "default/b1").Extras[xray.InfoKey] = "bozo"
+	root = resolve(makeBlee("b1", "u1", "1"))
+	assert.Equal(t, 2, r.calls)
+	assert.Equal(t, 1, root.CountChildren())
+	assert.Equal(t, 2, root.Children[0].CountChildren())
+	assert.Equal(t, "1", root.Find("v1/blees", "default/b1").Extras[xray.InfoKey])
+
+	root = resolve(makeBlee("b1", "u1", "2"))
+	assert.Equal(t, 3, r.calls)
+	assert.Equal(t, "2", root.Find("v1/blees", "default/b1").Extras[xray.InfoKey])
+
+	resolve(makeBlee("b1", "", "2"))
+	assert.Equal(t, 4, r.calls)
+}
+
+func TestResolveDependents(t *testing.T) {
+	var r countRenderer
+	f := makeFactory()
+	f.rows = map[string][]runtime.Object{"v1/blees": {makeBlee("b1", "u1", "1")}}
+	root := xray.NewTreeNode("blees", "blees")
+	ctx := context.WithValue(context.Background(), xray.KeyParent, root)
+	ctx = context.WithValue(ctx, internal.KeyFactory, f)
+	ctx = context.WithValue(ctx, xray.KeyCache, true)
+
+	o := makeBlee("b1", "u10", "1")
+	require.NoError(t, xray.Resolve(ctx, &r, "", o))
+	require.NoError(t, xray.Resolve(ctx, &r, "", o))
+	assert.Equal(t, 1, r.calls)
+
+	f.rows["v1/blees"] = []runtime.Object{makeBlee("b1", "u1", "2")}
+	require.NoError(t, xray.Resolve(ctx, &r, "", o))
+	assert.Equal(t, 2, r.calls)
+
+	f.rows["v1/blees"] = nil
+	require.NoError(t, xray.Resolve(ctx, &r, "", o))
+	assert.Equal(t, 3, r.calls)
+}
+
+func TestResolveSettings(t *testing.T) {
+	var r countRenderer
+	root := xray.NewTreeNode("blees", "blees")
+	ctx := context.WithValue(context.Background(), xray.KeyParent, root)
+	ctx = context.WithValue(ctx, internal.KeyFactory, makeFactory())
+	ctx = context.WithValue(ctx, xray.KeyCache, true)
+
+	o := makeBlee("b1", "u30", "1")
+	require.NoError(t, xray.Resolve(ctx, &r, "", o))
+	require.NoError(t, xray.Resolve(ctx, &r, "", o))
+	assert.Equal(t, 1, r.calls)
+
+	ctx = context.WithValue(ctx, xray.KeyStrictRefs, true)
+	require.NoError(t, xray.Resolve(ctx, &r, "", o))
+	assert.Equal(t, 2, r.calls)
+
+	ctx = context.WithValue(ctx, xray.KeySidecars, []string{"istio-proxy"})
+	require.NoError(t, xray.Resolve(ctx, &r, "", o))
+	require.NoError(t, xray.Resolve(ctx, &r, "", o))
+	assert.Equal(t, 3, r.calls)
+
+	ctx = context.WithValue(ctx, xray.KeyPricing, xray.UnitPrices{CPU: 1})
+	require.NoError(t, xray.Resolve(ctx, &r, "", o))
+	assert.Equal(t, 4, r.calls)
+}
+
+func TestResolveDisabled(t *testing.T) {
+	var r countRenderer
+	root := xray.NewTreeNode("blees", "blees")
+	ctx := context.WithValue(context.Background(), xray.KeyParent, root)
+	ctx = context.WithValue(ctx, internal.KeyFactory, makeFactory())
+
+	o := makeBlee("b1", "u20", "1")
+	require.NoError(t, xray.Resolve(ctx, &r, "", o))
+	require.NoError(t, xray.Resolve(ctx, &r, "", o))
+	assert.Equal(t, 2, r.calls)
+}
+
+func TestResolveCanceled(t *testing.T) {
+	var r countRenderer
+	root := xray.NewTreeNode("blees", "blees")
+	ctx := context.WithValue(context.Background(), xray.KeyParent, root)
+	ctx = context.WithValue(ctx, internal.KeyFactory, makeFactory())
+	ctx, cancel := context.WithCancel(context.WithValue(ctx, xray.KeyCache, true))
+	cancel()
+
+	assert.ErrorIs(t, xray.Resolve(ctx, &r, "", makeBlee("b1", "u3", "1")), context.Canceled)
+	assert.Equal(t, 0, r.calls)
+	assert.Equal(t, 0, root.CountChildren())
+}
+
+// Helpers...
+
+type countRenderer struct {
+	calls int
+}
+
+func (r *countRenderer) Render(ctx context.Context, _ string, o interface{}) error {
+	r.calls++
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("expecting unstructured but got %T", o)
+	}
+	parent, ok := ctx.Value(xray.KeyParent).(*xray.TreeNode)
+	if !ok {
+		return fmt.Errorf("expecting a TreeNode but got %T", ctx.Value(xray.KeyParent))
+	}
+	nsn := parent.Find("v1/namespaces", "-/"+u.GetNamespace())
+	if nsn == nil {
+		nsn = xray.NewTreeNode("v1/namespaces", "-/"+u.GetNamespace())
+		parent.Add(nsn)
+	}
+	n := xray.NewTreeNode("v1/blees", u.GetNamespace()+"/"+u.GetName())
+	n.Extras[xray.InfoKey] = u.GetResourceVersion()
+	nsn.Add(n)
+
+	return nil
+}
+
+func makeBlee(n, uid, rv string) *unstructured.Unstructured {
+	o := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Blee",
+	}}
+	o.SetName(n)
+	o.SetNamespace("default")
+	o.SetUID(types.UID(uid))
+	o.SetResourceVersion(rv)
+
+	return o
+}
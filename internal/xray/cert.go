@@ -0,0 +1,111 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package xray
+
+import (
+	"bytes"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// checkCert annotates a TLS secret node with its leaf certificate expiry and
+// issuer. Certificates expiring within CertExpiryThreshold are flagged.
+// Non TLS or unparseable secrets are left as is.
+func checkCert(n *TreeNode, o runtime.Object) {
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return
+	}
+	var sec v1.Secret
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &sec); err != nil {
+		log.Debug().Err(err).Msgf("Unable to convert secret %q", n.ID)
+		return
+	}
+	if sec.Type != v1.SecretTypeTLS {
+		return
+	}
+	c, err := leafCert(sec.Data[v1.TLSCertKey])
+	if err != nil {
+		log.Debug().Err(err).Msgf("Unable to parse certificate for secret %q", n.ID)
+		return
+	}
+	n.Extras[CertExpiryKey] = c.NotAfter.UTC().Format(time.RFC3339)
+	n.Extras[CertIssuerKey] = issuerName(c)
+	if n.Extras[StatusKey] == OkStatus && time.Until(c.NotAfter) < CertExpiryThreshold {
+		n.Extras[StatusKey] = ExpiringStatus
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// leafCert returns the leaf certificate of a PEM bundle ie the first
+// certificate not issuing any other certificate in the bundle.
+func leafCert(bb []byte) (*x509.Certificate, error) {
+	var cc []*x509.Certificate
+	for len(bb) > 0 {
+		var b *pem.Block
+		b, bb = pem.Decode(bb)
+		if b == nil {
+			break
+		}
+		if b.Type != "CERTIFICATE" {
+			continue
+		}
+		c, err := x509.ParseCertificate(b.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		cc = append(cc, c)
+	}
+	if len(cc) == 0 {
+		return nil, errors.New("no certificate found")
+	}
+	for _, c := range cc {
+		if !issues(c, cc) {
+			return c, nil
+		}
+	}
+
+	return cc[0], nil
+}
+
+func issues(c *x509.Certificate, cc []*x509.Certificate) bool {
+	for _, o := range cc {
+		if o != c && bytes.Equal(o.RawIssuer, c.RawSubject) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func issuerName(c *x509.Certificate) string {
+	if c.Issuer.CommonName != "" {
+		return c.Issuer.CommonName
+	}
+
+	return c.Issuer.String()
+}
+
+// explainCert returns a certificate expiry reason.
+func explainCert(expiry string) string {
+	t, err := time.Parse(time.RFC3339, expiry)
+	if err != nil {
+		return "certificate expires " + expiry
+	}
+	if d := time.Until(t); d > 0 {
+		return fmt.Sprintf("certificate expires in %s (%s)", d.Truncate(time.Hour), expiry)
+	}
+
+	return "certificate expired on " + expiry
+}
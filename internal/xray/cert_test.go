@@ -0,0 +1,131 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package xray_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/xray"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestPodRenderCertExpiry(t *testing.T) {
+	caPEM, ca, caKey := makeCert(t, "fred-ca", 365*24*time.Hour, nil, nil)
+	leafPEM := func(d time.Duration) []byte {
+		bb, _, _ := makeCert(t, "blee.example.com", d, ca, caKey)
+		return bb
+	}
+
+	uu := map[string]struct {
+		kind           v1.SecretType
+		crt            []byte
+		status, issuer string
+	}{
+		"valid": {
+			kind:   v1.SecretTypeTLS,
+			crt:    leafPEM(90 * 24 * time.Hour),
+			status: xray.OkStatus,
+			issuer: "fred-ca",
+		},
+		"ca-first": {
+			kind:   v1.SecretTypeTLS,
+			crt:    append(append([]byte{}, caPEM...), leafPEM(10*24*time.Hour)...),
+			status: xray.ExpiringStatus,
+			issuer: "fred-ca",
+		},
+		"expired": {
+			kind:   v1.SecretTypeTLS,
+			crt:    append(leafPEM(-time.Hour), caPEM...),
+			status: xray.ExpiringStatus,
+			issuer: "fred-ca",
+		},
+		"self-signed": {
+			kind:   v1.SecretTypeTLS,
+			crt:    caPEM,
+			status: xray.OkStatus,
+			issuer: "fred-ca",
+		},
+		"opaque": {
+			kind:   v1.SecretTypeOpaque,
+			crt:    leafPEM(time.Hour),
+			status: xray.OkStatus,
+		},
+		"garbage": {
+			kind:   v1.SecretTypeTLS,
+			crt:    []byte("bozo"),
+			status: xray.OkStatus,
+		},
+	}
+
+	var re xray.Pod
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			f := makeFactory()
+			f.rows = map[string][]runtime.Object{
+				"v1/secrets": {toUnstructured(t, &v1.Secret{
+					TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Secret"},
+					ObjectMeta: metav1.ObjectMeta{Name: "default-token-9ph8s", Namespace: "default"},
+					Type:       u.kind,
+					Data:       map[string][]byte{v1.TLSCertKey: u.crt},
+				})},
+			}
+			root := xray.NewTreeNode("pods", "pods")
+			ctx := context.WithValue(context.Background(), xray.KeyParent, root)
+			ctx = context.WithValue(ctx, internal.KeyFactory, f)
+
+			assert.NoError(t, re.Render(ctx, "", &render.PodWithMetrics{Raw: load(t, "po")}))
+			n := root.Find("v1/secrets", "default/default-token-9ph8s")
+			require.NotNil(t, n)
+			assert.Equal(t, u.status, n.Extras[xray.StatusKey])
+			assert.Equal(t, u.issuer, n.Extras[xray.CertIssuerKey])
+			if u.issuer == "" {
+				assert.NotContains(t, n.Extras, xray.CertExpiryKey)
+			}
+			if u.status == xray.ExpiringStatus {
+				assert.Contains(t, n.ExplainStatus(), "certificate expire")
+				assert.Contains(t, n.Title(true, nil), "EXPIRING")
+			}
+		})
+	}
+}
+
+// Helpers...
+
+func makeCert(t *testing.T, cn string, ttl time.Duration, parent *x509.Certificate, parentKey *ecdsa.PrivateKey) ([]byte, *x509.Certificate, *ecdsa.PrivateKey) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tpl := x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: cn},
+		NotBefore:             time.Now().Add(-48 * time.Hour),
+		NotAfter:              time.Now().Add(ttl),
+		IsCA:                  parent == nil,
+		BasicConstraintsValid: true,
+	}
+	if parent == nil {
+		parent, parentKey = &tpl, key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, &tpl, parent, &key.PublicKey, parentKey)
+	require.NoError(t, err)
+	c, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), c, key
+}
@@ -6,6 +6,10 @@ package xray
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
@@ -13,7 +17,16 @@ import (
 	"github.com/derailed/k9s/internal/render"
 	"github.com/rs/zerolog/log"
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/duration"
+)
+
+const (
+	oomKilled        = "OOMKilled"
+	crashLoopBackOff = "CrashLoopBackOff"
 )
 
 // Container represents an xray renderer.
@@ -37,68 +50,213 @@ func (c *Container) Render(ctx context.Context, ns string, o interface{}) error
 		return fmt.Errorf("Expecting a TreeNode but got %T", ctx.Value(KeyParent))
 	}
 	pns, _ := client.Namespaced(parent.ID)
-	c.envRefs(f, root, pns, co.Container)
+	c.envRefs(ctx, f, root, pns, co.Container)
+	c.probes(root, co)
+	c.restarts(root, co)
 	parent.Add(root)
 
 	return nil
 }
 
-func (c *Container) envRefs(f dao.Factory, parent *TreeNode, ns string, co *v1.Container) {
+func (c *Container) envRefs(ctx context.Context, f dao.Factory, parent *TreeNode, ns string, co *v1.Container) {
 	for _, e := range co.Env {
 		if e.ValueFrom == nil {
 			continue
 		}
-		c.secretRefs(f, parent, ns, e.ValueFrom.SecretKeyRef)
-		c.configMapRefs(f, parent, ns, e.ValueFrom.ConfigMapKeyRef)
+		c.secretRefs(ctx, f, parent, ns, e.ValueFrom.SecretKeyRef)
+		c.configMapRefs(ctx, f, parent, ns, e.ValueFrom.ConfigMapKeyRef)
 	}
 
 	for _, e := range co.EnvFrom {
 		if e.ConfigMapRef != nil {
 			gvr, id := "v1/configmaps", client.FQN(ns, e.ConfigMapRef.Name)
-			addRef(f, parent, gvr, id, e.ConfigMapRef.Optional)
+			addRef(ctx, f, parent, gvr, id, e.ConfigMapRef.Optional)
 		}
 		if e.SecretRef != nil {
 			gvr, id := "v1/secrets", client.FQN(ns, e.SecretRef.Name)
-			addRef(f, parent, gvr, id, e.SecretRef.Optional)
+			addRef(ctx, f, parent, gvr, id, e.SecretRef.Optional)
 		}
 	}
+	envOverrides(f, parent, ns, co)
 }
 
-func (c *Container) secretRefs(f dao.Factory, parent *TreeNode, ns string, ref *v1.SecretKeySelector) {
+// envOverrides flags env vars defined more than once in a container resolved
+// env set. EnvFrom sources apply in order then env entries, the last
+// definition winning.
+func envOverrides(f dao.Factory, n *TreeNode, ns string, co *v1.Container) {
+	var (
+		src  = make(map[string]string)
+		dups []string
+	)
+	set := func(name, s string) {
+		if _, ok := src[name]; ok && !slices.Contains(dups, name) {
+			dups = append(dups, name)
+		}
+		src[name] = s
+	}
+	from := func(gvr, kind, name, prefix string) {
+		for _, k := range dataKeys(f, gvr, client.FQN(ns, name)) {
+			set(prefix+k, kind+"/"+name)
+		}
+	}
+	for _, e := range co.EnvFrom {
+		if e.ConfigMapRef != nil {
+			from("v1/configmaps", "configmap", e.ConfigMapRef.Name, e.Prefix)
+		}
+		if e.SecretRef != nil {
+			from("v1/secrets", "secret", e.SecretRef.Name, e.Prefix)
+		}
+	}
+	for _, e := range co.Env {
+		set(e.Name, "env")
+	}
+	if len(dups) == 0 {
+		return
+	}
+
+	slices.Sort(dups)
+	ss := make([]string, 0, len(dups))
+	for _, d := range dups {
+		ss = append(ss, d+":"+src[d])
+	}
+	n.Extras[EnvOverrideKey] = strings.Join(ss, ",")
+}
+
+func (c *Container) secretRefs(ctx context.Context, f dao.Factory, parent *TreeNode, ns string, ref *v1.SecretKeySelector) {
 	if ref == nil {
 		return
 	}
 	gvr, id := "v1/secrets", client.FQN(ns, ref.LocalObjectReference.Name)
-	addRef(f, parent, gvr, id, ref.Optional)
+	addRef(ctx, f, parent, gvr, id, ref.Optional)
 }
 
-func (c *Container) configMapRefs(f dao.Factory, parent *TreeNode, ns string, ref *v1.ConfigMapKeySelector) {
+func (c *Container) configMapRefs(ctx context.Context, f dao.Factory, parent *TreeNode, ns string, ref *v1.ConfigMapKeySelector) {
 	if ref == nil {
 		return
 	}
 	gvr, id := "v1/configmaps", client.FQN(ns, ref.LocalObjectReference.Name)
-	addRef(f, parent, gvr, id, ref.Optional)
+	addRef(ctx, f, parent, gvr, id, ref.Optional)
+}
+
+func (*Container) probes(n *TreeNode, co render.ContainerRes) {
+	ss := co.Status
+	pp := []string{
+		"liveness:" + probeState(co.Container.LivenessProbe, ss, func(s *v1.ContainerStatus) bool {
+			return s.State.Running != nil
+		}),
+		"readiness:" + probeState(co.Container.ReadinessProbe, ss, func(s *v1.ContainerStatus) bool {
+			return s.Ready
+		}),
+		"startup:" + probeState(co.Container.StartupProbe, ss, func(s *v1.ContainerStatus) bool {
+			return s.Started != nil && *s.Started
+		}),
+	}
+	n.Extras[ProbesKey] = strings.Join(pp, ",")
+	if !co.IsInit && co.Container.ReadinessProbe == nil {
+		n.Extras[NoReadinessKey] = "true"
+	}
+}
+
+// restarts annotates a restarted container with its last termination reason.
+func (*Container) restarts(n *TreeNode, co render.ContainerRes) {
+	s := co.Status
+	if s == nil || s.RestartCount == 0 || s.LastTerminationState.Terminated == nil {
+		return
+	}
+	t := s.LastTerminationState.Terminated
+	reason := t.Reason
+	if reason == "" {
+		reason = "Unknown"
+	}
+	n.Extras[RestartsKey] = strconv.Itoa(int(s.RestartCount))
+	n.Extras[LastReasonKey] = reason
+	n.Extras[ExitCodeKey] = strconv.Itoa(int(t.ExitCode))
+	n.Extras[InfoKey] = fmt.Sprintf("restarts:%d,last:%s(%d)", s.RestartCount, reason, t.ExitCode)
+	if reason == oomKilled {
+		n.Extras[OOMKilledKey] = "true"
+	}
+	if w := s.State.Waiting; w != nil && w.Reason == crashLoopBackOff {
+		n.Extras[CrashLoopKey] = "true"
+	}
 }
 
 // ----------------------------------------------------------------------------
 // Helpers...
 
-func addRef(f dao.Factory, parent *TreeNode, gvr, id string, optional *bool) {
+// probeState returns a probe state or set if no status is available.
+func probeState(p *v1.Probe, s *v1.ContainerStatus, passed func(*v1.ContainerStatus) bool) string {
+	switch {
+	case p == nil:
+		return "none"
+	case s == nil:
+		return "set"
+	case passed(s):
+		return "ok"
+	default:
+		return "failed"
+	}
+}
+
+// dataKeys returns a configmap or secret data keys.
+func dataKeys(f dao.Factory, gvr, id string) []string {
+	o, err := f.Get(gvr, id, true, labels.Everything())
+	if err != nil || o == nil {
+		return nil
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return nil
+	}
+	var kk []string
+	for _, field := range []string{"data", "binaryData"} {
+		m, _, _ := unstructured.NestedMap(u.Object, field)
+		for k := range m {
+			kk = append(kk, k)
+		}
+	}
+	slices.Sort(kk)
+
+	return kk
+}
+
+func addRef(ctx context.Context, f dao.Factory, parent *TreeNode, gvr, id string, optional *bool) {
 	if parent.Find(gvr, id) == nil {
 		n := NewTreeNode(gvr, id)
-		validate(f, n, optional)
+		validate(ctx, f, n, optional)
 		parent.Add(n)
 	}
 }
 
-func validate(f dao.Factory, n *TreeNode, optional *bool) {
+func validate(ctx context.Context, f dao.Factory, n *TreeNode, optional *bool) {
 	res, err := f.Get(n.GVR, n.ID, true, labels.Everything())
 	if err != nil || res == nil {
-		if optional == nil || !*optional {
+		strict, _ := ctx.Value(KeyStrictRefs).(bool)
+		if strict || optional == nil || !*optional {
 			log.Warn().Err(err).Msgf("Missing ref %q::%q", n.GVR, n.ID)
 			n.Extras[StatusKey] = MissingRefStatus
 		}
 		return
 	}
 	n.Extras[StatusKey] = OkStatus
+	if m, err := meta.Accessor(res); err == nil {
+		terminating(n, m)
+	}
+	if n.GVR == "v1/secrets" {
+		checkCert(n, res)
+	}
+}
+
+// terminating flags a resource pending deletion and its remaining finalizers.
+func terminating(n *TreeNode, m metav1.Object) {
+	ts := m.GetDeletionTimestamp()
+	if ts == nil {
+		return
+	}
+	n.Extras[StatusKey] = TerminatingStatus
+	if ff := m.GetFinalizers(); len(ff) > 0 {
+		n.Extras[FinalizersKey] = strings.Join(ff, ",")
+	}
+	if time.Since(ts.Time) > StuckThreshold {
+		n.Extras[StuckKey] = duration.HumanDuration(time.Since(ts.Time))
+	}
 }
@@ -43,6 +43,7 @@ func TestCOConfigMapRefs(t *testing.T) {
 func TestCORefs(t *testing.T) {
 	uu := map[string]struct {
 		co             render.ContainerRes
+		strict         bool
 		level1, level2 int
 		e              string
 	}{
@@ -76,6 +77,20 @@ func TestCORefs(t *testing.T) {
 			level2: 1,
 			e:      xray.OkStatus,
 		},
+		"sec_optional_strict": {
+			co:     render.ContainerRes{Container: makeSecContainer("c1", true)},
+			strict: true,
+			level1: 1,
+			level2: 1,
+			e:      xray.MissingRefStatus,
+		},
+		"cm_optional_strict": {
+			co:     render.ContainerRes{Container: makeCMContainer("c1", true)},
+			strict: true,
+			level1: 1,
+			level2: 1,
+			e:      xray.MissingRefStatus,
+		},
 		"envFrom_optional": {
 			co:     render.ContainerRes{Container: makeCMEnvFromContainer("c1", false)},
 			level1: 1,
@@ -91,6 +106,7 @@ func TestCORefs(t *testing.T) {
 			root := xray.NewTreeNode("root", "root")
 			ctx := context.WithValue(context.Background(), xray.KeyParent, root)
 			ctx = context.WithValue(ctx, internal.KeyFactory, makeFactory())
+			ctx = context.WithValue(ctx, xray.KeyStrictRefs, u.strict)
 
 			assert.Nil(t, re.Render(ctx, "", u.co))
 			assert.Equal(t, u.level1, root.CountChildren())
@@ -100,6 +116,213 @@ func TestCORefs(t *testing.T) {
 	}
 }
 
+func TestCOProbes(t *testing.T) {
+	ready, started := true, false
+	uu := map[string]struct {
+		co     render.ContainerRes
+		probes string
+		noRead bool
+	}{
+		"none": {
+			co:     render.ContainerRes{Container: &v1.Container{Name: "c1"}},
+			probes: "liveness:none,readiness:none,startup:none",
+			noRead: true,
+		},
+		"init": {
+			co:     render.ContainerRes{Container: &v1.Container{Name: "c1"}, IsInit: true},
+			probes: "liveness:none,readiness:none,startup:none",
+		},
+		"no-status": {
+			co: render.ContainerRes{Container: &v1.Container{
+				Name:           "c1",
+				LivenessProbe:  &v1.Probe{},
+				ReadinessProbe: &v1.Probe{},
+			}},
+			probes: "liveness:set,readiness:set,startup:none",
+		},
+		"live": {
+			co: render.ContainerRes{
+				Container: &v1.Container{
+					Name:           "c1",
+					LivenessProbe:  &v1.Probe{},
+					ReadinessProbe: &v1.Probe{},
+					StartupProbe:   &v1.Probe{},
+				},
+				Status: &v1.ContainerStatus{
+					Name:    "c1",
+					Ready:   ready,
+					Started: &started,
+					State:   v1.ContainerState{Running: &v1.ContainerStateRunning{}},
+				},
+			},
+			probes: "liveness:ok,readiness:ok,startup:failed",
+		},
+	}
+
+	var re xray.Container
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			root := xray.NewTreeNode("root", "root")
+			ctx := context.WithValue(context.Background(), xray.KeyParent, root)
+			ctx = context.WithValue(ctx, internal.KeyFactory, makeFactory())
+
+			assert.Nil(t, re.Render(ctx, "", u.co))
+			n := root.Children[0]
+			assert.Equal(t, u.probes, n.Extras[xray.ProbesKey])
+			_, ok := n.Extras[xray.NoReadinessKey]
+			assert.Equal(t, u.noRead, ok)
+		})
+	}
+}
+
+func TestCORestarts(t *testing.T) {
+	uu := map[string]struct {
+		status       *v1.ContainerStatus
+		reason, code string
+		title        string
+		oom, crash   bool
+	}{
+		"no-status": {},
+		"no-restarts": {
+			status: &v1.ContainerStatus{Name: "c1"},
+		},
+		"error": {
+			status: &v1.ContainerStatus{
+				Name:                 "c1",
+				RestartCount:         2,
+				LastTerminationState: v1.ContainerState{Terminated: &v1.ContainerStateTerminated{Reason: "Error", ExitCode: 1}},
+			},
+			reason: "Error",
+			code:   "1",
+		},
+		"oom": {
+			status: &v1.ContainerStatus{
+				Name:                 "c1",
+				RestartCount:         3,
+				LastTerminationState: v1.ContainerState{Terminated: &v1.ContainerStateTerminated{Reason: "OOMKilled", ExitCode: 137}},
+			},
+			reason: "OOMKilled",
+			code:   "137",
+			title:  "OOM_KILLED",
+			oom:    true,
+		},
+		"crash-loop": {
+			status: &v1.ContainerStatus{
+				Name:                 "c1",
+				RestartCount:         5,
+				State:                v1.ContainerState{Waiting: &v1.ContainerStateWaiting{Reason: "CrashLoopBackOff"}},
+				LastTerminationState: v1.ContainerState{Terminated: &v1.ContainerStateTerminated{ExitCode: 2}},
+			},
+			reason: "Unknown",
+			code:   "2",
+			title:  "CRASH_LOOP",
+			crash:  true,
+		},
+	}
+
+	var re xray.Container
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			root := xray.NewTreeNode("root", "root")
+			ctx := context.WithValue(context.Background(), xray.KeyParent, root)
+			ctx = context.WithValue(ctx, internal.KeyFactory, makeFactory())
+
+			co := render.ContainerRes{Container: &v1.Container{Name: "c1", ReadinessProbe: &v1.Probe{}}, Status: u.status}
+			assert.Nil(t, re.Render(ctx, "", co))
+			n := root.Children[0]
+			assert.Equal(t, u.reason, n.Extras[xray.LastReasonKey])
+			assert.Equal(t, u.code, n.Extras[xray.ExitCodeKey])
+			_, ok := n.Extras[xray.OOMKilledKey]
+			assert.Equal(t, u.oom, ok)
+			_, ok = n.Extras[xray.CrashLoopKey]
+			assert.Equal(t, u.crash, ok)
+			if u.reason == "" {
+				assert.NotContains(t, n.Extras, xray.RestartsKey)
+				assert.Empty(t, n.ExplainStatus())
+				return
+			}
+			assert.Contains(t, n.ExplainStatus(), "last "+u.reason+" exit "+u.code)
+			if u.title != "" {
+				assert.Contains(t, n.Title(true, nil), u.title)
+			}
+		})
+	}
+}
+
+func TestCOEnvOverrides(t *testing.T) {
+	cm := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata":   map[string]interface{}{"name": "cm1", "namespace": "default"},
+		"data":       map[string]interface{}{"FOO": "1", "BAR": "2"},
+	}}
+	uu := map[string]struct {
+		co *v1.Container
+		e  string
+	}{
+		"none": {
+			co: &v1.Container{
+				Name: "c1",
+				Env:  []v1.EnvVar{{Name: "FOO"}, {Name: "BAZ"}},
+			},
+		},
+		"env": {
+			co: &v1.Container{
+				Name: "c1",
+				Env:  []v1.EnvVar{{Name: "BAZ"}, {Name: "FOO"}, {Name: "BAZ"}},
+			},
+			e: "BAZ:env",
+		},
+		"env-from": {
+			co: &v1.Container{
+				Name: "c1",
+				EnvFrom: []v1.EnvFromSource{
+					{ConfigMapRef: &v1.ConfigMapEnvSource{LocalObjectReference: v1.LocalObjectReference{Name: "cm1"}}},
+				},
+				Env: []v1.EnvVar{{Name: "FOO"}},
+			},
+			e: "FOO:env",
+		},
+		"env-from-twice": {
+			co: &v1.Container{
+				Name: "c1",
+				EnvFrom: []v1.EnvFromSource{
+					{ConfigMapRef: &v1.ConfigMapEnvSource{LocalObjectReference: v1.LocalObjectReference{Name: "cm1"}}},
+					{ConfigMapRef: &v1.ConfigMapEnvSource{LocalObjectReference: v1.LocalObjectReference{Name: "cm1"}}},
+				},
+			},
+			e: "BAR:configmap/cm1,FOO:configmap/cm1",
+		},
+		"prefix": {
+			co: &v1.Container{
+				Name: "c1",
+				EnvFrom: []v1.EnvFromSource{
+					{Prefix: "X_", ConfigMapRef: &v1.ConfigMapEnvSource{LocalObjectReference: v1.LocalObjectReference{Name: "cm1"}}},
+				},
+				Env: []v1.EnvVar{{Name: "FOO"}, {Name: "X_BAR"}},
+			},
+			e: "X_BAR:env",
+		},
+	}
+
+	var re xray.Container
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			f := makeFactory()
+			f.rows = map[string][]runtime.Object{"v1/configmaps": {cm}}
+			root := xray.NewTreeNode("v1/pods", "default/p1")
+			ctx := context.WithValue(context.Background(), xray.KeyParent, root)
+			ctx = context.WithValue(ctx, internal.KeyFactory, f)
+
+			assert.Nil(t, re.Render(ctx, "", render.ContainerRes{Container: u.co}))
+			assert.Equal(t, u.e, root.Children[0].Extras[xray.EnvOverrideKey])
+		})
+	}
+}
+
 // ----------------------------------------------------------------------------
 // Helpers...
 
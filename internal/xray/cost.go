@@ -0,0 +1,53 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package xray
+
+import (
+	"context"
+	"fmt"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const gib = 1 << 30
+
+// PriceTable prices resources requests.
+type PriceTable interface {
+	// HourlyCost returns an hourly cost for the given resources.
+	HourlyCost(v1.ResourceList) float64
+}
+
+// UnitPrices prices cpu per core and memory per GiB per hour.
+type UnitPrices struct {
+	CPU, Mem float64
+}
+
+// HourlyCost returns an hourly cost for the given resources.
+func (u UnitPrices) HourlyCost(rr v1.ResourceList) float64 {
+	return rr.Cpu().AsApproximateFloat64()*u.CPU + rr.Memory().AsApproximateFloat64()/gib*u.Mem
+}
+
+// estimateCost annotates a workload node with an hourly cost estimate
+// computed from its pods requests. No-op unless a price table is set.
+func estimateCost(ctx context.Context, root *TreeNode, oo []runtime.Object) error {
+	pt, ok := ctx.Value(KeyPricing).(PriceTable)
+	if !ok {
+		return nil
+	}
+	reqs := make(v1.ResourceList, 2)
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return fmt.Errorf("expecting *Unstructured but got %T", o)
+		}
+		if err := addPodRequests(reqs, u); err != nil {
+			return err
+		}
+	}
+	root.Extras[CostKey] = fmt.Sprintf("~$%.2f/h", pt.HourlyCost(reqs))
+
+	return nil
+}
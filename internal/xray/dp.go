@@ -66,7 +66,15 @@ func (d *Deployment) Render(ctx context.Context, ns string, o interface{}) error
 	}
 	nsn.Add(root)
 
-	return d.validate(root, dp)
+	if err := estimateCost(ctx, root, oo); err != nil {
+		return err
+	}
+
+	if err := d.validate(root, dp); err != nil {
+		return err
+	}
+
+	return checkSpread(ctx, root, dp.Spec.Template, oo)
 }
 
 func (*Deployment) validate(root *TreeNode, dp appsv1.Deployment) error {
@@ -48,3 +48,42 @@ func TestDeployRender(t *testing.T) {
 		})
 	}
 }
+
+func TestDeployRenderCost(t *testing.T) {
+	uu := map[string]struct {
+		prices xray.PriceTable
+		cost   string
+	}{
+		"none": {},
+		"cpu": {
+			prices: xray.UnitPrices{CPU: 10},
+			cost:   "~$1.00/h",
+		},
+		"full": {
+			prices: xray.UnitPrices{CPU: 10, Mem: 1024},
+			cost:   "~$71.00/h",
+		},
+	}
+
+	var re xray.Deployment
+	for k := range uu {
+		f := makeFactory()
+		f.rows = map[string][]runtime.Object{
+			"v1/pods":            {load(t, "po")},
+			"v1/serviceaccounts": {load(t, "sa")},
+		}
+
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			root := xray.NewTreeNode("deployments", "deployments")
+			ctx := context.WithValue(context.Background(), xray.KeyParent, root)
+			ctx = context.WithValue(ctx, internal.KeyFactory, f)
+			if u.prices != nil {
+				ctx = context.WithValue(ctx, xray.KeyPricing, u.prices)
+			}
+
+			assert.Nil(t, re.Render(ctx, "", load(t, "dp")))
+			assert.Equal(t, u.cost, root.Children[0].Children[0].Extras[xray.CostKey])
+		})
+	}
+}
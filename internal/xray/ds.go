@@ -62,6 +62,10 @@ func (d *DaemonSet) Render(ctx context.Context, ns string, o interface{}) error
 	}
 	nsn.Add(root)
 
+	if err := estimateCost(ctx, root, oo); err != nil {
+		return err
+	}
+
 	return d.validate(root, ds)
 }
 
@@ -0,0 +1,116 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package xray
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/rs/zerolog/log"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// AddEvents annotates tree nodes with their most recent warning event.
+// Missing refs without events of their own inherit a matching event from
+// their closest ancestor.
+func AddEvents(ctx context.Context, f dao.Factory, root *TreeNode) {
+	if on, _ := ctx.Value(KeyEvents).(bool); !on {
+		return
+	}
+
+	nss := make(map[string]struct{})
+	walk(root, func(n *TreeNode) {
+		if ns, _ := client.Namespaced(n.ID); ns != client.ClusterScope && ns != "" {
+			nss[ns] = struct{}{}
+		}
+	})
+	ee := make(map[string]*v1.Event)
+	for ns := range nss {
+		oo, err := f.List("v1/events", ns, false, labels.Everything())
+		if err != nil {
+			log.Warn().Err(err).Msgf("Unable to list events in %q", ns)
+			continue
+		}
+		indexWarnings(oo, ee)
+	}
+
+	walk(root, func(n *TreeNode) {
+		if evt, ok := ee[eventKey(nodeKind(n.GVR), n.ID)]; ok {
+			n.Extras[EventReasonKey], n.Extras[EventMessageKey] = evt.Reason, evt.Message
+		}
+	})
+	walk(root, func(n *TreeNode) {
+		if n.Extras[StatusKey] != MissingRefStatus || n.Extras[EventReasonKey] != "" {
+			return
+		}
+		_, name := client.Namespaced(n.ID)
+		for p := n.Parent; p != nil; p = p.Parent {
+			if msg := p.Extras[EventMessageKey]; msg != "" && strings.Contains(msg, name) {
+				n.Extras[EventReasonKey], n.Extras[EventMessageKey] = p.Extras[EventReasonKey], msg
+				return
+			}
+		}
+	})
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func walk(n *TreeNode, fn func(*TreeNode)) {
+	fn(n)
+	for _, c := range n.Children {
+		walk(c, fn)
+	}
+}
+
+func indexWarnings(oo []runtime.Object, ee map[string]*v1.Event) {
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			continue
+		}
+		var evt v1.Event
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &evt); err != nil {
+			log.Warn().Err(err).Msgf("Unable to convert event %q", u.GetName())
+			continue
+		}
+		if evt.Type != v1.EventTypeWarning {
+			continue
+		}
+		ref := evt.InvolvedObject
+		key := eventKey(strings.ToLower(ref.Kind), client.FQN(ref.Namespace, ref.Name))
+		if prev, ok := ee[key]; !ok || eventTime(&evt).After(eventTime(prev)) {
+			ee[key] = &evt
+		}
+	}
+}
+
+func eventKey(kind, fqn string) string {
+	return kind + ":" + fqn
+}
+
+func eventTime(e *v1.Event) time.Time {
+	switch {
+	case !e.LastTimestamp.IsZero():
+		return e.LastTimestamp.Time
+	case !e.EventTime.IsZero():
+		return e.EventTime.Time
+	default:
+		return e.CreationTimestamp.Time
+	}
+}
+
+func nodeKind(gvr string) string {
+	if meta, err := dao.MetaAccess.MetaFor(client.NewGVR(gvr)); err == nil {
+		return strings.ToLower(meta.Kind)
+	}
+
+	return strings.TrimSuffix(client.NewGVR(gvr).R(), "s")
+}
@@ -0,0 +1,85 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package xray_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/xray"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestAddEvents(t *testing.T) {
+	now := time.Now()
+	f := makeFactory()
+	f.rows = map[string][]runtime.Object{
+		"v1/events": {
+			makeEvent(t, "e1", v1.EventTypeWarning, "Pod", "p1", "BackOff", "Back-off restarting", now.Add(-time.Minute)),
+			makeEvent(t, "e2", v1.EventTypeWarning, "Pod", "p1", "FailedMount", `secret "s1" not found`, now),
+			makeEvent(t, "e3", v1.EventTypeNormal, "Pod", "p2", "Pulled", "Image pulled", now),
+		},
+	}
+
+	uu := map[string]struct {
+		on                         bool
+		p1Reason, p2Reason, secMsg string
+	}{
+		"off": {},
+		"on": {
+			on:       true,
+			p1Reason: "FailedMount",
+			secMsg:   `secret "s1" not found`,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			root := xray.NewTreeNode("pods", "pods")
+			ns := xray.NewTreeNode("v1/namespaces", "-/default")
+			p1, p2 := xray.NewTreeNode("v1/pods", "default/p1"), xray.NewTreeNode("v1/pods", "default/p2")
+			s1 := xray.NewTreeNode("v1/secrets", "default/s1")
+			s1.Extras[xray.StatusKey] = xray.MissingRefStatus
+			p1.Add(s1)
+			ns.Add(p1)
+			ns.Add(p2)
+			root.Add(ns)
+
+			ctx := context.WithValue(context.Background(), xray.KeyEvents, u.on)
+			xray.AddEvents(ctx, f, root)
+
+			assert.Equal(t, u.p1Reason, p1.Extras[xray.EventReasonKey])
+			assert.Equal(t, u.p2Reason, p2.Extras[xray.EventReasonKey])
+			assert.Equal(t, u.secMsg, s1.Extras[xray.EventMessageKey])
+			assert.Equal(t, u.on, s1.Spec().HasEvent())
+		})
+	}
+}
+
+// Helpers...
+
+func makeEvent(t *testing.T, n, kind, okind, oname, reason, msg string, at time.Time) *unstructured.Unstructured {
+	evt := v1.Event{
+		ObjectMeta: metav1.ObjectMeta{Name: n, Namespace: "default"},
+		InvolvedObject: v1.ObjectReference{
+			Kind:      okind,
+			Namespace: "default",
+			Name:      oname,
+		},
+		Type:          kind,
+		Reason:        reason,
+		Message:       msg,
+		LastTimestamp: metav1.NewTime(at),
+	}
+	o, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&evt)
+	assert.NoError(t, err)
+
+	return &unstructured.Unstructured{Object: o}
+}
@@ -0,0 +1,107 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package xray
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+)
+
+// ExplainStatus returns a human readable reason for the node status based on
+// its extras. Returns blank if the node is healthy and has no warnings.
+func (t *TreeNode) ExplainStatus() string {
+	var rr []string
+	switch t.Extras[StatusKey] {
+	case ToastStatus:
+		rr = append(rr, t.explainToast())
+	case MissingRefStatus:
+		if t.GVR == ValidatingPolicyBindingGVR {
+			rr = append(rr, "binding references a missing policy or params")
+			break
+		}
+		rr = append(rr, fmt.Sprintf("referenced %s %q does not exist", client.NewGVR(t.GVR).R(), t.ID))
+	case TerminatingStatus:
+		r := "pending deletion"
+		if d, ok := t.Extras[StuckKey]; ok {
+			r = "stuck terminating for " + d
+		}
+		if ff := t.Extras[FinalizersKey]; ff != "" {
+			r += " waiting on finalizers " + ff
+		}
+		rr = append(rr, r)
+	case ExpiringStatus:
+		rr = append(rr, explainCert(t.Extras[CertExpiryKey]))
+	}
+	if _, ok := t.Extras[NoReadinessKey]; ok {
+		rr = append(rr, "no readiness probe defined")
+	}
+	if o := t.Extras[OvercommitKey]; o != "" {
+		rr = append(rr, "pods requests exceed allocatable "+o)
+	}
+	if r := t.Extras[LastReasonKey]; r != "" {
+		rr = append(rr, explainRestarts(t.Extras))
+	}
+	if o := t.Extras[SkewKey]; o != "" {
+		rr = append(rr, "pods spread violates "+o+" ("+t.Extras[SpreadKey]+")")
+	}
+	if _, ok := t.Extras[NoPDBKey]; ok {
+		rr = append(rr, "not covered by any disruption budget")
+	}
+	if o := t.Extras[EnvOverrideKey]; o != "" {
+		rr = append(rr, "env vars defined more than once "+o)
+	}
+	if _, ok := t.Extras[ExternalKey]; ok {
+		rr = append(rr, "target resolves outside the cluster")
+	}
+	if r, ok := t.Extras[EventReasonKey]; ok {
+		rr = append(rr, "last warning "+r+": "+t.Extras[EventMessageKey])
+	}
+
+	return strings.Join(rr, "; ")
+}
+
+func (t *TreeNode) explainToast() string {
+	if d, ok := t.Extras[DisruptionsKey]; ok && d == "0" {
+		return "disruption budget allows no disruptions"
+	}
+	if e := t.Extras[ExhaustedKey]; e != "" {
+		return "quota exhausted for " + e
+	}
+	if b, ok := t.Extras[BackoffLimitKey]; ok {
+		return "job failed " + t.Extras[FailedKey] + " times exceeding its backoff limit of " + b
+	}
+	info := t.Extras[InfoKey]
+	switch t.GVR {
+	case "v1/pods":
+		if info != "" {
+			return "containers not ready " + info
+		}
+	case "apps/v1/deployments", "apps/v1/replicasets", "apps/v1/statefulsets", "apps/v1/daemonsets":
+		if info != "" {
+			return "replicas not available " + info
+		}
+	case JobGVR:
+		return "job failed " + info
+	case "v1/persistentvolumeclaims":
+		if strings.Contains(info, "conflict") {
+			return "access mode conflict " + info
+		}
+		return "claim is not bound"
+	case "v1/namespaces":
+		return "namespace is terminating"
+	}
+
+	return "resource is not healthy"
+}
+
+func explainRestarts(ee map[string]string) string {
+	r := "restarted " + ee[RestartsKey] + " times, last " + ee[LastReasonKey] + " exit " + ee[ExitCodeKey]
+	if _, ok := ee[CrashLoopKey]; ok {
+		r += " backing off"
+	}
+
+	return r
+}
@@ -0,0 +1,132 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package xray_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/xray"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTreeNodeExplainStatus(t *testing.T) {
+	uu := map[string]struct {
+		gvr    string
+		extras map[string]string
+		e      string
+	}{
+		"ok": {
+			gvr:    "v1/pods",
+			extras: map[string]string{xray.StatusKey: xray.OkStatus},
+		},
+		"completed": {
+			gvr:    "v1/pods",
+			extras: map[string]string{xray.StatusKey: xray.CompletedStatus},
+		},
+		"toast-pod": {
+			gvr:    "v1/pods",
+			extras: map[string]string{xray.StatusKey: xray.ToastStatus, xray.InfoKey: "1/2"},
+			e:      "containers not ready 1/2",
+		},
+		"toast-deploy": {
+			gvr:    "apps/v1/deployments",
+			extras: map[string]string{xray.StatusKey: xray.ToastStatus, xray.InfoKey: "1/3/2"},
+			e:      "replicas not available 1/3/2",
+		},
+		"toast-pvc": {
+			gvr:    "v1/persistentvolumeclaims",
+			extras: map[string]string{xray.StatusKey: xray.ToastStatus, xray.InfoKey: "RWO"},
+			e:      "claim is not bound",
+		},
+		"toast-pvc-conflict": {
+			gvr:    "v1/persistentvolumeclaims",
+			extras: map[string]string{xray.StatusKey: xray.ToastStatus, xray.InfoKey: "RWO conflict(2 nodes)"},
+			e:      "access mode conflict RWO conflict(2 nodes)",
+		},
+		"toast-ns": {
+			gvr:    "v1/namespaces",
+			extras: map[string]string{xray.StatusKey: xray.ToastStatus},
+			e:      "namespace is terminating",
+		},
+		"toast-pdb": {
+			gvr:    xray.PDBGVR,
+			extras: map[string]string{xray.StatusKey: xray.ToastStatus, xray.DisruptionsKey: "0"},
+			e:      "disruption budget allows no disruptions",
+		},
+		"missing-binding": {
+			gvr:    xray.ValidatingPolicyBindingGVR,
+			extras: map[string]string{xray.StatusKey: xray.MissingRefStatus},
+			e:      "binding references a missing policy or params",
+		},
+		"toast-generic": {
+			gvr:    "v1/services",
+			extras: map[string]string{xray.StatusKey: xray.ToastStatus},
+			e:      "resource is not healthy",
+		},
+		"missing-ref": {
+			gvr:    "v1/secrets",
+			extras: map[string]string{xray.StatusKey: xray.MissingRefStatus},
+			e:      `referenced secrets "default/fred" does not exist`,
+		},
+		"terminating": {
+			gvr:    "v1/pods",
+			extras: map[string]string{xray.StatusKey: xray.TerminatingStatus},
+			e:      "pending deletion",
+		},
+		"stuck": {
+			gvr: "v1/pods",
+			extras: map[string]string{
+				xray.StatusKey:     xray.TerminatingStatus,
+				xray.StuckKey:      "10m",
+				xray.FinalizersKey: "blee,zorg",
+			},
+			e: "stuck terminating for 10m waiting on finalizers blee,zorg",
+		},
+		"no-readiness": {
+			gvr:    "containers",
+			extras: map[string]string{xray.StatusKey: xray.OkStatus, xray.NoReadinessKey: "true"},
+			e:      "no readiness probe defined",
+		},
+		"overcommit": {
+			gvr:    "v1/nodes",
+			extras: map[string]string{xray.StatusKey: xray.OkStatus, xray.OvercommitKey: "cpu,mem"},
+			e:      "pods requests exceed allocatable cpu,mem",
+		},
+		"no-pdb": {
+			gvr:    "v1/pods",
+			extras: map[string]string{xray.StatusKey: xray.OkStatus, xray.NoPDBKey: "true"},
+			e:      "not covered by any disruption budget",
+		},
+		"env-override": {
+			gvr:    "containers",
+			extras: map[string]string{xray.StatusKey: xray.OkStatus, xray.EnvOverrideKey: "FOO:configmap/cm1"},
+			e:      "env vars defined more than once FOO:configmap/cm1",
+		},
+		"external": {
+			gvr:    xray.AddressGVR,
+			extras: map[string]string{xray.StatusKey: xray.OkStatus, xray.ExternalKey: "true"},
+			e:      "target resolves outside the cluster",
+		},
+		"event": {
+			gvr: "v1/pods",
+			extras: map[string]string{
+				xray.StatusKey:       xray.ToastStatus,
+				xray.InfoKey:         "0/1",
+				xray.EventReasonKey:  "BackOff",
+				xray.EventMessageKey: "restarting failed container",
+			},
+			e: "containers not ready 0/1; last warning BackOff: restarting failed container",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			n := xray.NewTreeNode(u.gvr, "default/fred")
+			n.Extras = u.extras
+			assert.Equal(t, u.e, n.ExplainStatus())
+			assert.Equal(t, u.e, n.Spec().Reason)
+		})
+	}
+}
@@ -0,0 +1,57 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package xray
+
+import (
+	"encoding/csv"
+	"io"
+	"slices"
+	"strings"
+)
+
+// FlatNode represents a tree node as a table row.
+type FlatNode struct {
+	// Path lists the node ancestors IDs from the top most one.
+	Path []string
+
+	GVR, ID, Status string
+}
+
+// FlatNodes returns all the descendant nodes depth first. Unlike Flatten which
+// only returns leaves specs, every node is listed along with its path.
+func (t *TreeNode) FlatNodes() []FlatNode {
+	return t.flatNodes(nil)
+}
+
+func (t *TreeNode) flatNodes(path []string) []FlatNode {
+	nn := make([]FlatNode, 0, len(t.Children))
+	for _, c := range t.Children {
+		nn = append(nn, FlatNode{
+			Path:   slices.Clone(path),
+			GVR:    c.GVR,
+			ID:     c.ID,
+			Status: c.Extras[StatusKey],
+		})
+		nn = append(nn, c.flatNodes(append(slices.Clip(path), c.ID))...)
+	}
+
+	return nn
+}
+
+// WriteCSV writes out the tree descendants as CSV. Paths are joined by the
+// path separator.
+func (t *TreeNode) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write([]string{"PATH", "GVR", "ID", "STATUS"}); err != nil {
+		return err
+	}
+	for _, n := range t.FlatNodes() {
+		if err := cw.Write([]string{strings.Join(n.Path, PathSeparator), n.GVR, n.ID, n.Status}); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+
+	return cw.Error()
+}
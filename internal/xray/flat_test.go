@@ -0,0 +1,59 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package xray_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/derailed/k9s/internal/xray"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTreeNodeFlatNodes(t *testing.T) {
+	root := makeFlatTree()
+
+	assert.Equal(t, []xray.FlatNode{
+		{GVR: "v1/namespaces", ID: "-/default", Status: xray.OkStatus},
+		{Path: []string{"-/default"}, GVR: "apps/v1/deployments", ID: "default/dp1", Status: xray.ToastStatus},
+		{Path: []string{"-/default", "default/dp1"}, GVR: "v1/pods", ID: "default/p1", Status: xray.ToastStatus},
+		{Path: []string{"-/default", "default/dp1"}, GVR: "v1/configmaps", ID: "default/cm,1", Status: xray.MissingRefStatus},
+		{Path: []string{"-/default"}, GVR: "v1/services", ID: "default/svc1", Status: xray.OkStatus},
+	}, root.FlatNodes())
+	assert.Empty(t, xray.NewTreeNode("root", "root").FlatNodes())
+}
+
+func TestTreeNodeWriteCSV(t *testing.T) {
+	var buff bytes.Buffer
+	require.NoError(t, makeFlatTree().WriteCSV(&buff))
+
+	assert.Equal(t, `PATH,GVR,ID,STATUS
+,v1/namespaces,-/default,ok
+-/default,apps/v1/deployments,default/dp1,toast
+-/default::default/dp1,v1/pods,default/p1,toast
+-/default::default/dp1,v1/configmaps,"default/cm,1",noref
+-/default,v1/services,default/svc1,ok
+`, buff.String())
+}
+
+// Helpers...
+
+func makeFlatTree() *xray.TreeNode {
+	root := xray.NewTreeNode("root", "root")
+	ns := xray.NewTreeNode("v1/namespaces", "-/default")
+	dp := xray.NewTreeNode("apps/v1/deployments", "default/dp1")
+	dp.Extras[xray.StatusKey] = xray.ToastStatus
+	po := xray.NewTreeNode("v1/pods", "default/p1")
+	po.Extras[xray.StatusKey] = xray.ToastStatus
+	cm := xray.NewTreeNode("v1/configmaps", "default/cm,1")
+	cm.Extras[xray.StatusKey] = xray.MissingRefStatus
+	dp.Add(po)
+	dp.Add(cm)
+	ns.Add(dp)
+	ns.Add(xray.NewTreeNode("v1/services", "default/svc1"))
+	root.Add(ns)
+
+	return root
+}
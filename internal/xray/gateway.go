@@ -0,0 +1,301 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package xray
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/rs/zerolog/log"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	// GatewayGVR represents a Gateway API gateway resource.
+	GatewayGVR = "gateway.networking.k8s.io/v1/gateways"
+
+	// GatewayClassGVR represents a Gateway API gateway class resource.
+	GatewayClassGVR = "gateway.networking.k8s.io/v1/gatewayclasses"
+
+	// HTTPRouteGVR represents a Gateway API http route resource.
+	HTTPRouteGVR = "gateway.networking.k8s.io/v1/httproutes"
+
+	// ListenerGVR represents a gateway listener.
+	ListenerGVR = "listeners"
+
+	gatewayGroup = "gateway.networking.k8s.io"
+)
+
+// Gateway represents an xray renderer.
+type Gateway struct{}
+
+// Render renders an xray node.
+func (g *Gateway) Render(ctx context.Context, ns string, o interface{}) error {
+	var gw gateway
+	if err := fromUnstructured(o, &gw); err != nil {
+		return err
+	}
+	f, ok := ctx.Value(internal.KeyFactory).(dao.Factory)
+	if !ok {
+		return fmt.Errorf("no factory found in context")
+	}
+	parent, ok := ctx.Value(KeyParent).(*TreeNode)
+	if !ok {
+		return fmt.Errorf("Expecting a TreeNode but got %T", ctx.Value(KeyParent))
+	}
+
+	root := NewTreeNode(GatewayGVR, client.FQN(gw.Namespace, gw.Name))
+	root.Extras[StatusKey] = OkStatus
+	if gw.Spec.GatewayClassName != "" {
+		addRef(ctx, f, root, GatewayClassGVR, client.FQN(client.ClusterScope, gw.Spec.GatewayClassName), nil)
+	}
+	if err := g.listenerRefs(ctx, ns, f, root, gw); err != nil {
+		return err
+	}
+	addToNamespace(parent, root, gw.Namespace)
+
+	return nil
+}
+
+// listenerRefs adds the gateway listeners and their attached routes. Routes
+// attaching to an unknown listener are flagged as dangling.
+func (*Gateway) listenerRefs(ctx context.Context, ns string, f dao.Factory, root *TreeNode, gw gateway) error {
+	ll := make(map[string]*TreeNode, len(gw.Spec.Listeners))
+	for _, l := range gw.Spec.Listeners {
+		n := NewTreeNode(ListenerGVR, client.FQN(gw.Namespace, l.Name))
+		n.Extras[StatusKey] = OkStatus
+		n.Extras[InfoKey] = fmt.Sprintf("%s:%d", l.Protocol, l.Port)
+		if l.Hostname != "" {
+			n.Extras[InfoKey] += " " + l.Hostname
+		}
+		ll[l.Name] = n
+		root.Add(n)
+	}
+
+	oo, err := f.List(HTTPRouteGVR, client.BlankNamespace, false, labels.Everything())
+	if err != nil {
+		log.Warn().Err(err).Msgf("Unable to list http routes")
+		return nil
+	}
+	for _, o := range oo {
+		var r httpRoute
+		if err := fromUnstructured(o, &r); err != nil {
+			return err
+		}
+		for _, p := range r.Spec.ParentRefs {
+			if !p.isGateway() || p.namespace(r.Namespace) != gw.Namespace || p.Name != gw.Name {
+				continue
+			}
+			var nn []*TreeNode
+			if p.SectionName == "" {
+				for _, l := range gw.Spec.Listeners {
+					nn = append(nn, ll[l.Name])
+				}
+			} else if n, ok := ll[p.SectionName]; ok {
+				nn = append(nn, n)
+			} else {
+				n := NewTreeNode(ListenerGVR, client.FQN(gw.Namespace, p.SectionName))
+				n.Extras[StatusKey] = MissingRefStatus
+				ll[p.SectionName] = n
+				root.Add(n)
+				nn = append(nn, n)
+			}
+			for _, n := range nn {
+				if n.Find(HTTPRouteGVR, client.FQN(r.Namespace, r.Name)) != nil {
+					continue
+				}
+				rn := NewTreeNode(HTTPRouteGVR, client.FQN(r.Namespace, r.Name))
+				rn.Extras[StatusKey] = OkStatus
+				if err := backendRefs(ctx, ns, f, rn, r); err != nil {
+					return err
+				}
+				n.Add(rn)
+			}
+		}
+	}
+
+	return nil
+}
+
+// HTTPRoute represents an xray renderer.
+type HTTPRoute struct{}
+
+// Render renders an xray node.
+func (h *HTTPRoute) Render(ctx context.Context, ns string, o interface{}) error {
+	var r httpRoute
+	if err := fromUnstructured(o, &r); err != nil {
+		return err
+	}
+	f, ok := ctx.Value(internal.KeyFactory).(dao.Factory)
+	if !ok {
+		return fmt.Errorf("no factory found in context")
+	}
+	parent, ok := ctx.Value(KeyParent).(*TreeNode)
+	if !ok {
+		return fmt.Errorf("Expecting a TreeNode but got %T", ctx.Value(KeyParent))
+	}
+
+	root := NewTreeNode(HTTPRouteGVR, client.FQN(r.Namespace, r.Name))
+	root.Extras[StatusKey] = OkStatus
+	if len(r.Spec.Hostnames) > 0 {
+		root.Extras[InfoKey] = strings.Join(r.Spec.Hostnames, ",")
+	}
+	h.parentRefs(ctx, f, root, r)
+	if err := backendRefs(ctx, ns, f, root, r); err != nil {
+		return err
+	}
+	addToNamespace(parent, root, r.Namespace)
+
+	return nil
+}
+
+// parentRefs adds the gateways a route attaches to.
+func (*HTTPRoute) parentRefs(ctx context.Context, f dao.Factory, root *TreeNode, r httpRoute) {
+	for _, p := range r.Spec.ParentRefs {
+		if !p.isGateway() {
+			continue
+		}
+		id := client.FQN(p.namespace(r.Namespace), p.Name)
+		if root.Find(GatewayGVR, id) != nil {
+			continue
+		}
+		n := NewTreeNode(GatewayGVR, id)
+		validate(ctx, f, n, nil)
+		if p.SectionName != "" {
+			n.Extras[InfoKey] = "listener:" + p.SectionName
+		}
+		root.Add(n)
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+type gateway struct {
+	metav1.ObjectMeta `json:"metadata"`
+	Spec              struct {
+		GatewayClassName string `json:"gatewayClassName"`
+		Listeners        []struct {
+			Name     string `json:"name"`
+			Hostname string `json:"hostname"`
+			Protocol string `json:"protocol"`
+			Port     int32  `json:"port"`
+		} `json:"listeners"`
+	} `json:"spec"`
+}
+
+type httpRoute struct {
+	metav1.ObjectMeta `json:"metadata"`
+	Spec              struct {
+		ParentRefs []routeRef `json:"parentRefs"`
+		Hostnames  []string   `json:"hostnames"`
+		Rules      []struct {
+			BackendRefs []routeRef `json:"backendRefs"`
+		} `json:"rules"`
+	} `json:"spec"`
+}
+
+// routeRef represents a route parent or backend reference.
+type routeRef struct {
+	Group       *string `json:"group"`
+	Kind        *string `json:"kind"`
+	Namespace   string  `json:"namespace"`
+	Name        string  `json:"name"`
+	SectionName string  `json:"sectionName"`
+	Port        int32   `json:"port"`
+}
+
+func (r routeRef) namespace(ns string) string {
+	if r.Namespace != "" {
+		return r.Namespace
+	}
+
+	return ns
+}
+
+func (r routeRef) is(group, kind string) bool {
+	g, k := group, kind
+	if r.Group != nil {
+		g = *r.Group
+	}
+	if r.Kind != nil {
+		k = *r.Kind
+	}
+
+	return g == group && k == kind
+}
+
+func (r routeRef) isGateway() bool {
+	return r.is(gatewayGroup, "Gateway")
+}
+
+// backendRefs adds the services a route forwards traffic to along with
+// their pods. Dangling services are flagged as missing.
+func backendRefs(ctx context.Context, ns string, f dao.Factory, root *TreeNode, r httpRoute) error {
+	for _, rule := range r.Spec.Rules {
+		for _, b := range rule.BackendRefs {
+			if !b.is("", "Service") {
+				continue
+			}
+			id := client.FQN(b.namespace(r.Namespace), b.Name)
+			if root.Find("v1/services", id) != nil {
+				continue
+			}
+			n := NewTreeNode("v1/services", id)
+			validate(ctx, f, n, nil)
+			if b.Port > 0 {
+				n.Extras[InfoKey] = fmt.Sprintf("port:%d", b.Port)
+			}
+			root.Add(n)
+			if n.Extras[StatusKey] == MissingRefStatus {
+				continue
+			}
+			if err := servicePods(ctx, ns, f, n); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// servicePods adds the pods selected by a given service node.
+func servicePods(ctx context.Context, ns string, f dao.Factory, n *TreeNode) error {
+	o, err := f.Get("v1/services", n.ID, true, labels.Everything())
+	if err != nil || o == nil {
+		return nil
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("expecting *Unstructured but got %T", o)
+	}
+	var svc v1.Service
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &svc); err != nil {
+		return err
+	}
+	if len(svc.Spec.Selector) == 0 {
+		return nil
+	}
+
+	return (&Service{}).podRefs(ctx, ns, n, svc)
+}
+
+// addToNamespace adds a node under its namespace node.
+func addToNamespace(parent, n *TreeNode, ns string) {
+	gvr, nsID := "v1/namespaces", client.FQN(client.ClusterScope, ns)
+	nsn := parent.Find(gvr, nsID)
+	if nsn == nil {
+		nsn = NewTreeNode(gvr, nsID)
+		parent.Add(nsn)
+	}
+	nsn.Add(n)
+}
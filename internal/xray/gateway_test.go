@@ -0,0 +1,170 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package xray_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/xray"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestHTTPRouteRender(t *testing.T) {
+	f := makeFactory()
+	f.rows = map[string][]runtime.Object{
+		"v1/services": {load(t, "svc")},
+		"v1/pods":     {load(t, "po")},
+	}
+	o := makeHTTPRoute("r1", "default", map[string]interface{}{
+		"hostnames": []interface{}{"a.example.com"},
+		"parentRefs": []interface{}{
+			map[string]interface{}{"name": "gw1", "sectionName": "http"},
+			map[string]interface{}{"name": "gw1", "sectionName": "http"},
+			map[string]interface{}{"name": "mesh", "kind": "Service", "group": ""},
+		},
+		"rules": []interface{}{
+			map[string]interface{}{
+				"backendRefs": []interface{}{
+					map[string]interface{}{"name": "nginx", "port": int64(80)},
+					map[string]interface{}{"name": "nginx", "port": int64(80)},
+					map[string]interface{}{"name": "fred", "kind": "ServiceImport", "group": "multicluster.x-k8s.io"},
+				},
+			},
+		},
+	})
+
+	root := xray.NewTreeNode("httproutes", "httproutes")
+	ctx := context.WithValue(context.Background(), xray.KeyParent, root)
+	ctx = context.WithValue(ctx, internal.KeyFactory, f)
+
+	var re xray.HTTPRoute
+	require.NoError(t, re.Render(ctx, "", o))
+	n := root.Find(xray.HTTPRouteGVR, "default/r1")
+	require.NotNil(t, n)
+	assert.Equal(t, "a.example.com", n.Extras[xray.InfoKey])
+	assert.Equal(t, 2, n.CountChildren())
+
+	gw := n.Find(xray.GatewayGVR, "default/gw1")
+	require.NotNil(t, gw)
+	assert.Equal(t, xray.MissingRefStatus, gw.Extras[xray.StatusKey])
+	assert.Equal(t, "listener:http", gw.Extras[xray.InfoKey])
+
+	svc := n.Find("v1/services", "default/nginx")
+	require.NotNil(t, svc)
+	assert.Equal(t, xray.OkStatus, svc.Extras[xray.StatusKey])
+	assert.Equal(t, "port:80", svc.Extras[xray.InfoKey])
+	assert.Equal(t, 1, svc.Count("v1/pods"))
+}
+
+func TestGatewayRender(t *testing.T) {
+	f := makeFactory()
+	f.rows = map[string][]runtime.Object{
+		xray.HTTPRouteGVR: {
+			makeHTTPRoute("r1", "default", map[string]interface{}{
+				"parentRefs": []interface{}{
+					map[string]interface{}{"name": "gw1", "sectionName": "http"},
+				},
+			}),
+			makeHTTPRoute("r2", "default", map[string]interface{}{
+				"parentRefs": []interface{}{
+					map[string]interface{}{"name": "gw1"},
+				},
+			}),
+			makeHTTPRoute("r3", "fred", map[string]interface{}{
+				"parentRefs": []interface{}{
+					map[string]interface{}{"name": "gw1", "namespace": "default", "sectionName": "grpc"},
+				},
+				"rules": []interface{}{
+					map[string]interface{}{
+						"backendRefs": []interface{}{
+							map[string]interface{}{"name": "nginx"},
+						},
+					},
+				},
+			}),
+			makeHTTPRoute("r4", "default", map[string]interface{}{
+				"parentRefs": []interface{}{
+					map[string]interface{}{"name": "gw2"},
+				},
+			}),
+		},
+	}
+	o := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "gateway.networking.k8s.io/v1",
+		"kind":       "Gateway",
+		"metadata":   map[string]interface{}{"name": "gw1", "namespace": "default"},
+		"spec": map[string]interface{}{
+			"gatewayClassName": "blee",
+			"listeners": []interface{}{
+				map[string]interface{}{"name": "http", "protocol": "HTTP", "port": int64(80)},
+				map[string]interface{}{"name": "https", "protocol": "HTTPS", "port": int64(443), "hostname": "*.example.com"},
+			},
+		},
+	}}
+
+	root := xray.NewTreeNode("gateways", "gateways")
+	ctx := context.WithValue(context.Background(), xray.KeyParent, root)
+	ctx = context.WithValue(ctx, internal.KeyFactory, f)
+
+	var re xray.Gateway
+	require.NoError(t, re.Render(ctx, "", o))
+	gw := root.Find(xray.GatewayGVR, "default/gw1")
+	require.NotNil(t, gw)
+	assert.Equal(t, 4, gw.CountChildren())
+	assert.Equal(t, xray.MissingRefStatus, gw.Find(xray.GatewayClassGVR, "-/blee").Extras[xray.StatusKey])
+
+	uu := map[string]struct {
+		listener, status, info string
+		routes                 []string
+	}{
+		"http": {
+			listener: "default/http",
+			status:   xray.OkStatus,
+			info:     "HTTP:80",
+			routes:   []string{"default/r1", "default/r2"},
+		},
+		"https": {
+			listener: "default/https",
+			status:   xray.OkStatus,
+			info:     "HTTPS:443 *.example.com",
+			routes:   []string{"default/r2"},
+		},
+		"dangling": {
+			listener: "default/grpc",
+			status:   xray.MissingRefStatus,
+			routes:   []string{"fred/r3"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			l := gw.Find(xray.ListenerGVR, u.listener)
+			require.NotNil(t, l)
+			assert.Equal(t, u.status, l.Extras[xray.StatusKey])
+			assert.Equal(t, u.info, l.Extras[xray.InfoKey])
+			assert.Equal(t, len(u.routes), l.CountChildren())
+			for _, r := range u.routes {
+				assert.NotNil(t, l.Find(xray.HTTPRouteGVR, r))
+			}
+		})
+	}
+	assert.Equal(t, xray.MissingRefStatus, gw.Find("v1/services", "fred/nginx").Extras[xray.StatusKey])
+}
+
+// Helpers...
+
+func makeHTTPRoute(n, ns string, spec map[string]interface{}) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "gateway.networking.k8s.io/v1",
+		"kind":       "HTTPRoute",
+		"metadata":   map[string]interface{}{"name": n, "namespace": ns},
+		"spec":       spec,
+	}}
+}
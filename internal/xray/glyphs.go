@@ -0,0 +1,57 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package xray
+
+import "maps"
+
+// Glyphs maps nodes statuses to the glyphs rendering them.
+type Glyphs map[string]string
+
+var (
+	defaultGlyphs = Glyphs{
+		OkStatus:          "✔",
+		ToastStatus:       "✘",
+		MissingRefStatus:  "?",
+		CompletedStatus:   "●",
+		TerminatingStatus: "⧗",
+		ExpiringStatus:    "⏳",
+	}
+
+	asciiGlyphs = Glyphs{
+		OkStatus:          "+",
+		ToastStatus:       "x",
+		MissingRefStatus:  "?",
+		CompletedStatus:   "o",
+		TerminatingStatus: "~",
+		ExpiringStatus:    "!",
+	}
+)
+
+// NewGlyphs returns the default or ascii only glyphs with the given overrides.
+func NewGlyphs(ascii bool, overrides map[string]string) Glyphs {
+	base := defaultGlyphs
+	if ascii {
+		base = asciiGlyphs
+	}
+	gg := maps.Clone(base)
+	for k, v := range overrides {
+		if v != "" {
+			gg[k] = v
+		}
+	}
+
+	return gg
+}
+
+// Glyph returns the glyph for a given status. Unknown statuses render as ok.
+func (g Glyphs) Glyph(status string) string {
+	if _, ok := defaultGlyphs[status]; !ok {
+		status = OkStatus
+	}
+	if s, ok := g[status]; ok {
+		return s
+	}
+
+	return defaultGlyphs[status]
+}
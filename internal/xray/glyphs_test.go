@@ -0,0 +1,69 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package xray_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/xray"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGlyphsGlyph(t *testing.T) {
+	uu := map[string]struct {
+		gg     xray.Glyphs
+		status string
+		e      string
+	}{
+		"nil": {
+			status: xray.ToastStatus,
+			e:      "✘",
+		},
+		"default": {
+			gg:     xray.NewGlyphs(false, nil),
+			status: xray.TerminatingStatus,
+			e:      "⧗",
+		},
+		"ascii": {
+			gg:     xray.NewGlyphs(true, nil),
+			status: xray.ToastStatus,
+			e:      "x",
+		},
+		"override": {
+			gg:     xray.NewGlyphs(true, map[string]string{xray.ToastStatus: "!!"}),
+			status: xray.ToastStatus,
+			e:      "!!",
+		},
+		"blank-override": {
+			gg:     xray.NewGlyphs(true, map[string]string{xray.ToastStatus: ""}),
+			status: xray.ToastStatus,
+			e:      "x",
+		},
+		"unknown": {
+			gg:     xray.NewGlyphs(true, nil),
+			status: "fred",
+			e:      "+",
+		},
+		"no-status": {
+			gg: xray.NewGlyphs(false, nil),
+			e:  "✔",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, u.gg.Glyph(u.status))
+		})
+	}
+}
+
+func TestTreeNodeTitleGlyphs(t *testing.T) {
+	n := xray.NewTreeNode("v1/secrets", "default/s1")
+	n.Extras[xray.StatusKey] = xray.MissingRefStatus
+
+	assert.Contains(t, n.Title(true, nil), "]TOAST_REF[")
+	assert.Contains(t, n.Title(true, xray.NewGlyphs(true, map[string]string{xray.MissingRefStatus: "??"})), "]?? TOAST_REF[")
+	assert.Contains(t, n.Title(false, xray.NewGlyphs(true, nil)), "]? TOAST_REF[")
+}
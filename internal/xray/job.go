@@ -0,0 +1,131 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package xray
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// JobGVR represents a batch job.
+const JobGVR = "batch/v1/jobs"
+
+// Job represents an xray renderer.
+type Job struct{}
+
+// Render renders an xray node.
+func (j *Job) Render(ctx context.Context, ns string, o interface{}) error {
+	raw, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("expected Unstructured, but got %T", o)
+	}
+	var job batchv1.Job
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw.Object, &job)
+	if err != nil {
+		return err
+	}
+
+	parent, ok := ctx.Value(KeyParent).(*TreeNode)
+	if !ok {
+		return fmt.Errorf("Expecting a TreeNode but got %T", ctx.Value(KeyParent))
+	}
+	f, ok := ctx.Value(internal.KeyFactory).(dao.Factory)
+	if !ok {
+		return fmt.Errorf("Expecting a factory but got %T", ctx.Value(internal.KeyFactory))
+	}
+
+	root := NewTreeNode(JobGVR, client.FQN(job.Namespace, job.Name))
+	ownerRefs(ctx, f, root, job.Namespace, job.OwnerReferences)
+
+	var oo []runtime.Object
+	if job.Spec.Selector != nil {
+		if oo, err = locatePods(ctx, job.Namespace, job.Spec.Selector); err != nil {
+			return err
+		}
+	}
+	pctx := context.WithValue(ctx, KeyParent, root)
+	var re Pod
+	for _, o := range oo {
+		p, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return fmt.Errorf("expecting *Unstructured but got %T", o)
+		}
+		if err := re.Render(pctx, ns, &render.PodWithMetrics{Raw: p}); err != nil {
+			return err
+		}
+	}
+
+	gvr, nsID := "v1/namespaces", client.FQN(client.ClusterScope, job.Namespace)
+	nsn := parent.Find(gvr, nsID)
+	if nsn == nil {
+		nsn = NewTreeNode(gvr, nsID)
+		parent.Add(nsn)
+	}
+	nsn.Add(root)
+
+	return j.validate(root, job)
+}
+
+// validate annotates a job node with its pods counts. Jobs failed for
+// exceeding their backoff limit are flagged.
+func (*Job) validate(root *TreeNode, job batchv1.Job) error {
+	var completions, parallelism int32 = 1, 1
+	if job.Spec.Completions != nil {
+		completions = *job.Spec.Completions
+	}
+	if job.Spec.Parallelism != nil {
+		parallelism = *job.Spec.Parallelism
+	}
+	backoff := int32(6)
+	if job.Spec.BackoffLimit != nil {
+		backoff = *job.Spec.BackoffLimit
+	}
+
+	st := job.Status
+	root.Extras[ActiveKey] = strconv.Itoa(int(st.Active))
+	root.Extras[SucceededKey] = strconv.Itoa(int(st.Succeeded))
+	root.Extras[FailedKey] = strconv.Itoa(int(st.Failed))
+	root.Extras[InfoKey] = fmt.Sprintf("completions:%d/%d,parallelism:%d,active:%d,failed:%d/%d",
+		st.Succeeded, completions, parallelism, st.Active, st.Failed, backoff)
+
+	root.Extras[StatusKey] = OkStatus
+	if jobCondition(job, batchv1.JobComplete) != nil {
+		root.Extras[StatusKey] = CompletedStatus
+	}
+	c := jobCondition(job, batchv1.JobFailed)
+	if c != nil {
+		root.Extras[StatusKey] = ToastStatus
+	}
+	if (c != nil && c.Reason == batchv1.JobReasonBackoffLimitExceeded) || st.Failed > backoff {
+		root.Extras[StatusKey] = ToastStatus
+		root.Extras[BackoffLimitKey] = strconv.Itoa(int(backoff))
+	}
+	terminating(root, &job)
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// jobCondition returns a job condition of a given type if set.
+func jobCondition(job batchv1.Job, t batchv1.JobConditionType) *batchv1.JobCondition {
+	for i, c := range job.Status.Conditions {
+		if c.Type == t && c.Status == v1.ConditionTrue {
+			return &job.Status.Conditions[i]
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,157 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package xray_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/xray"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestJobRender(t *testing.T) {
+	uu := map[string]struct {
+		status     batchv1.JobStatus
+		e, info    string
+		backoff    bool
+		titleState string
+	}{
+		"running": {
+			status: batchv1.JobStatus{Active: 2, Failed: 1},
+			e:      xray.OkStatus,
+			info:   "completions:0/3,parallelism:2,active:2,failed:1/2",
+		},
+		"complete": {
+			status: batchv1.JobStatus{Succeeded: 3, Conditions: []batchv1.JobCondition{
+				{Type: batchv1.JobComplete, Status: v1.ConditionTrue},
+			}},
+			e:    xray.CompletedStatus,
+			info: "completions:3/3,parallelism:2,active:0,failed:0/2",
+		},
+		"deadline": {
+			status: batchv1.JobStatus{Failed: 1, Conditions: []batchv1.JobCondition{
+				{Type: batchv1.JobFailed, Status: v1.ConditionTrue, Reason: batchv1.JobReasonDeadlineExceeded},
+			}},
+			e:          xray.ToastStatus,
+			info:       "completions:0/3,parallelism:2,active:0,failed:1/2",
+			titleState: "TOAST",
+		},
+		"backoff": {
+			status: batchv1.JobStatus{Failed: 3, Conditions: []batchv1.JobCondition{
+				{Type: batchv1.JobFailed, Status: v1.ConditionTrue, Reason: batchv1.JobReasonBackoffLimitExceeded},
+			}},
+			e:          xray.ToastStatus,
+			info:       "completions:0/3,parallelism:2,active:0,failed:3/2",
+			backoff:    true,
+			titleState: "BACKOFF_LIMIT",
+		},
+	}
+
+	var re xray.Job
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			f := makeFactory()
+			f.rows = map[string][]runtime.Object{
+				"v1/pods":           {makeJobPod(t)},
+				"batch/v1/cronjobs": {makeCronJob(t)},
+			}
+			root := xray.NewTreeNode("jobs", "jobs")
+			ctx := context.WithValue(context.Background(), xray.KeyParent, root)
+			ctx = context.WithValue(ctx, internal.KeyFactory, f)
+
+			require.NoError(t, re.Render(ctx, "", toUnstructured(t, makeJob(u.status))))
+			n := root.Find(xray.JobGVR, "default/j1")
+			require.NotNil(t, n)
+			assert.Equal(t, u.e, n.Extras[xray.StatusKey])
+			assert.Equal(t, u.info, n.Extras[xray.InfoKey])
+			_, ok := n.Extras[xray.BackoffLimitKey]
+			assert.Equal(t, u.backoff, ok)
+			if u.titleState != "" {
+				assert.Contains(t, n.Title(true, nil), u.titleState)
+			}
+			if u.backoff {
+				assert.Equal(t, "job failed 3 times exceeding its backoff limit of 2", n.ExplainStatus())
+			}
+
+			cj := n.Find("batch/v1/cronjobs", "default/cj1")
+			require.NotNil(t, cj)
+			assert.Equal(t, "CronJob", cj.Extras[xray.GenericOwnerKey])
+			assert.Equal(t, xray.OkStatus, cj.Extras[xray.StatusKey])
+
+			po := n.Find("v1/pods", "default/j1-x")
+			require.NotNil(t, po)
+			assert.Nil(t, po.Find(xray.JobGVR, "default/j1"))
+		})
+	}
+}
+
+func TestJobRenderNoPods(t *testing.T) {
+	job := makeJob(batchv1.JobStatus{Failed: 3, Conditions: []batchv1.JobCondition{
+		{Type: batchv1.JobFailed, Status: v1.ConditionTrue, Reason: batchv1.JobReasonBackoffLimitExceeded},
+	}})
+	job.OwnerReferences = nil
+	root := xray.NewTreeNode("jobs", "jobs")
+	ctx := context.WithValue(context.Background(), xray.KeyParent, root)
+	ctx = context.WithValue(ctx, internal.KeyFactory, makeFactory())
+
+	var re xray.Job
+	require.NoError(t, re.Render(ctx, "", toUnstructured(t, job)))
+	n := root.Find(xray.JobGVR, "default/j1")
+	require.NotNil(t, n)
+	assert.True(t, n.IsLeaf())
+	assert.Equal(t, xray.ToastStatus, n.Extras[xray.StatusKey])
+	assert.Equal(t, "2", n.Extras[xray.BackoffLimitKey])
+}
+
+// Helpers...
+
+func makeJob(st batchv1.JobStatus) *batchv1.Job {
+	var completions, parallelism, backoff int32 = 3, 2, 2
+	return &batchv1.Job{
+		TypeMeta: metav1.TypeMeta{APIVersion: "batch/v1", Kind: "Job"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "j1",
+			Namespace: "default",
+			OwnerReferences: []metav1.OwnerReference{
+				{APIVersion: "batch/v1", Kind: "CronJob", Name: "cj1"},
+			},
+		},
+		Spec: batchv1.JobSpec{
+			Completions:  &completions,
+			Parallelism:  &parallelism,
+			BackoffLimit: &backoff,
+			Selector:     &metav1.LabelSelector{MatchLabels: map[string]string{"job-name": "j1"}},
+		},
+		Status: st,
+	}
+}
+
+func makeJobPod(t *testing.T) runtime.Object {
+	return toUnstructured(t, &v1.Pod{
+		TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "Pod"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "j1-x",
+			Namespace: "default",
+			Labels:    map[string]string{"job-name": "j1"},
+			OwnerReferences: []metav1.OwnerReference{
+				{APIVersion: "batch/v1", Kind: "Job", Name: "j1"},
+			},
+		},
+	})
+}
+
+func makeCronJob(t *testing.T) runtime.Object {
+	return toUnstructured(t, &batchv1.CronJob{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "batch/v1", Kind: "CronJob"},
+		ObjectMeta: metav1.ObjectMeta{Name: "cj1", Namespace: "default"},
+	})
+}
@@ -0,0 +1,174 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package xray
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// Node represents an xray renderer.
+type Node struct{}
+
+// Render renders an xray node.
+func (n *Node) Render(ctx context.Context, ns string, o interface{}) error {
+	var raw *unstructured.Unstructured
+	switch t := o.(type) {
+	case *render.NodeWithMetrics:
+		raw = t.Raw
+	case *unstructured.Unstructured:
+		raw = t
+	default:
+		return fmt.Errorf("expected NodeWithMetrics, but got %T", o)
+	}
+
+	var no v1.Node
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw.Object, &no)
+	if err != nil {
+		return err
+	}
+
+	f, ok := ctx.Value(internal.KeyFactory).(dao.Factory)
+	if !ok {
+		return fmt.Errorf("no factory found in context")
+	}
+	parent, ok := ctx.Value(KeyParent).(*TreeNode)
+	if !ok {
+		return fmt.Errorf("Expecting a TreeNode but got %T", ctx.Value(KeyParent))
+	}
+
+	root := NewTreeNode("v1/nodes", client.FQN(client.ClusterScope, no.Name))
+	pp, err := n.locatePods(f, no.Name)
+	if err != nil {
+		return err
+	}
+	ctx = context.WithValue(ctx, KeyParent, root)
+	var re Pod
+	for _, p := range pp {
+		if err := re.Render(ctx, ns, &render.PodWithMetrics{Raw: p}); err != nil {
+			return err
+		}
+	}
+	parent.Add(root)
+
+	return n.validate(root, no, pp)
+}
+
+func (*Node) locatePods(f dao.Factory, name string) ([]*unstructured.Unstructured, error) {
+	oo, err := f.List("v1/pods", client.BlankNamespace, false, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	pp := make([]*unstructured.Unstructured, 0, len(oo))
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return nil, fmt.Errorf("expecting *Unstructured but got %T", o)
+		}
+		if node, _, _ := unstructured.NestedString(u.Object, "spec", "nodeName"); node == name {
+			pp = append(pp, u)
+		}
+	}
+
+	return pp, nil
+}
+
+// validate rolls up the node pods requests against the node allocatable.
+func (*Node) validate(root *TreeNode, no v1.Node, pp []*unstructured.Unstructured) error {
+	reqs := make(v1.ResourceList, 2)
+	for _, p := range pp {
+		if err := addPodRequests(reqs, p); err != nil {
+			return err
+		}
+	}
+
+	root.Extras[StatusKey] = OkStatus
+	var (
+		info []string
+		over []string
+	)
+	for _, r := range []struct {
+		name v1.ResourceName
+		key  string
+	}{
+		{name: v1.ResourceCPU, key: "cpu"},
+		{name: v1.ResourceMemory, key: "mem"},
+	} {
+		req, alloc := reqs[r.name], no.Status.Allocatable[r.name]
+		if alloc.IsZero() {
+			continue
+		}
+		pc := req.MilliValue() * 100 / alloc.MilliValue()
+		info = append(info, fmt.Sprintf("%s:%d%%", r.key, pc))
+		if pc > 100 {
+			over = append(over, r.key)
+		}
+	}
+	if len(info) > 0 {
+		root.Extras[InfoKey] = strings.Join(info, ",")
+	}
+	if len(over) > 0 {
+		root.Extras[OvercommitKey] = strings.Join(over, ",")
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// addPodRequests adds a running pod effective requests to the given totals.
+func addPodRequests(reqs v1.ResourceList, u *unstructured.Unstructured) error {
+	var po v1.Pod
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &po); err != nil {
+		return err
+	}
+	if po.Status.Phase == v1.PodSucceeded || po.Status.Phase == v1.PodFailed {
+		return nil
+	}
+	for n, q := range podRequests(po.Spec) {
+		acc := reqs[n]
+		acc.Add(q)
+		reqs[n] = acc
+	}
+
+	return nil
+}
+
+// podRequests computes a pod effective cpu and memory requests ie the max of
+// the containers requests sum and any of the init containers requests.
+func podRequests(spec v1.PodSpec) v1.ResourceList {
+	rr := make(v1.ResourceList, 2)
+	for _, n := range []v1.ResourceName{v1.ResourceCPU, v1.ResourceMemory} {
+		var sum resource.Quantity
+		for _, co := range spec.Containers {
+			if q, ok := co.Resources.Requests[n]; ok {
+				sum.Add(q)
+			}
+		}
+		for _, co := range spec.InitContainers {
+			if q, ok := co.Resources.Requests[n]; ok && q.Cmp(sum) > 0 {
+				sum = q.DeepCopy()
+			}
+		}
+		if q, ok := spec.Overhead[n]; ok {
+			sum.Add(q)
+		}
+		rr[n] = sum
+	}
+
+	return rr
+}
@@ -0,0 +1,79 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package xray_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/xray"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestNodeRender(t *testing.T) {
+	uu := map[string]struct {
+		node, cpu, mem, info, over string
+		count                      int
+	}{
+		"plenty": {
+			node:  "minikube",
+			cpu:   "1",
+			mem:   "1Gi",
+			info:  "cpu:20%,mem:16%",
+			count: 13,
+		},
+		"overcommit": {
+			node:  "minikube",
+			cpu:   "150m",
+			mem:   "1Gi",
+			info:  "cpu:133%,mem:16%",
+			over:  "cpu",
+			count: 13,
+		},
+		"no-pods": {
+			node:  "fred",
+			cpu:   "1",
+			mem:   "1Gi",
+			info:  "cpu:0%,mem:0%",
+			count: 2,
+		},
+	}
+
+	var re xray.Node
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			f := makeFactory()
+			f.rows = map[string][]runtime.Object{
+				"v1/pods": {load(t, "po"), load(t, "init")},
+			}
+			no := toUnstructured(t, &v1.Node{
+				ObjectMeta: metav1.ObjectMeta{Name: u.node},
+				Status: v1.NodeStatus{
+					Allocatable: v1.ResourceList{
+						v1.ResourceCPU:    resource.MustParse(u.cpu),
+						v1.ResourceMemory: resource.MustParse(u.mem),
+					},
+				},
+			})
+			root := xray.NewTreeNode("nodes", "nodes")
+			ctx := context.WithValue(context.Background(), xray.KeyParent, root)
+			ctx = context.WithValue(ctx, internal.KeyFactory, f)
+
+			assert.Nil(t, re.Render(ctx, "", &render.NodeWithMetrics{Raw: no}))
+			n := root.Find("v1/nodes", "-/"+u.node)
+			require.NotNil(t, n)
+			assert.Equal(t, u.count, root.Count(""))
+			assert.Equal(t, u.info, n.Extras[xray.InfoKey])
+			assert.Equal(t, u.over, n.Extras[xray.OvercommitKey])
+		})
+	}
+}
@@ -5,18 +5,54 @@ package xray
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"path"
+	"slices"
 	"strconv"
+	"strings"
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
 	v1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
+const (
+	// TokenGVR represents a projected service account token.
+	TokenGVR = "authentication.k8s.io/v1/tokenrequests"
+
+	// PDBGVR represents a pod disruption budget.
+	PDBGVR = "policy/v1/poddisruptionbudgets"
+
+	apiAccessVolume        = "kube-api-access-"
+	defaultTokenExpiration = int64(3600)
+
+	istioStatusAnnotation  = "sidecar.istio.io/status"
+	linkerdProxyAnnotation = "linkerd.io/proxy-version"
+	istioProxyContainer    = "istio-proxy"
+	linkerdProxyContainer  = "linkerd-proxy"
+)
+
+// DefaultSidecars represents well known sidecar container names.
+var DefaultSidecars = []string{
+	istioProxyContainer,
+	linkerdProxyContainer,
+	"envoy",
+	"cilium-envoy",
+	"vault-agent",
+	"consul-dataplane",
+	"cloud-sql-proxy",
+}
+
 // Pod represents an xray renderer.
 type Pod struct{}
 
@@ -44,13 +80,19 @@ func (p *Pod) Render(ctx context.Context, ns string, o interface{}) error {
 		return fmt.Errorf("Expecting a TreeNode but got %T", ctx.Value(KeyParent))
 	}
 
-	if err := p.containerRefs(ctx, node, po.Namespace, po.Spec); err != nil {
+	if err := p.containerRefs(ctx, node, po.Namespace, po.Spec, po.Status); err != nil {
 		return err
 	}
-	p.podVolumeRefs(f, node, po.Namespace, po.Spec.Volumes)
+	p.sidecars(ctx, node, po)
+	p.scheduling(node, po.Spec)
+	p.podVolumeRefs(ctx, f, node, po.Namespace, po.Spec)
+	ownerRefs(ctx, f, node, po.Namespace, po.OwnerReferences)
 	if err := p.serviceAccountRef(ctx, f, node, po.Namespace, po.Spec); err != nil {
 		return err
 	}
+	if err := p.pdbRefs(f, node, po); err != nil {
+		return err
+	}
 
 	gvr, nsID := "v1/namespaces", client.FQN(client.ClusterScope, po.Namespace)
 	nsn := parent.Find(gvr, nsID)
@@ -78,20 +120,30 @@ func (p *Pod) validate(node *TreeNode, po v1.Pod) error {
 
 	node.Extras[StatusKey] = status
 	node.Extras[InfoKey] = strconv.Itoa(cr) + "/" + strconv.Itoa(len(ss))
+	terminating(node, &po)
 
 	return nil
 }
 
-func (*Pod) containerRefs(ctx context.Context, parent *TreeNode, ns string, spec v1.PodSpec) error {
+func (*Pod) containerRefs(ctx context.Context, parent *TreeNode, ns string, spec v1.PodSpec, status v1.PodStatus) error {
 	ctx = context.WithValue(ctx, KeyParent, parent)
 	var cre Container
 	for i := 0; i < len(spec.InitContainers); i++ {
-		if err := cre.Render(ctx, ns, render.ContainerRes{Container: &spec.InitContainers[i]}); err != nil {
+		co := render.ContainerRes{
+			Container: &spec.InitContainers[i],
+			Status:    containerStatus(spec.InitContainers[i].Name, status.InitContainerStatuses),
+			IsInit:    true,
+		}
+		if err := cre.Render(ctx, ns, co); err != nil {
 			return err
 		}
 	}
 	for i := 0; i < len(spec.Containers); i++ {
-		if err := cre.Render(ctx, ns, render.ContainerRes{Container: &spec.Containers[i]}); err != nil {
+		co := render.ContainerRes{
+			Container: &spec.Containers[i],
+			Status:    containerStatus(spec.Containers[i].Name, status.ContainerStatuses),
+		}
+		if err := cre.Render(ctx, ns, co); err != nil {
 			return err
 		}
 	}
@@ -99,6 +151,32 @@ func (*Pod) containerRefs(ctx context.Context, parent *TreeNode, ns string, spec
 	return nil
 }
 
+// sidecars flags injected or native sidecar containers.
+func (*Pod) sidecars(ctx context.Context, node *TreeNode, po v1.Pod) {
+	known, ok := ctx.Value(KeySidecars).([]string)
+	if !ok {
+		known = DefaultSidecars
+	}
+	injected := injectedSidecars(po.Annotations)
+	cc := make([]v1.Container, 0, len(po.Spec.InitContainers)+len(po.Spec.Containers))
+	cc = append(cc, po.Spec.InitContainers...)
+	cc = append(cc, po.Spec.Containers...)
+	for _, co := range cc {
+		n := node.Find("containers", client.FQN(po.Namespace, co.Name))
+		if n == nil {
+			continue
+		}
+		switch {
+		case co.RestartPolicy != nil && *co.RestartPolicy == v1.ContainerRestartPolicyAlways:
+			n.Extras[SidecarKey] = "native"
+		case injected[co.Name] != "":
+			n.Extras[SidecarKey] = injected[co.Name]
+		case slices.Contains(known, co.Name):
+			n.Extras[SidecarKey] = "name"
+		}
+	}
+}
+
 func (*Pod) serviceAccountRef(ctx context.Context, f dao.Factory, parent *TreeNode, ns string, spec v1.PodSpec) error {
 	if spec.ServiceAccountName == "" {
 		return nil
@@ -110,7 +188,7 @@ func (*Pod) serviceAccountRef(ctx context.Context, f dao.Factory, parent *TreeNo
 		return err
 	}
 	if o == nil {
-		addRef(f, parent, "v1/serviceaccounts", id, nil)
+		addRef(ctx, f, parent, "v1/serviceaccounts", id, nil)
 		return nil
 	}
 
@@ -120,23 +198,265 @@ func (*Pod) serviceAccountRef(ctx context.Context, f dao.Factory, parent *TreeNo
 	return saRE.Render(ctx, ns, o)
 }
 
-func (*Pod) podVolumeRefs(f dao.Factory, parent *TreeNode, ns string, vv []v1.Volume) {
-	for _, v := range vv {
+// ownerRefs renders owners that do not have a specialized resolver. Owners
+// already rendered above the node ie a job pods are skipped.
+func ownerRefs(ctx context.Context, f dao.Factory, parent *TreeNode, ns string, refs []metav1.OwnerReference) {
+	up, _ := ctx.Value(KeyParent).(*TreeNode)
+	for _, ref := range refs {
+		gv, err := schema.ParseGroupVersion(ref.APIVersion)
+		if err != nil {
+			log.Warn().Err(err).Msgf("Invalid owner apiVersion %q", ref.APIVersion)
+			continue
+		}
+		if isResolvedOwner(gv, ref.Kind) {
+			continue
+		}
+		gvr, namespaced, ok := dao.MetaAccess.GVK2GVR(gv, ref.Kind)
+		if !ok {
+			gvr, namespaced = client.NewGVR(path.Join(ref.APIVersion, strings.ToLower(ref.Kind)+"s")), true
+		}
+		id := client.FQN(client.ClusterScope, ref.Name)
+		if namespaced {
+			id = client.FQN(ns, ref.Name)
+		}
+		if parent.Find(gvr.String(), id) != nil || isAncestor(up, gvr.String(), id) {
+			continue
+		}
+		n := NewTreeNode(gvr.String(), id)
+		n.Extras[GenericOwnerKey] = ref.Kind
+		validate(ctx, f, n, nil)
+		parent.Add(n)
+	}
+}
+
+// pdbRefs adds the disruption budgets selecting the pod. Running pods not
+// covered by any budget are flagged.
+func (*Pod) pdbRefs(f dao.Factory, parent *TreeNode, po v1.Pod) error {
+	oo, err := f.List(PDBGVR, po.Namespace, false, labels.Everything())
+	if err != nil {
+		log.Warn().Err(err).Msgf("Unable to list pod disruption budgets in %q", po.Namespace)
+		return nil
+	}
+
+	var covered bool
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return fmt.Errorf("expecting *Unstructured but got %T", o)
+		}
+		var pdb policyv1.PodDisruptionBudget
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &pdb); err != nil {
+			return err
+		}
+		if pdb.Namespace != po.Namespace {
+			continue
+		}
+		sel, err := metav1.LabelSelectorAsSelector(pdb.Spec.Selector)
+		if err != nil {
+			log.Warn().Err(err).Msgf("Invalid selector on pdb %q", client.FQN(pdb.Namespace, pdb.Name))
+			continue
+		}
+		if !sel.Matches(labels.Set(po.Labels)) {
+			continue
+		}
+		covered = true
+		n := NewTreeNode(PDBGVR, client.FQN(pdb.Namespace, pdb.Name))
+		n.Extras[StatusKey] = OkStatus
+		if pdb.Status.DisruptionsAllowed == 0 {
+			n.Extras[StatusKey] = ToastStatus
+		}
+		n.Extras[DisruptionsKey] = strconv.Itoa(int(pdb.Status.DisruptionsAllowed))
+		n.Extras[InfoKey] = fmt.Sprintf("allowed:%d healthy:%d/%d",
+			pdb.Status.DisruptionsAllowed, pdb.Status.CurrentHealthy, pdb.Status.DesiredHealthy)
+		parent.Add(n)
+	}
+	if !covered && po.Status.Phase != v1.PodSucceeded && po.Status.Phase != v1.PodFailed {
+		parent.Extras[NoPDBKey] = "true"
+	}
+
+	return nil
+}
+
+// scheduling annotates a pod node with its QoS class and scheduling constraints.
+func (*Pod) scheduling(node *TreeNode, spec v1.PodSpec) {
+	node.Extras[QoSKey] = string(podQoS(spec))
+	if a := spec.Affinity; a != nil && a.NodeAffinity != nil {
+		var tt []string
+		if a.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution != nil {
+			tt = append(tt, "required")
+		}
+		if len(a.NodeAffinity.PreferredDuringSchedulingIgnoredDuringExecution) > 0 {
+			tt = append(tt, "preferred")
+		}
+		if len(tt) > 0 {
+			node.Extras[NodeAffinityKey] = strings.Join(tt, ",")
+		}
+	}
+	var count int
+	for _, t := range spec.Tolerations {
+		if !isDefaultToleration(t) {
+			count++
+		}
+	}
+	if count > 0 {
+		node.Extras[TolerationsKey] = strconv.Itoa(count)
+	}
+}
+
+func (p *Pod) podVolumeRefs(ctx context.Context, f dao.Factory, parent *TreeNode, ns string, spec v1.PodSpec) {
+	for _, v := range spec.Volumes {
+		if v.VolumeSource.Projected != nil {
+			p.projectedRefs(ctx, f, parent, ns, spec.ServiceAccountName, v)
+			continue
+		}
+
 		sec := v.VolumeSource.Secret
 		if sec != nil {
-			addRef(f, parent, "v1/secrets", client.FQN(ns, sec.SecretName), sec.Optional)
+			addRef(ctx, f, parent, "v1/secrets", client.FQN(ns, sec.SecretName), sec.Optional)
 			continue
 		}
 
 		cm := v.VolumeSource.ConfigMap
 		if cm != nil {
-			addRef(f, parent, "v1/configmaps", client.FQN(ns, cm.LocalObjectReference.Name), cm.Optional)
+			addRef(ctx, f, parent, "v1/configmaps", client.FQN(ns, cm.LocalObjectReference.Name), cm.Optional)
 			continue
 		}
 
 		pvc := v.VolumeSource.PersistentVolumeClaim
 		if pvc != nil {
-			addRef(f, parent, "v1/persistentvolumeclaims", client.FQN(ns, pvc.ClaimName), nil)
+			addRef(ctx, f, parent, "v1/persistentvolumeclaims", client.FQN(ns, pvc.ClaimName), nil)
+		}
+	}
+}
+
+// projectedRefs renders projected volume sources. Auto injected api access
+// volumes are skipped.
+func (*Pod) projectedRefs(ctx context.Context, f dao.Factory, parent *TreeNode, ns, sa string, v v1.Volume) {
+	if strings.HasPrefix(v.Name, apiAccessVolume) {
+		return
+	}
+	for _, src := range v.VolumeSource.Projected.Sources {
+		switch {
+		case src.Secret != nil:
+			addRef(ctx, f, parent, "v1/secrets", client.FQN(ns, src.Secret.Name), src.Secret.Optional)
+		case src.ConfigMap != nil:
+			addRef(ctx, f, parent, "v1/configmaps", client.FQN(ns, src.ConfigMap.Name), src.ConfigMap.Optional)
+		case src.ServiceAccountToken != nil:
+			tok := src.ServiceAccountToken
+			n := NewTreeNode(TokenGVR, client.FQN(ns, v.Name+"/"+tok.Path))
+			aud, exp := tok.Audience, defaultTokenExpiration
+			if aud == "" {
+				aud = "apiserver"
+			}
+			if tok.ExpirationSeconds != nil {
+				exp = *tok.ExpirationSeconds
+			}
+			n.Extras[AudienceKey] = aud
+			n.Extras[ExpirationKey] = strconv.FormatInt(exp, 10)
+			n.Extras[InfoKey] = fmt.Sprintf("aud:%s,exp:%ds", aud, exp)
+			if sa == "" {
+				sa = "default"
+			}
+			addRef(ctx, f, n, "v1/serviceaccounts", client.FQN(ns, sa), nil)
+			parent.Add(n)
+		}
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func isResolvedOwner(gv schema.GroupVersion, kind string) bool {
+	if gv.Group != "apps" {
+		return false
+	}
+	switch kind {
+	case "ReplicaSet", "StatefulSet", "DaemonSet", "Deployment":
+		return true
+	default:
+		return false
+	}
+}
+
+// isAncestor checks if a node or one of its ancestors matches a gvr/id spec.
+func isAncestor(n *TreeNode, gvr, id string) bool {
+	for ; n != nil; n = n.Parent {
+		if n.GVR == gvr && n.ID == id {
+			return true
 		}
 	}
+
+	return false
+}
+
+// injectedSidecars returns the containers injected by a service mesh keyed by
+// container name.
+func injectedSidecars(aa map[string]string) map[string]string {
+	mm := make(map[string]string)
+	if raw, ok := aa[istioStatusAnnotation]; ok {
+		var st struct {
+			Containers []string `json:"containers"`
+		}
+		if err := json.Unmarshal([]byte(raw), &st); err != nil || len(st.Containers) == 0 {
+			st.Containers = []string{istioProxyContainer}
+		}
+		for _, c := range st.Containers {
+			mm[c] = "istio"
+		}
+	}
+	if _, ok := aa[linkerdProxyAnnotation]; ok {
+		mm[linkerdProxyContainer] = "linkerd"
+	}
+
+	return mm
+}
+
+func containerStatus(name string, ss []v1.ContainerStatus) *v1.ContainerStatus {
+	for i := range ss {
+		if ss[i].Name == name {
+			return &ss[i]
+		}
+	}
+
+	return nil
+}
+
+// podQoS computes a pod QoS class from its containers cpu and memory resources.
+func podQoS(spec v1.PodSpec) v1.PodQOSClass {
+	cc := make([]v1.Container, 0, len(spec.InitContainers)+len(spec.Containers))
+	cc = append(cc, spec.InitContainers...)
+	cc = append(cc, spec.Containers...)
+
+	var hasResources bool
+	guaranteed := true
+	for _, co := range cc {
+		for _, n := range []v1.ResourceName{v1.ResourceCPU, v1.ResourceMemory} {
+			req, rok := co.Resources.Requests[n]
+			lim, lok := co.Resources.Limits[n]
+			rok, lok = rok && !req.IsZero(), lok && !lim.IsZero()
+			if rok || lok {
+				hasResources = true
+			}
+			if !lok || (rok && req.Cmp(lim) != 0) {
+				guaranteed = false
+			}
+		}
+	}
+	switch {
+	case !hasResources:
+		return v1.PodQOSBestEffort
+	case guaranteed:
+		return v1.PodQOSGuaranteed
+	default:
+		return v1.PodQOSBurstable
+	}
+}
+
+// isDefaultToleration checks for tolerations injected by the api server on all pods.
+func isDefaultToleration(t v1.Toleration) bool {
+	if t.Effect != v1.TaintEffectNoExecute || t.Operator != v1.TolerationOpExists {
+		return false
+	}
+
+	return t.Key == v1.TaintNodeNotReady || t.Key == v1.TaintNodeUnreachable
 }
@@ -5,14 +5,337 @@ package xray_test
 
 import (
 	"context"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/xray"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 )
 
+func TestPodRenderGenericOwner(t *testing.T) {
+	o := load(t, "po")
+	o.SetOwnerReferences([]metav1.OwnerReference{
+		{APIVersion: "fred.io/v1", Kind: "Blee", Name: "b1"},
+		{APIVersion: "apps/v1", Kind: "ReplicaSet", Name: "rs1"},
+	})
+	root := xray.NewTreeNode("pods", "pods")
+	ctx := context.WithValue(context.Background(), xray.KeyParent, root)
+	ctx = context.WithValue(ctx, internal.KeyFactory, makeFactory())
+
+	var re xray.Pod
+	assert.Nil(t, re.Render(ctx, "", &render.PodWithMetrics{Raw: o}))
+	assert.Equal(t, 8, root.Count(""))
+	n := root.Find("fred.io/v1/blees", "default/b1")
+	assert.NotNil(t, n)
+	assert.Equal(t, "Blee", n.Extras[xray.GenericOwnerKey])
+	assert.Equal(t, xray.MissingRefStatus, n.Extras[xray.StatusKey])
+}
+
+func TestPodRenderScheduling(t *testing.T) {
+	uu := map[string]struct {
+		resources, affinity  map[string]interface{}
+		tolerations          []interface{}
+		qos, nodeAff, tolers string
+	}{
+		"burstable": {
+			qos: "Burstable",
+		},
+		"best-effort": {
+			resources: map[string]interface{}{},
+			qos:       "BestEffort",
+		},
+		"guaranteed": {
+			resources: map[string]interface{}{
+				"limits": map[string]interface{}{"cpu": "100m", "memory": "70Mi"},
+			},
+			qos: "Guaranteed",
+		},
+		"not-guaranteed": {
+			resources: map[string]interface{}{
+				"requests": map[string]interface{}{"cpu": "50m", "memory": "70Mi"},
+				"limits":   map[string]interface{}{"cpu": "100m", "memory": "70Mi"},
+			},
+			qos: "Burstable",
+		},
+		"affinity": {
+			affinity: map[string]interface{}{
+				"nodeAffinity": map[string]interface{}{
+					"requiredDuringSchedulingIgnoredDuringExecution": map[string]interface{}{
+						"nodeSelectorTerms": []interface{}{},
+					},
+					"preferredDuringSchedulingIgnoredDuringExecution": []interface{}{
+						map[string]interface{}{"weight": int64(1), "preference": map[string]interface{}{}},
+					},
+				},
+			},
+			qos:     "Burstable",
+			nodeAff: "required,preferred",
+		},
+		"tolerations": {
+			tolerations: []interface{}{
+				map[string]interface{}{"key": "node.kubernetes.io/not-ready", "operator": "Exists", "effect": "NoExecute"},
+				map[string]interface{}{"key": "dedicated", "operator": "Equal", "value": "db", "effect": "NoSchedule"},
+			},
+			qos:    "Burstable",
+			tolers: "1",
+		},
+	}
+
+	var re xray.Pod
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			o := load(t, "po")
+			if u.resources != nil {
+				cc, _, _ := unstructured.NestedSlice(o.Object, "spec", "containers")
+				cc[0].(map[string]interface{})["resources"] = u.resources
+				require.NoError(t, unstructured.SetNestedSlice(o.Object, cc, "spec", "containers"))
+			}
+			if u.tolerations != nil {
+				require.NoError(t, unstructured.SetNestedSlice(o.Object, u.tolerations, "spec", "tolerations"))
+			}
+			if u.affinity != nil {
+				require.NoError(t, unstructured.SetNestedMap(o.Object, u.affinity, "spec", "affinity"))
+			}
+			root := xray.NewTreeNode("pods", "pods")
+			ctx := context.WithValue(context.Background(), xray.KeyParent, root)
+			ctx = context.WithValue(ctx, internal.KeyFactory, makeFactory())
+
+			assert.Nil(t, re.Render(ctx, "", &render.PodWithMetrics{Raw: o}))
+			n := root.Find("v1/pods", "default/nginx")
+			require.NotNil(t, n)
+			assert.Equal(t, u.qos, n.Extras[xray.QoSKey])
+			assert.Equal(t, u.nodeAff, n.Extras[xray.NodeAffinityKey])
+			assert.Equal(t, u.tolers, n.Extras[xray.TolerationsKey])
+		})
+	}
+}
+
+func TestPodRenderTerminating(t *testing.T) {
+	uu := map[string]struct {
+		deleted    time.Duration
+		finalizers []string
+		status     string
+		ff         string
+		stuck      bool
+	}{
+		"live": {
+			status: xray.OkStatus,
+		},
+		"terminating": {
+			deleted:    time.Minute,
+			finalizers: []string{"fred.io/f1", "fred.io/f2"},
+			status:     xray.TerminatingStatus,
+			ff:         "fred.io/f1,fred.io/f2",
+		},
+		"stuck": {
+			deleted:    time.Hour,
+			finalizers: []string{"fred.io/f1"},
+			status:     xray.TerminatingStatus,
+			ff:         "fred.io/f1",
+			stuck:      true,
+		},
+	}
+
+	var re xray.Pod
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			o := load(t, "po")
+			if u.deleted > 0 {
+				ts := metav1.NewTime(time.Now().Add(-u.deleted))
+				o.SetDeletionTimestamp(&ts)
+				o.SetFinalizers(u.finalizers)
+			}
+			root := xray.NewTreeNode("pods", "pods")
+			ctx := context.WithValue(context.Background(), xray.KeyParent, root)
+			ctx = context.WithValue(ctx, internal.KeyFactory, makeFactory())
+
+			assert.Nil(t, re.Render(ctx, "", &render.PodWithMetrics{Raw: o}))
+			n := root.Find("v1/pods", "default/nginx")
+			require.NotNil(t, n)
+			assert.Equal(t, u.status, n.Extras[xray.StatusKey])
+			assert.Equal(t, u.ff, n.Extras[xray.FinalizersKey])
+			_, ok := n.Extras[xray.StuckKey]
+			assert.Equal(t, u.stuck, ok)
+		})
+	}
+}
+
+func TestPodRenderSidecars(t *testing.T) {
+	uu := map[string]struct {
+		annotations map[string]string
+		known       []string
+		native      bool
+		e           map[string]string
+	}{
+		"none": {
+			e: map[string]string{"nginx": "", "istio-proxy": "name", "app": ""},
+		},
+		"native": {
+			native: true,
+			e:      map[string]string{"nginx": "", "istio-proxy": "name", "app": "native"},
+		},
+		"istio": {
+			annotations: map[string]string{"sidecar.istio.io/status": `{"containers":["istio-proxy"]}`},
+			e:           map[string]string{"nginx": "", "istio-proxy": "istio", "app": ""},
+		},
+		"linkerd": {
+			annotations: map[string]string{"linkerd.io/proxy-version": "stable-2.14"},
+			known:       []string{"nginx"},
+			e:           map[string]string{"nginx": "name", "istio-proxy": "", "app": ""},
+		},
+	}
+
+	var re xray.Pod
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			o := load(t, "po")
+			cc, _, _ := unstructured.NestedSlice(o.Object, "spec", "containers")
+			cc = append(cc, map[string]interface{}{"name": "istio-proxy", "image": "istio/proxyv2"})
+			require.NoError(t, unstructured.SetNestedSlice(o.Object, cc, "spec", "containers"))
+			ic := map[string]interface{}{"name": "app", "image": "fred"}
+			if u.native {
+				ic["restartPolicy"] = "Always"
+			}
+			require.NoError(t, unstructured.SetNestedSlice(o.Object, []interface{}{ic}, "spec", "initContainers"))
+			o.SetAnnotations(u.annotations)
+			root := xray.NewTreeNode("pods", "pods")
+			ctx := context.WithValue(context.Background(), xray.KeyParent, root)
+			ctx = context.WithValue(ctx, internal.KeyFactory, makeFactory())
+			if u.known != nil {
+				ctx = context.WithValue(ctx, xray.KeySidecars, u.known)
+			}
+
+			assert.Nil(t, re.Render(ctx, "", &render.PodWithMetrics{Raw: o}))
+			for co, e := range u.e {
+				n := root.Find("containers", "default/"+co)
+				require.NotNil(t, n, co)
+				assert.Equal(t, e, n.Extras[xray.SidecarKey], co)
+			}
+		})
+	}
+}
+
+func TestPodRenderPDBs(t *testing.T) {
+	uu := map[string]struct {
+		pdbs  []runtime.Object
+		phase string
+		ids   []string
+		noPDB string
+		title bool
+	}{
+		"none": {
+			noPDB: "true",
+			title: true,
+		},
+		"completed": {
+			phase: "Succeeded",
+		},
+		"covered": {
+			pdbs: []runtime.Object{
+				makePDB("p1", "default", map[string]interface{}{"app": "nginx"}, 1),
+				makePDB("p2", "default", map[string]interface{}{"app": "fred"}, 1),
+				makePDB("p3", "fred", map[string]interface{}{"app": "nginx"}, 1),
+				makePDB("p4", "default", map[string]interface{}{}, 0),
+			},
+			ids: []string{"default/p1", "default/p4"},
+		},
+	}
+
+	var re xray.Pod
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			o := load(t, "po")
+			o.SetLabels(map[string]string{"app": "nginx"})
+			if u.phase != "" {
+				require.NoError(t, unstructured.SetNestedField(o.Object, u.phase, "status", "phase"))
+			}
+			f := makeFactory()
+			f.rows = map[string][]runtime.Object{xray.PDBGVR: u.pdbs}
+			root := xray.NewTreeNode("pods", "pods")
+			ctx := context.WithValue(context.Background(), xray.KeyParent, root)
+			ctx = context.WithValue(ctx, internal.KeyFactory, f)
+
+			assert.Nil(t, re.Render(ctx, "", &render.PodWithMetrics{Raw: o}))
+			po := root.Find("v1/pods", "default/nginx")
+			require.NotNil(t, po)
+			assert.Equal(t, u.noPDB, po.Extras[xray.NoPDBKey])
+			assert.Equal(t, u.title, strings.Contains(po.Title(true, nil), "NO_PDB"))
+			var ids []string
+			for _, c := range po.Children {
+				if c.GVR == xray.PDBGVR {
+					ids = append(ids, c.ID)
+				}
+			}
+			assert.Equal(t, u.ids, ids)
+			if n := root.Find(xray.PDBGVR, "default/p4"); n != nil {
+				assert.Equal(t, xray.ToastStatus, n.Extras[xray.StatusKey])
+				assert.Equal(t, "0", n.Extras[xray.DisruptionsKey])
+				assert.Equal(t, "allowed:0 healthy:2/3", n.Extras[xray.InfoKey])
+			}
+		})
+	}
+}
+
+func TestPodRenderProjected(t *testing.T) {
+	o := load(t, "po")
+	vv, _, _ := unstructured.NestedSlice(o.Object, "spec", "volumes")
+	vv = append(vv,
+		map[string]interface{}{
+			"name": "oidc",
+			"projected": map[string]interface{}{
+				"sources": []interface{}{
+					map[string]interface{}{
+						"serviceAccountToken": map[string]interface{}{
+							"audience":          "sts.amazonaws.com",
+							"expirationSeconds": int64(86400),
+							"path":              "token",
+						},
+					},
+					map[string]interface{}{
+						"configMap": map[string]interface{}{"name": "aws-cfg"},
+					},
+				},
+			},
+		},
+		map[string]interface{}{
+			"name": "kube-api-access-x2k9l",
+			"projected": map[string]interface{}{
+				"sources": []interface{}{
+					map[string]interface{}{
+						"serviceAccountToken": map[string]interface{}{"path": "token"},
+					},
+				},
+			},
+		},
+	)
+	require.NoError(t, unstructured.SetNestedSlice(o.Object, vv, "spec", "volumes"))
+	root := xray.NewTreeNode("pods", "pods")
+	ctx := context.WithValue(context.Background(), xray.KeyParent, root)
+	ctx = context.WithValue(ctx, internal.KeyFactory, makeFactory())
+
+	var re xray.Pod
+	assert.Nil(t, re.Render(ctx, "", &render.PodWithMetrics{Raw: o}))
+	assert.Nil(t, root.Find(xray.TokenGVR, "default/kube-api-access-x2k9l/token"))
+	assert.NotNil(t, root.Find("v1/configmaps", "default/aws-cfg"))
+	n := root.Find(xray.TokenGVR, "default/oidc/token")
+	require.NotNil(t, n)
+	assert.Equal(t, "sts.amazonaws.com", n.Extras[xray.AudienceKey])
+	assert.Equal(t, "86400", n.Extras[xray.ExpirationKey])
+	assert.Equal(t, "aud:sts.amazonaws.com,exp:86400s", n.Extras[xray.InfoKey])
+	assert.NotNil(t, n.Find("v1/serviceaccounts", "default/default"))
+}
+
 func TestPodRender(t *testing.T) {
 	uu := map[string]struct {
 		file            string
@@ -54,3 +377,22 @@ func TestPodRender(t *testing.T) {
 		})
 	}
 }
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func makePDB(n, ns string, sel map[string]interface{}, allowed int64) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "policy/v1",
+		"kind":       "PodDisruptionBudget",
+		"metadata":   map[string]interface{}{"name": n, "namespace": ns},
+		"spec": map[string]interface{}{
+			"selector": map[string]interface{}{"matchLabels": sel},
+		},
+		"status": map[string]interface{}{
+			"disruptionsAllowed": allowed,
+			"currentHealthy":     int64(2),
+			"desiredHealthy":     int64(3),
+		},
+	}}
+}
@@ -0,0 +1,172 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package xray
+
+import (
+	"context"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/rs/zerolog/log"
+	admv1 "k8s.io/api/admissionregistration/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	// ValidatingPolicyGVR represents a validating admission policy.
+	ValidatingPolicyGVR = "admissionregistration.k8s.io/v1/validatingadmissionpolicies"
+
+	// ValidatingPolicyBindingGVR represents a validating admission policy binding.
+	ValidatingPolicyBindingGVR = "admissionregistration.k8s.io/v1/validatingadmissionpolicybindings"
+
+	// ValidationActionsKey tracks policy bindings enforcement actions.
+	ValidationActionsKey = "validationActions"
+)
+
+// AddAdmissionPolicies renders validating admission policies matching a given
+// resource along with their bindings and parameters. Bindings referencing
+// missing policies or parameters are flagged.
+func AddAdmissionPolicies(ctx context.Context, f dao.Factory, root *TreeNode, gvr client.GVR) {
+	if on, _ := ctx.Value(KeyPolicies).(bool); !on {
+		return
+	}
+
+	pp := make(map[string]admv1.ValidatingAdmissionPolicy)
+	oo, err := f.List(ValidatingPolicyGVR, client.ClusterScope, false, labels.Everything())
+	if err != nil {
+		log.Debug().Err(err).Msgf("Unable to list %q", ValidatingPolicyGVR)
+		return
+	}
+	for _, o := range oo {
+		var p admv1.ValidatingAdmissionPolicy
+		if err := fromUnstructured(o, &p); err != nil {
+			log.Warn().Err(err).Msgf("Unable to convert %q", ValidatingPolicyGVR)
+			continue
+		}
+		pp[p.Name] = p
+	}
+
+	nn := make(map[string]*TreeNode)
+	names := make([]string, 0, len(pp))
+	for name := range pp {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	for _, name := range names {
+		ops, ok := matchResources(pp[name].Spec.MatchConstraints, gvr)
+		if !ok {
+			continue
+		}
+		n := NewTreeNode(ValidatingPolicyGVR, client.FQN(client.ClusterScope, name))
+		n.Extras[InfoKey] = strings.Join(ops, ",")
+		root.Add(n)
+		nn[name] = n
+	}
+
+	bb, err := f.List(ValidatingPolicyBindingGVR, client.ClusterScope, false, labels.Everything())
+	if err != nil {
+		log.Debug().Err(err).Msgf("Unable to list %q", ValidatingPolicyBindingGVR)
+		return
+	}
+	for _, o := range bb {
+		var b admv1.ValidatingAdmissionPolicyBinding
+		if err := fromUnstructured(o, &b); err != nil {
+			log.Warn().Err(err).Msgf("Unable to convert %q", ValidatingPolicyBindingGVR)
+			continue
+		}
+		addPolicyBinding(ctx, f, root, nn, pp, b, gvr)
+	}
+}
+
+// addPolicyBinding adds a binding under its matched policy. Bindings to missing
+// policies are only rendered if their own match resources apply.
+func addPolicyBinding(ctx context.Context, f dao.Factory, root *TreeNode, nn map[string]*TreeNode, pp map[string]admv1.ValidatingAdmissionPolicy, b admv1.ValidatingAdmissionPolicyBinding, gvr client.GVR) {
+	if b.Spec.MatchResources != nil {
+		if _, ok := matchResources(b.Spec.MatchResources, gvr); !ok {
+			return
+		}
+	}
+	n := NewTreeNode(ValidatingPolicyBindingGVR, client.FQN(client.ClusterScope, b.Name))
+	aa := make([]string, 0, len(b.Spec.ValidationActions))
+	for _, a := range b.Spec.ValidationActions {
+		aa = append(aa, string(a))
+	}
+	n.Extras[ValidationActionsKey] = strings.Join(aa, ",")
+
+	pn, ok := nn[b.Spec.PolicyName]
+	if !ok {
+		if _, exists := pp[b.Spec.PolicyName]; exists || b.Spec.MatchResources == nil {
+			return
+		}
+		pn = NewTreeNode(ValidatingPolicyGVR, client.FQN(client.ClusterScope, b.Spec.PolicyName))
+		pn.Extras[StatusKey] = MissingRefStatus
+		n.Extras[StatusKey] = MissingRefStatus
+		n.Add(pn)
+		root.Add(n)
+		return
+	}
+	if p := pp[b.Spec.PolicyName]; p.Spec.ParamKind != nil && b.Spec.ParamRef != nil {
+		paramRef(ctx, f, n, *p.Spec.ParamKind, *b.Spec.ParamRef)
+	}
+	pn.Add(n)
+}
+
+// paramRef adds a binding named parameter resource.
+func paramRef(ctx context.Context, f dao.Factory, n *TreeNode, kind admv1.ParamKind, ref admv1.ParamRef) {
+	if ref.Name == "" {
+		n.Extras[InfoKey] = "params:selector"
+		return
+	}
+	gv, err := schema.ParseGroupVersion(kind.APIVersion)
+	if err != nil {
+		log.Warn().Err(err).Msgf("Invalid param apiVersion %q", kind.APIVersion)
+		return
+	}
+	pgvr, _, ok := dao.MetaAccess.GVK2GVR(gv, kind.Kind)
+	if !ok {
+		pgvr = client.NewGVR(path.Join(kind.APIVersion, strings.ToLower(kind.Kind)+"s"))
+	}
+	ns := ref.Namespace
+	if ns == "" {
+		ns = client.ClusterScope
+	}
+	pn := NewTreeNode(pgvr.String(), client.FQN(ns, ref.Name))
+	validate(ctx, f, pn, nil)
+	if pn.Extras[StatusKey] == MissingRefStatus {
+		n.Extras[StatusKey] = MissingRefStatus
+	}
+	n.Add(pn)
+}
+
+// matchResources returns the operations of rules matching a given resource
+// unless the resource is excluded.
+func matchResources(m *admv1.MatchResources, gvr client.GVR) ([]string, bool) {
+	if m == nil {
+		return nil, false
+	}
+	for _, r := range m.ExcludeResourceRules {
+		if ruleMatches(r.Rule, gvr) {
+			return nil, false
+		}
+	}
+	var (
+		ops     []string
+		matched bool
+	)
+	for _, r := range m.ResourceRules {
+		if !ruleMatches(r.Rule, gvr) {
+			continue
+		}
+		matched = true
+		for _, op := range r.Operations {
+			ops = appendUnique(ops, string(op))
+		}
+	}
+
+	return ops, matched
+}
@@ -0,0 +1,102 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package xray_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/xray"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	admv1 "k8s.io/api/admissionregistration/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestAddAdmissionPolicies(t *testing.T) {
+	pods := makeRule([]string{""}, []string{"v1"}, []string{"pods"}, admv1.Create)
+	f := makeFactory()
+	f.rows = map[string][]runtime.Object{
+		xray.ValidatingPolicyGVR: {
+			makePolicy(t, "p1", &admv1.ParamKind{APIVersion: "v1", Kind: "ConfigMap"}, []admv1.RuleWithOperations{pods}, nil),
+			makePolicy(t, "p2", nil, []admv1.RuleWithOperations{makeRule([]string{"apps"}, []string{"v1"}, []string{"deployments"}, admv1.Create)}, nil),
+			makePolicy(t, "p3", nil, []admv1.RuleWithOperations{makeRule([]string{"*"}, []string{"*"}, []string{"*"}, admv1.Update)}, []admv1.RuleWithOperations{pods}),
+			makePolicy(t, "p4", &admv1.ParamKind{APIVersion: "v1", Kind: "Secret"}, []admv1.RuleWithOperations{pods}, nil),
+		},
+		xray.ValidatingPolicyBindingGVR: {
+			makeBinding(t, "b1", "p1", &admv1.ParamRef{Name: "cfg", Namespace: "default"}, nil, admv1.Deny, admv1.Audit),
+			makeBinding(t, "b2", "p2", nil, nil, admv1.Deny),
+			makeBinding(t, "b3", "p4", &admv1.ParamRef{Name: "sec"}, nil, admv1.Warn),
+			makeBinding(t, "b4", "ghost", nil, &admv1.MatchResources{ResourceRules: []admv1.NamedRuleWithOperations{{RuleWithOperations: pods}}}, admv1.Deny),
+			makeBinding(t, "b5", "ghost", nil, nil, admv1.Deny),
+		},
+		"v1/configmaps": {
+			toUnstructured(t, &v1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "cfg", Namespace: "default"}}),
+		},
+	}
+
+	root := xray.NewTreeNode("pods", "pods")
+	xray.AddAdmissionPolicies(context.Background(), f, root, client.NewGVR("v1/pods"))
+	assert.Equal(t, 0, root.CountChildren())
+
+	xray.AddAdmissionPolicies(context.WithValue(context.Background(), xray.KeyPolicies, true), f, root, client.NewGVR("v1/pods"))
+	assert.Equal(t, 3, root.CountChildren())
+	assert.Nil(t, root.Find(xray.ValidatingPolicyGVR, "-/p2"))
+	assert.Nil(t, root.Find(xray.ValidatingPolicyGVR, "-/p3"))
+
+	p1 := root.Find(xray.ValidatingPolicyGVR, "-/p1")
+	require.NotNil(t, p1)
+	assert.Equal(t, "CREATE", p1.Extras[xray.InfoKey])
+	b1 := p1.Find(xray.ValidatingPolicyBindingGVR, "-/b1")
+	require.NotNil(t, b1)
+	assert.Equal(t, xray.OkStatus, b1.Extras[xray.StatusKey])
+	assert.Equal(t, "Deny,Audit", b1.Extras[xray.ValidationActionsKey])
+	assert.NotNil(t, b1.Find("v1/configmaps", "default/cfg"))
+
+	b3 := root.Find(xray.ValidatingPolicyBindingGVR, "-/b3")
+	require.NotNil(t, b3)
+	assert.Equal(t, xray.MissingRefStatus, b3.Extras[xray.StatusKey])
+	assert.Equal(t, xray.MissingRefStatus, b3.Find("v1/secrets", "-/sec").Extras[xray.StatusKey])
+
+	b4 := root.Find(xray.ValidatingPolicyBindingGVR, "-/b4")
+	require.NotNil(t, b4)
+	assert.Equal(t, xray.MissingRefStatus, b4.Extras[xray.StatusKey])
+	assert.Equal(t, xray.MissingRefStatus, b4.Find(xray.ValidatingPolicyGVR, "-/ghost").Extras[xray.StatusKey])
+	assert.Nil(t, root.Find(xray.ValidatingPolicyBindingGVR, "-/b5"))
+}
+
+// Helpers...
+
+func makePolicy(t *testing.T, n string, kind *admv1.ParamKind, rules, excludes []admv1.RuleWithOperations) runtime.Object {
+	m := admv1.MatchResources{}
+	for _, r := range rules {
+		m.ResourceRules = append(m.ResourceRules, admv1.NamedRuleWithOperations{RuleWithOperations: r})
+	}
+	for _, r := range excludes {
+		m.ExcludeResourceRules = append(m.ExcludeResourceRules, admv1.NamedRuleWithOperations{RuleWithOperations: r})
+	}
+
+	return toUnstructured(t, &admv1.ValidatingAdmissionPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: n},
+		Spec: admv1.ValidatingAdmissionPolicySpec{
+			ParamKind:        kind,
+			MatchConstraints: &m,
+		},
+	})
+}
+
+func makeBinding(t *testing.T, n, policy string, ref *admv1.ParamRef, m *admv1.MatchResources, aa ...admv1.ValidationAction) runtime.Object {
+	return toUnstructured(t, &admv1.ValidatingAdmissionPolicyBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: n},
+		Spec: admv1.ValidatingAdmissionPolicyBindingSpec{
+			PolicyName:        policy,
+			ParamRef:          ref,
+			MatchResources:    m,
+			ValidationActions: aa,
+		},
+	})
+}
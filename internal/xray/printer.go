@@ -0,0 +1,115 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package xray
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/color"
+)
+
+const (
+	branchMid  = "├── "
+	branchLast = "└── "
+	indentMid  = "│   "
+	indentLast = "    "
+)
+
+// RenderOpts represents tree rendering options.
+type RenderOpts struct {
+	// MaxDepth limits the rendered depth. Zero means unlimited.
+	MaxDepth int
+
+	// ShowOK renders healthy nodes that have no unhealthy descendants.
+	ShowOK bool
+
+	// Color turns on ANSI colors.
+	Color bool
+
+	// Glyphs renders nodes statuses. Defaults to unicode glyphs.
+	Glyphs Glyphs
+}
+
+// Render prints out the tree to the given writer. Unhealthy nodes are
+// followed by their status explanation.
+func (t *TreeNode) Render(w io.Writer, opts RenderOpts) error {
+	if _, err := fmt.Fprintln(w, t.printLine(opts)); err != nil {
+		return err
+	}
+
+	return t.renderChildren(w, opts, "", 1)
+}
+
+func (t *TreeNode) renderChildren(w io.Writer, opts RenderOpts, prefix string, depth int) error {
+	if opts.MaxDepth > 0 && depth > opts.MaxDepth {
+		return nil
+	}
+	cc := make(ChildNodes, 0, len(t.Children))
+	for _, c := range t.Children {
+		if opts.ShowOK || !c.isHealthy() {
+			cc = append(cc, c)
+		}
+	}
+	for i, c := range cc {
+		branch, indent := branchMid, indentMid
+		if i == len(cc)-1 {
+			branch, indent = branchLast, indentLast
+		}
+		if _, err := fmt.Fprintln(w, prefix+branch+c.printLine(opts)); err != nil {
+			return err
+		}
+		if err := c.renderChildren(w, opts, prefix+indent, depth+1); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (t *TreeNode) printLine(opts RenderOpts) string {
+	glyph, paint := opts.Glyphs.Glyph(t.Extras[StatusKey]), statusPaint(t.Extras[StatusKey])
+	if !opts.Color {
+		paint = 0
+	}
+	kind := category(t.GVR)
+	if kind == "" {
+		kind = client.NewGVR(t.GVR).R()
+	}
+
+	var b strings.Builder
+	b.WriteString(color.Colorize(glyph, paint))
+	b.WriteString(" ")
+	if kind != "" && kind != t.ID {
+		b.WriteString(kind + "/")
+	}
+	b.WriteString(t.ID)
+	if info := t.Info(); info != "" {
+		b.WriteString(" [" + info + "]")
+	}
+	if !t.isHealthyNode() {
+		if r := t.ExplainStatus(); r != "" {
+			b.WriteString(" - " + r)
+		}
+	}
+
+	return b.String()
+}
+
+func statusPaint(status string) color.Paint {
+	switch status {
+	case ToastStatus:
+		return color.Red
+	case MissingRefStatus, ExpiringStatus:
+		return color.Yellow
+	case CompletedStatus:
+		return color.DarkGray
+	case TerminatingStatus:
+		return color.Magenta
+	default:
+		return color.Green
+	}
+}
@@ -0,0 +1,80 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package xray_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/derailed/k9s/internal/xray"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTreeNodeRender(t *testing.T) {
+	uu := map[string]struct {
+		opts xray.RenderOpts
+		e    string
+	}{
+		"all": {
+			opts: xray.RenderOpts{ShowOK: true},
+			e: `✔ pods
+└── ✔ namespaces/-/default
+    ├── ✘ pods/default/p1 [0/1] - containers not ready 0/1
+    │   └── ? secrets/default/s1 - referenced secrets "default/s1" does not exist
+    └── ✔ pods/default/p2 [1/1]
+`,
+		},
+		"issues-only": {
+			e: `✔ pods
+└── ✔ namespaces/-/default
+    └── ✘ pods/default/p1 [0/1] - containers not ready 0/1
+        └── ? secrets/default/s1 - referenced secrets "default/s1" does not exist
+`,
+		},
+		"max-depth": {
+			opts: xray.RenderOpts{ShowOK: true, MaxDepth: 1},
+			e: `✔ pods
+└── ✔ namespaces/-/default
+`,
+		},
+		"ascii": {
+			opts: xray.RenderOpts{Glyphs: xray.NewGlyphs(true, map[string]string{xray.MissingRefStatus: "??"})},
+			e: `+ pods
+└── + namespaces/-/default
+    └── x pods/default/p1 [0/1] - containers not ready 0/1
+        └── ?? secrets/default/s1 - referenced secrets "default/s1" does not exist
+`,
+		},
+		"color": {
+			opts: xray.RenderOpts{ShowOK: true, MaxDepth: 1, Color: true},
+			e:    "\x1b[32m✔\x1b[0m pods\n└── \x1b[32m✔\x1b[0m namespaces/-/default\n",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var w bytes.Buffer
+			assert.NoError(t, printRoot().Render(&w, u.opts))
+			assert.Equal(t, u.e, w.String())
+		})
+	}
+}
+
+func printRoot() *xray.TreeNode {
+	n := xray.NewTreeNode("pods", "pods")
+	ns := xray.NewTreeNode("v1/namespaces", "-/default")
+	p1 := xray.NewTreeNode("v1/pods", "default/p1")
+	p1.Extras[xray.StatusKey], p1.Extras[xray.InfoKey] = xray.ToastStatus, "0/1"
+	s1 := xray.NewTreeNode("v1/secrets", "default/s1")
+	s1.Extras[xray.StatusKey] = xray.MissingRefStatus
+	p1.Add(s1)
+	p2 := xray.NewTreeNode("v1/pods", "default/p2")
+	p2.Extras[xray.InfoKey] = "1/1"
+	ns.Add(p1)
+	ns.Add(p2)
+	n.Add(ns)
+
+	return n
+}
@@ -0,0 +1,220 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package xray
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	// PersistentVolumeGVR represents a persistent volume resource.
+	PersistentVolumeGVR = "v1/persistentvolumes"
+
+	// CSIDriverGVR represents a CSI driver resource.
+	CSIDriverGVR = "storage.k8s.io/v1/csidrivers"
+)
+
+// PersistentVolumeClaim represents an xray renderer.
+type PersistentVolumeClaim struct{}
+
+// Render renders an xray node.
+func (p *PersistentVolumeClaim) Render(ctx context.Context, ns string, o interface{}) error {
+	raw, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("expected Unstructured, but got %T", o)
+	}
+	var pvc v1.PersistentVolumeClaim
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw.Object, &pvc)
+	if err != nil {
+		return err
+	}
+
+	parent, ok := ctx.Value(KeyParent).(*TreeNode)
+	if !ok {
+		return fmt.Errorf("Expecting a TreeNode but got %T", ctx.Value(KeyParent))
+	}
+
+	root := NewTreeNode("v1/persistentvolumeclaims", client.FQN(pvc.Namespace, pvc.Name))
+	pods, err := p.locateConsumers(ctx, pvc)
+	if err != nil {
+		return err
+	}
+	ctx = context.WithValue(ctx, KeyParent, root)
+	var re Pod
+	for _, po := range pods {
+		if err := re.Render(ctx, ns, &render.PodWithMetrics{Raw: po}); err != nil {
+			return err
+		}
+	}
+
+	if root.IsLeaf() {
+		return nil
+	}
+	gvr, nsID := "v1/namespaces", client.FQN(client.ClusterScope, pvc.Namespace)
+	nsn := parent.Find(gvr, nsID)
+	if nsn == nil {
+		nsn = NewTreeNode(gvr, nsID)
+		parent.Add(nsn)
+	}
+	nsn.Add(root)
+	if err := p.volumeRefs(ctx, root, pvc); err != nil {
+		return err
+	}
+
+	return p.validate(root, pvc, pods)
+}
+
+// volumeRefs adds the volume bound to a claim. CSI volumes are annotated with
+// their driver and volume handle along with the driver object if any.
+func (*PersistentVolumeClaim) volumeRefs(ctx context.Context, root *TreeNode, pvc v1.PersistentVolumeClaim) error {
+	if pvc.Spec.VolumeName == "" {
+		return nil
+	}
+	f, ok := ctx.Value(internal.KeyFactory).(dao.Factory)
+	if !ok {
+		return fmt.Errorf("Expecting a factory but got %T", ctx.Value(internal.KeyFactory))
+	}
+	n := NewTreeNode(PersistentVolumeGVR, client.FQN(client.ClusterScope, pvc.Spec.VolumeName))
+	validate(ctx, f, n, nil)
+	root.Add(n)
+	if n.Extras[StatusKey] == MissingRefStatus {
+		return nil
+	}
+
+	o, err := f.Get(n.GVR, n.ID, true, labels.Everything())
+	if err != nil || o == nil {
+		return nil
+	}
+	raw, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("expecting *Unstructured but got %T", o)
+	}
+	var pv v1.PersistentVolume
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw.Object, &pv); err != nil {
+		return err
+	}
+	csi := pv.Spec.CSI
+	if csi == nil {
+		return nil
+	}
+	n.Extras[CSIDriverKey], n.Extras[VolumeHandleKey] = csi.Driver, csi.VolumeHandle
+	n.Extras[InfoKey] = "csi:" + csi.Driver
+	d := NewTreeNode(CSIDriverGVR, client.FQN(client.ClusterScope, csi.Driver))
+	validate(ctx, f, d, nil)
+	if d.Extras[StatusKey] != MissingRefStatus {
+		n.Add(d)
+	}
+
+	return nil
+}
+
+func (*PersistentVolumeClaim) locateConsumers(ctx context.Context, pvc v1.PersistentVolumeClaim) ([]*unstructured.Unstructured, error) {
+	f, ok := ctx.Value(internal.KeyFactory).(dao.Factory)
+	if !ok {
+		return nil, fmt.Errorf("Expecting a factory but got %T", ctx.Value(internal.KeyFactory))
+	}
+	oo, err := f.List("v1/pods", pvc.Namespace, false, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	pods := make([]*unstructured.Unstructured, 0, len(oo))
+	for _, o := range oo {
+		raw, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return nil, fmt.Errorf("expecting *Unstructured but got %T", o)
+		}
+		var po v1.Pod
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw.Object, &po); err != nil {
+			return nil, err
+		}
+		if mountsClaim(po, pvc.Name) {
+			pods = append(pods, raw)
+		}
+	}
+
+	return pods, nil
+}
+
+func (*PersistentVolumeClaim) validate(root *TreeNode, pvc v1.PersistentVolumeClaim, pods []*unstructured.Unstructured) error {
+	root.Extras[StatusKey] = OkStatus
+	if pvc.Status.Phase != v1.ClaimBound {
+		root.Extras[StatusKey] = ToastStatus
+	}
+
+	modes := accessModes(pvc.Spec.AccessModes)
+	root.Extras[InfoKey] = strings.Join(modes, ",")
+	if hasAccessMode(pvc.Spec.AccessModes, v1.ReadWriteMany) || hasAccessMode(pvc.Spec.AccessModes, v1.ReadOnlyMany) {
+		return nil
+	}
+
+	nodes := make(map[string]struct{}, len(pods))
+	for _, po := range pods {
+		if n, ok, _ := unstructured.NestedString(po.Object, "spec", "nodeName"); ok && n != "" {
+			nodes[n] = struct{}{}
+		}
+	}
+	switch {
+	case hasAccessMode(pvc.Spec.AccessModes, v1.ReadWriteOncePod) && len(pods) > 1:
+		root.Extras[StatusKey] = ToastStatus
+		root.Extras[InfoKey] += fmt.Sprintf(" conflict(%d pods)", len(pods))
+	case hasAccessMode(pvc.Spec.AccessModes, v1.ReadWriteOnce) && len(nodes) > 1:
+		root.Extras[StatusKey] = ToastStatus
+		root.Extras[InfoKey] += fmt.Sprintf(" conflict(%d nodes)", len(nodes))
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func mountsClaim(po v1.Pod, claim string) bool {
+	for _, v := range po.Spec.Volumes {
+		if pvc := v.VolumeSource.PersistentVolumeClaim; pvc != nil && pvc.ClaimName == claim {
+			return true
+		}
+	}
+
+	return false
+}
+
+func hasAccessMode(mm []v1.PersistentVolumeAccessMode, m v1.PersistentVolumeAccessMode) bool {
+	for _, am := range mm {
+		if am == m {
+			return true
+		}
+	}
+
+	return false
+}
+
+func accessModes(mm []v1.PersistentVolumeAccessMode) []string {
+	ss := make([]string, 0, len(mm))
+	for _, m := range mm {
+		switch m {
+		case v1.ReadWriteOnce:
+			ss = append(ss, "RWO")
+		case v1.ReadOnlyMany:
+			ss = append(ss, "ROX")
+		case v1.ReadWriteMany:
+			ss = append(ss, "RWX")
+		case v1.ReadWriteOncePod:
+			ss = append(ss, "RWOP")
+		}
+	}
+
+	return ss
+}
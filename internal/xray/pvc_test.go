@@ -0,0 +1,169 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package xray_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/xray"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestPersistentVolumeClaimRender(t *testing.T) {
+	uu := map[string]struct {
+		pods          []string
+		nodes         []string
+		level1, pods2 int
+		status, info  string
+	}{
+		"plain": {
+			pods:   []string{"po"},
+			nodes:  []string{"n1"},
+			level1: 1,
+			pods2:  1,
+			status: xray.OkStatus,
+			info:   "RWO",
+		},
+		"unmounted": {
+			pods:   []string{"init"},
+			nodes:  []string{"n1"},
+			level1: 0,
+		},
+		"rwo_conflict": {
+			pods:   []string{"po", "po"},
+			nodes:  []string{"n1", "n2"},
+			level1: 1,
+			pods2:  2,
+			status: xray.ToastStatus,
+			info:   "RWO conflict(2 nodes)",
+		},
+		"rwo_same_node": {
+			pods:   []string{"po", "po"},
+			nodes:  []string{"n1", "n1"},
+			level1: 1,
+			pods2:  2,
+			status: xray.OkStatus,
+			info:   "RWO",
+		},
+	}
+
+	var re xray.PersistentVolumeClaim
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			f := makeFactory()
+			oo := make([]runtime.Object, 0, len(u.pods))
+			for i, n := range u.pods {
+				po := load(t, n)
+				po.SetName(po.GetName() + "-" + string(rune('a'+i)))
+				assert.Nil(t, unstructured.SetNestedField(po.Object, u.nodes[i], "spec", "nodeName"))
+				oo = append(oo, po)
+			}
+			f.rows = map[string][]runtime.Object{"v1/pods": oo}
+
+			root := xray.NewTreeNode("persistentvolumeclaims", "persistentvolumeclaims")
+			ctx := context.WithValue(context.Background(), xray.KeyParent, root)
+			ctx = context.WithValue(ctx, internal.KeyFactory, f)
+
+			assert.Nil(t, re.Render(ctx, "", load(t, "pvc")))
+			assert.Equal(t, u.level1, root.CountChildren())
+			if u.level1 == 0 {
+				return
+			}
+			pvc := root.Children[0].Children[0]
+			assert.Equal(t, u.pods2, pvc.Children[0].CountChildren())
+			assert.Equal(t, u.status, pvc.Extras[xray.StatusKey])
+			assert.Equal(t, u.info, pvc.Extras[xray.InfoKey])
+		})
+	}
+}
+
+func TestPersistentVolumeClaimVolume(t *testing.T) {
+	csi := makePV(map[string]interface{}{
+		"csi": map[string]interface{}{"driver": "ebs.csi.aws.com", "volumeHandle": "vol-0abc"},
+	})
+	driver := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "storage.k8s.io/v1",
+		"kind":       "CSIDriver",
+		"metadata":   map[string]interface{}{"name": "ebs.csi.aws.com"},
+	}}
+	uu := map[string]struct {
+		rows           map[string][]runtime.Object
+		status, driver string
+		handle, info   string
+		drivers        int
+	}{
+		"csi": {
+			rows: map[string][]runtime.Object{
+				xray.PersistentVolumeGVR: {csi},
+				xray.CSIDriverGVR:        {driver},
+			},
+			status:  xray.OkStatus,
+			driver:  "ebs.csi.aws.com",
+			handle:  "vol-0abc",
+			info:    "csi:ebs.csi.aws.com",
+			drivers: 1,
+		},
+		"no-driver-object": {
+			rows:   map[string][]runtime.Object{xray.PersistentVolumeGVR: {csi}},
+			status: xray.OkStatus,
+			driver: "ebs.csi.aws.com",
+			handle: "vol-0abc",
+			info:   "csi:ebs.csi.aws.com",
+		},
+		"host-path": {
+			rows: map[string][]runtime.Object{
+				xray.PersistentVolumeGVR: {makePV(map[string]interface{}{
+					"hostPath": map[string]interface{}{"path": "/data"},
+				})},
+			},
+			status: xray.OkStatus,
+		},
+		"missing": {
+			status: xray.MissingRefStatus,
+		},
+	}
+
+	var re xray.PersistentVolumeClaim
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			f := makeFactory()
+			f.rows = u.rows
+			if f.rows == nil {
+				f.rows = make(map[string][]runtime.Object)
+			}
+			f.rows["v1/pods"] = []runtime.Object{load(t, "po")}
+
+			root := xray.NewTreeNode("persistentvolumeclaims", "persistentvolumeclaims")
+			ctx := context.WithValue(context.Background(), xray.KeyParent, root)
+			ctx = context.WithValue(ctx, internal.KeyFactory, f)
+
+			assert.Nil(t, re.Render(ctx, "", load(t, "pvc")))
+			pvc := root.Children[0].Children[0]
+			pv := pvc.Find(xray.PersistentVolumeGVR, "-/pvc-3c1e3a9a-1b5c-4b5f-9d0e-4c1f9c6f0a11")
+			assert.NotNil(t, pv)
+			assert.Equal(t, u.status, pv.Extras[xray.StatusKey])
+			assert.Equal(t, u.driver, pv.Extras[xray.CSIDriverKey])
+			assert.Equal(t, u.handle, pv.Extras[xray.VolumeHandleKey])
+			assert.Equal(t, u.info, pv.Extras[xray.InfoKey])
+			assert.Equal(t, u.drivers, pv.CountChildren())
+		})
+	}
+}
+
+// Helpers...
+
+func makePV(source map[string]interface{}) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "PersistentVolume",
+		"metadata":   map[string]interface{}{"name": "pvc-3c1e3a9a-1b5c-4b5f-9d0e-4c1f9c6f0a11"},
+		"spec":       source,
+	}}
+}
@@ -0,0 +1,164 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package xray
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	anyLevel  = "*"
+	anyLevels = "**"
+)
+
+var versionRX = regexp.MustCompile(`\A(v\d+((alpha|beta)\d+)?|\*)\.`)
+
+// PathQuery represents a compiled tree path expression.
+//
+// An expression lists steps separated by / each matching one tree level,
+// starting at the children of the node being queried:
+//
+//	expr     := step ("/" step)*
+//	step     := "*" | "**" | [group "/"] version "." resource | resource
+//
+// A * step matches any node and a ** step matches zero or more levels. Group,
+// version and resource may hold * wildcards ie apps/v1.deployments/**/*.pods
+// selects pods at any depth under a deployment. A bare resource matches nodes
+// without a version ie containers.
+type PathQuery struct {
+	expr  string
+	steps []string
+}
+
+// CompilePath checks a tree path expression and compiles it.
+func CompilePath(expr string) (PathQuery, error) {
+	q := PathQuery{expr: expr}
+	if strings.TrimSpace(expr) == "" {
+		return q, errors.New("path expression must not be blank")
+	}
+	tt := strings.Split(expr, "/")
+	for i := 0; i < len(tt); i++ {
+		t := tt[i]
+		switch {
+		case t == "":
+			return q, fmt.Errorf("invalid path expression %q: blank step at %d", expr, len(q.steps)+1)
+		case t == anyLevel || t == anyLevels:
+			q.steps = append(q.steps, t)
+			continue
+		case !versionRX.MatchString(t) && i+1 < len(tt) && versionRX.MatchString(tt[i+1]):
+			i++
+			t += "/" + tt[i]
+		}
+		step, err := compileStep(t)
+		if err != nil {
+			return q, fmt.Errorf("invalid path expression %q: %w", expr, err)
+		}
+		q.steps = append(q.steps, step)
+	}
+
+	return q, nil
+}
+
+// String returns the query expression.
+func (q PathQuery) String() string {
+	return q.expr
+}
+
+// Select returns the nodes matching the query under a given node in tree
+// order.
+func (q PathQuery) Select(t *TreeNode) []*TreeNode {
+	mm := make(map[*TreeNode]struct{})
+	q.match(t, 0, func(n *TreeNode) {
+		mm[n] = struct{}{}
+	})
+	if len(mm) == 0 {
+		return nil
+	}
+	nn := make([]*TreeNode, 0, len(mm))
+	walk(t, func(n *TreeNode) {
+		if _, ok := mm[n]; ok {
+			nn = append(nn, n)
+		}
+	})
+
+	return nn
+}
+
+// match matches a node descendants against the query steps from a given step.
+func (q PathQuery) match(t *TreeNode, i int, add func(*TreeNode)) {
+	if i == len(q.steps) {
+		add(t)
+		return
+	}
+	s := q.steps[i]
+	if s == anyLevels {
+		q.match(t, i+1, add)
+		for _, c := range t.Children {
+			q.match(c, i, add)
+		}
+		return
+	}
+	for _, c := range t.Children {
+		if s == anyLevel {
+			q.match(c, i+1, add)
+			continue
+		}
+		if ok, _ := path.Match(s, c.GVR); ok {
+			q.match(c, i+1, add)
+		}
+	}
+}
+
+// Select returns the nodes matching a path expression under this node.
+// Invalid expressions select nothing.
+func (t *TreeNode) Select(expr string) []*TreeNode {
+	q, err := CompilePath(expr)
+	if err != nil {
+		log.Warn().Err(err).Msg("Tree select failed")
+		return nil
+	}
+
+	return q.Select(t)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// compileStep converts a step to a gvr glob ie apps/v1.deployments -> apps/v1/deployments.
+func compileStep(s string) (string, error) {
+	group, vr, ok := strings.Cut(s, "/")
+	if !ok {
+		group, vr = "", s
+	}
+	if !versionRX.MatchString(vr) {
+		if ok {
+			return "", fmt.Errorf("step %q must read group/version.resource", s)
+		}
+		if strings.Contains(vr, ".") {
+			return "", fmt.Errorf("invalid version in step %q", s)
+		}
+		return vr, checkGlob(vr)
+	}
+	v, r, _ := strings.Cut(vr, ".")
+	if r == "" {
+		return "", fmt.Errorf("missing resource in step %q", s)
+	}
+	g := path.Join(group, v, r)
+
+	return g, checkGlob(g)
+}
+
+func checkGlob(g string) error {
+	if _, err := path.Match(g, ""); err != nil {
+		return fmt.Errorf("invalid pattern %q: %w", g, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,122 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package xray_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/xray"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompilePath(t *testing.T) {
+	uu := map[string]struct {
+		expr, err string
+	}{
+		"core": {
+			expr: "v1.pods",
+		},
+		"grouped": {
+			expr: "networking.k8s.io/v1.ingresses",
+		},
+		"wildcards": {
+			expr: "apps/v1.deployments/**/*.pods/containers",
+		},
+		"blank": {
+			expr: " ",
+			err:  "path expression must not be blank",
+		},
+		"blank-step": {
+			expr: "v1.pods//containers",
+			err:  `invalid path expression "v1.pods//containers": blank step at 2`,
+		},
+		"no-resource": {
+			expr: "apps/v1.",
+			err:  `invalid path expression "apps/v1.": missing resource in step "apps/v1."`,
+		},
+		"no-version": {
+			expr: "networking.k8s.io",
+			err:  `invalid path expression "networking.k8s.io": invalid version in step "networking.k8s.io"`,
+		},
+		"bad-glob": {
+			expr: "v1.[pods",
+			err:  `invalid path expression "v1.[pods": invalid pattern "v1/[pods": syntax error in pattern`,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			q, err := xray.CompilePath(u.expr)
+			if u.err != "" {
+				assert.EqualError(t, err, u.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, u.expr, q.String())
+		})
+	}
+}
+
+func TestTreeNodeSelect(t *testing.T) {
+	root := xray.NewTreeNode("deployments", "deployments")
+	ns := xray.NewTreeNode("v1/namespaces", "-/default")
+	root.Add(ns)
+	dp := xray.NewTreeNode("apps/v1/deployments", "default/dp1")
+	ns.Add(dp)
+	rs := xray.NewTreeNode("apps/v1/replicasets", "default/rs1")
+	dp.Add(rs)
+	p1, p2 := xray.NewTreeNode("v1/pods", "default/p1"), xray.NewTreeNode("v1/pods", "default/p2")
+	rs.Add(p1)
+	dp.Add(p2)
+	co := xray.NewTreeNode("containers", "default/p1/c1")
+	p1.Add(co)
+	sts := xray.NewTreeNode("apps/v1/statefulsets", "default/sts1")
+	ns.Add(sts)
+	p3 := xray.NewTreeNode("v1/pods", "default/p3")
+	sts.Add(p3)
+
+	uu := map[string]struct {
+		expr string
+		e    []string
+	}{
+		"level": {
+			expr: "v1.namespaces/apps/v1.deployments/*/v1.pods",
+			e:    []string{"default/p1"},
+		},
+		"any-depth": {
+			expr: "**/apps/v1.deployments/**/v1.pods",
+			e:    []string{"default/p1", "default/p2"},
+		},
+		"all-pods": {
+			expr: "**/*.pods",
+			e:    []string{"default/p1", "default/p2", "default/p3"},
+		},
+		"containers": {
+			expr: "**/v1.pods/containers",
+			e:    []string{"default/p1/c1"},
+		},
+		"resource-glob": {
+			expr: "*/apps/v1.*sets",
+			e:    []string{"default/sts1"},
+		},
+		"none": {
+			expr: "v1.pods",
+		},
+		"invalid": {
+			expr: "v1.pods//",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var ids []string
+			for _, n := range root.Select(u.expr) {
+				ids = append(ids, n.ID)
+			}
+			assert.Equal(t, u.e, ids)
+		})
+	}
+}
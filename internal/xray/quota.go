@@ -0,0 +1,173 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package xray
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/rs/zerolog/log"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	// LimitRangeGVR represents a limit range resource.
+	LimitRangeGVR = "v1/limitranges"
+
+	// ResourceQuotaGVR represents a resource quota resource.
+	ResourceQuotaGVR = "v1/resourcequotas"
+
+	// QuotaKey tracks a resource quota used vs hard limits ie cpu:1/4.
+	QuotaKey = "quota"
+
+	// ExhaustedKey flags resource quota entries whose usage reached the hard limit.
+	ExhaustedKey = "exhausted"
+
+	// LimitDefaultsKey tracks a limit range containers default requests and limits.
+	LimitDefaultsKey = "limitDefaults"
+)
+
+// AddQuotas renders the limit ranges and resource quotas of the namespaces
+// found in the tree.
+func AddQuotas(ctx context.Context, f dao.Factory, root *TreeNode) {
+	if on, _ := ctx.Value(KeyQuotas).(bool); !on {
+		return
+	}
+
+	var nss []*TreeNode
+	walk(root, func(n *TreeNode) {
+		if n.GVR == "v1/namespaces" {
+			nss = append(nss, n)
+		}
+	})
+	for _, n := range nss {
+		_, ns := client.Namespaced(n.ID)
+		addLimitRanges(f, n, ns)
+		addResourceQuotas(f, n, ns)
+	}
+}
+
+func addLimitRanges(f dao.Factory, parent *TreeNode, ns string) {
+	oo, err := f.List(LimitRangeGVR, ns, false, labels.Everything())
+	if err != nil {
+		log.Debug().Err(err).Msgf("Unable to list limit ranges in %q", ns)
+		return
+	}
+	for _, o := range oo {
+		var lr v1.LimitRange
+		if err := fromUnstructured(o, &lr); err != nil {
+			log.Warn().Err(err).Msgf("Unable to convert limit range")
+			continue
+		}
+		if lr.Namespace != ns {
+			continue
+		}
+		n := NewTreeNode(LimitRangeGVR, client.FQN(lr.Namespace, lr.Name))
+		if dd := limitDefaults(lr.Spec); dd != "" {
+			n.Extras[LimitDefaultsKey] = dd
+			n.Extras[InfoKey] = dd
+		}
+		parent.Add(n)
+	}
+}
+
+func addResourceQuotas(f dao.Factory, parent *TreeNode, ns string) {
+	oo, err := f.List(ResourceQuotaGVR, ns, false, labels.Everything())
+	if err != nil {
+		log.Debug().Err(err).Msgf("Unable to list resource quotas in %q", ns)
+		return
+	}
+	for _, o := range oo {
+		var rq v1.ResourceQuota
+		if err := fromUnstructured(o, &rq); err != nil {
+			log.Warn().Err(err).Msgf("Unable to convert resource quota")
+			continue
+		}
+		if rq.Namespace != ns {
+			continue
+		}
+		n := NewTreeNode(ResourceQuotaGVR, client.FQN(rq.Namespace, rq.Name))
+		usage, exhausted := quotaUsage(rq.Status)
+		if usage != "" {
+			n.Extras[QuotaKey] = usage
+			n.Extras[InfoKey] = usage
+		}
+		if len(exhausted) > 0 {
+			n.Extras[StatusKey] = ToastStatus
+			n.Extras[ExhaustedKey] = strings.Join(exhausted, ",")
+		}
+		parent.Add(n)
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func fromUnstructured(o, obj interface{}) error {
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("expecting *Unstructured but got %T", o)
+	}
+
+	return runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, obj)
+}
+
+// quotaUsage returns a quota used vs hard limits sorted by resource name
+// and the resources whose usage reached their limit. Zero limits forbidding a
+// resource are only flagged once the resource is used.
+func quotaUsage(st v1.ResourceQuotaStatus) (string, []string) {
+	nn := make([]string, 0, len(st.Hard))
+	for n := range st.Hard {
+		nn = append(nn, string(n))
+	}
+	slices.Sort(nn)
+
+	var exhausted []string
+	ss := make([]string, 0, len(nn))
+	for _, n := range nn {
+		hard, used := st.Hard[v1.ResourceName(n)], st.Used[v1.ResourceName(n)]
+		ss = append(ss, fmt.Sprintf("%s:%s/%s", n, used.String(), hard.String()))
+		if used.Cmp(hard) >= 0 && (!hard.IsZero() || used.Sign() > 0) {
+			exhausted = append(exhausted, n)
+		}
+	}
+
+	return strings.Join(ss, ","), exhausted
+}
+
+// limitDefaults returns a limit range containers default requests and limits.
+func limitDefaults(spec v1.LimitRangeSpec) string {
+	var ss []string
+	for _, l := range spec.Limits {
+		if l.Type != v1.LimitTypeContainer {
+			continue
+		}
+		if s := resourceList(l.DefaultRequest); s != "" {
+			ss = append(ss, "request "+s)
+		}
+		if s := resourceList(l.Default); s != "" {
+			ss = append(ss, "limit "+s)
+		}
+	}
+
+	return strings.Join(ss, " ")
+}
+
+func resourceList(rl v1.ResourceList) string {
+	ss := make([]string, 0, len(rl))
+	for _, n := range []v1.ResourceName{v1.ResourceCPU, v1.ResourceMemory} {
+		if q, ok := rl[n]; ok {
+			ss = append(ss, fmt.Sprintf("%s:%s", n, q.String()))
+		}
+	}
+
+	return strings.Join(ss, ",")
+}
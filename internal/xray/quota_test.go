@@ -0,0 +1,101 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package xray_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/derailed/k9s/internal/xray"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestAddQuotas(t *testing.T) {
+	f := makeFactory()
+	f.rows = map[string][]runtime.Object{
+		xray.LimitRangeGVR: {
+			toUnstructured(t, &v1.LimitRange{
+				ObjectMeta: metav1.ObjectMeta{Name: "lr1", Namespace: "default"},
+				Spec: v1.LimitRangeSpec{Limits: []v1.LimitRangeItem{
+					{
+						Type:           v1.LimitTypeContainer,
+						Default:        v1.ResourceList{v1.ResourceCPU: resource.MustParse("500m")},
+						DefaultRequest: v1.ResourceList{v1.ResourceCPU: resource.MustParse("100m"), v1.ResourceMemory: resource.MustParse("64Mi")},
+					},
+					{
+						Type: v1.LimitTypePod,
+						Max:  v1.ResourceList{v1.ResourceCPU: resource.MustParse("2")},
+					},
+				}},
+			}),
+		},
+		xray.ResourceQuotaGVR: {
+			toUnstructured(t, &v1.ResourceQuota{
+				ObjectMeta: metav1.ObjectMeta{Name: "rq1", Namespace: "default"},
+				Status: v1.ResourceQuotaStatus{
+					Hard: v1.ResourceList{v1.ResourcePods: resource.MustParse("10"), v1.ResourceRequestsCPU: resource.MustParse("4"), v1.ResourceServicesLoadBalancers: resource.MustParse("0")},
+					Used: v1.ResourceList{v1.ResourcePods: resource.MustParse("3"), v1.ResourceRequestsCPU: resource.MustParse("1500m"), v1.ResourceServicesLoadBalancers: resource.MustParse("0")},
+				},
+			}),
+			toUnstructured(t, &v1.ResourceQuota{
+				ObjectMeta: metav1.ObjectMeta{Name: "rq2", Namespace: "default"},
+				Status: v1.ResourceQuotaStatus{
+					Hard: v1.ResourceList{v1.ResourcePods: resource.MustParse("3")},
+					Used: v1.ResourceList{v1.ResourcePods: resource.MustParse("3")},
+				},
+			}),
+			toUnstructured(t, &v1.ResourceQuota{
+				ObjectMeta: metav1.ObjectMeta{Name: "rq3", Namespace: "fred"},
+			}),
+		},
+	}
+
+	uu := map[string]struct {
+		on    bool
+		count int
+	}{
+		"off": {},
+		"on": {
+			on:    true,
+			count: 3,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			root := xray.NewTreeNode("pods", "pods")
+			ns := xray.NewTreeNode("v1/namespaces", "-/default")
+			ns.Add(xray.NewTreeNode("v1/pods", "default/p1"))
+			root.Add(ns)
+			ctx := context.WithValue(context.Background(), xray.KeyQuotas, u.on)
+
+			xray.AddQuotas(ctx, f, root)
+			assert.Equal(t, u.count, root.Count(xray.LimitRangeGVR)+root.Count(xray.ResourceQuotaGVR))
+			if !u.on {
+				return
+			}
+
+			lr := ns.Find(xray.LimitRangeGVR, "default/lr1")
+			require.NotNil(t, lr)
+			assert.Equal(t, "request cpu:100m,memory:64Mi limit cpu:500m", lr.Extras[xray.LimitDefaultsKey])
+
+			rq1 := ns.Find(xray.ResourceQuotaGVR, "default/rq1")
+			require.NotNil(t, rq1)
+			assert.Equal(t, "pods:3/10,requests.cpu:1500m/4,services.loadbalancers:0/0", rq1.Extras[xray.QuotaKey])
+			assert.Equal(t, xray.OkStatus, rq1.Extras[xray.StatusKey])
+
+			rq2 := ns.Find(xray.ResourceQuotaGVR, "default/rq2")
+			require.NotNil(t, rq2)
+			assert.Equal(t, xray.ToastStatus, rq2.Extras[xray.StatusKey])
+			assert.Equal(t, "quota exhausted for pods", rq2.ExplainStatus())
+			assert.Nil(t, root.Find(xray.ResourceQuotaGVR, "fred/rq3"))
+		})
+	}
+}
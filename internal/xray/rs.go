@@ -64,7 +64,15 @@ func (r *ReplicaSet) Render(ctx context.Context, ns string, o interface{}) error
 	}
 	nsn.Add(root)
 
-	return r.validate(root, rs)
+	if err := estimateCost(ctx, root, oo); err != nil {
+		return err
+	}
+
+	if err := r.validate(root, rs); err != nil {
+		return err
+	}
+
+	return checkSpread(ctx, root, rs.Spec.Template, oo)
 }
 
 func (*ReplicaSet) validate(root *TreeNode, rs appsv1.ReplicaSet) error {
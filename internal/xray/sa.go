@@ -44,10 +44,10 @@ func (s *ServiceAccount) Render(ctx context.Context, ns string, o interface{}) e
 	parent.Add(node)
 
 	for _, sec := range sa.Secrets {
-		addRef(f, node, "v1/secrets", client.FQN(sa.Namespace, sec.Name), nil)
+		addRef(ctx, f, node, "v1/secrets", client.FQN(sa.Namespace, sec.Name), nil)
 	}
 	for _, sec := range sa.ImagePullSecrets {
-		addRef(f, node, "v1/secrets", client.FQN(sa.Namespace, sec.Name), nil)
+		addRef(ctx, f, node, "v1/secrets", client.FQN(sa.Namespace, sec.Name), nil)
 	}
 
 	auto, _ := ctx.Value(KeySAAutomount).(*bool)
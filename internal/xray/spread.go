@@ -0,0 +1,171 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package xray
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/rs/zerolog/log"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// spreadConstraint represents a pods topology spread requirement. Exclusive
+// constraints allow at most one pod per topology domain.
+type spreadConstraint struct {
+	key       string
+	maxSkew   int
+	exclusive bool
+}
+
+// checkSpread annotates a workload node with its pods distribution across the
+// topology domains its spread constraints and required pod anti-affinity
+// refer to. Workloads whose scheduled pods violate the spread are flagged.
+func checkSpread(ctx context.Context, root *TreeNode, tpl v1.PodTemplateSpec, oo []runtime.Object) error {
+	cc := spreadConstraints(tpl)
+	if len(cc) == 0 {
+		return nil
+	}
+	f, ok := ctx.Value(internal.KeyFactory).(dao.Factory)
+	if !ok {
+		return fmt.Errorf("Expecting a factory but got %T", ctx.Value(internal.KeyFactory))
+	}
+	nn, err := f.List("v1/nodes", client.ClusterScope, false, labels.Everything())
+	if err != nil {
+		log.Debug().Err(err).Msgf("Unable to list nodes")
+		return nil
+	}
+	nodes := make(map[string]map[string]string, len(nn))
+	sel := labels.SelectorFromSet(tpl.Spec.NodeSelector)
+	for _, o := range nn {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return fmt.Errorf("expecting *Unstructured but got %T", o)
+		}
+		if sel.Matches(labels.Set(u.GetLabels())) {
+			nodes[u.GetName()] = u.GetLabels()
+		}
+	}
+
+	var spread, skew []string
+	for _, c := range cc {
+		counts := make(map[string]int)
+		for _, ll := range nodes {
+			if d, ok := ll[c.key]; ok {
+				counts[d] = 0
+			}
+		}
+		for _, o := range oo {
+			u, ok := o.(*unstructured.Unstructured)
+			if !ok {
+				return fmt.Errorf("expecting *Unstructured but got %T", o)
+			}
+			if u.GetDeletionTimestamp() != nil {
+				continue
+			}
+			node, _, _ := unstructured.NestedString(u.Object, "spec", "nodeName")
+			if d, ok := nodes[node][c.key]; ok {
+				counts[d]++
+			}
+		}
+		if len(counts) == 0 {
+			continue
+		}
+		spread = append(spread, spreadInfo(c.key, counts))
+		if v := c.violation(counts); v != "" {
+			skew = append(skew, v)
+		}
+	}
+	if len(spread) > 0 {
+		root.Extras[SpreadKey] = strings.Join(spread, ";")
+	}
+	if len(skew) > 0 {
+		root.Extras[SkewKey] = strings.Join(skew, ",")
+	}
+
+	return nil
+}
+
+// violation returns a constraint violation given pods counts per domain or
+// blank if the spread is honored.
+func (c spreadConstraint) violation(counts map[string]int) string {
+	lo, hi := -1, 0
+	for _, n := range counts {
+		if lo < 0 || n < lo {
+			lo = n
+		}
+		hi = max(hi, n)
+	}
+	if c.exclusive {
+		if hi > 1 {
+			return fmt.Sprintf("%s colocated %d", path.Base(c.key), hi)
+		}
+		return ""
+	}
+	if hi-lo > c.maxSkew {
+		return fmt.Sprintf("%s skew %d>%d", path.Base(c.key), hi-lo, c.maxSkew)
+	}
+
+	return ""
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// spreadConstraints returns a pod template spread constraints and required
+// anti-affinity terms selecting the template own pods.
+func spreadConstraints(tpl v1.PodTemplateSpec) []spreadConstraint {
+	var cc []spreadConstraint
+	for _, c := range tpl.Spec.TopologySpreadConstraints {
+		if selects(c.LabelSelector, tpl.Labels) {
+			cc = append(cc, spreadConstraint{key: c.TopologyKey, maxSkew: int(c.MaxSkew)})
+		}
+	}
+	if a := tpl.Spec.Affinity; a != nil && a.PodAntiAffinity != nil {
+		for _, t := range a.PodAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution {
+			if len(t.Namespaces) == 0 && t.NamespaceSelector == nil && selects(t.LabelSelector, tpl.Labels) {
+				cc = append(cc, spreadConstraint{key: t.TopologyKey, exclusive: true})
+			}
+		}
+	}
+
+	return cc
+}
+
+func selects(sel *metav1.LabelSelector, ll map[string]string) bool {
+	if sel == nil {
+		return false
+	}
+	s, err := metav1.LabelSelectorAsSelector(sel)
+	if err != nil {
+		return false
+	}
+
+	return !s.Empty() && s.Matches(labels.Set(ll))
+}
+
+// spreadInfo renders pods counts per domain ie zone=a:2,b:1.
+func spreadInfo(key string, counts map[string]int) string {
+	dd := make([]string, 0, len(counts))
+	for d := range counts {
+		dd = append(dd, d)
+	}
+	slices.Sort(dd)
+	ss := make([]string, 0, len(dd))
+	for _, d := range dd {
+		ss = append(ss, fmt.Sprintf("%s:%d", d, counts[d]))
+	}
+
+	return path.Base(key) + "=" + strings.Join(ss, ",")
+}
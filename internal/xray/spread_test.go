@@ -0,0 +1,132 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package xray_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/xray"
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestDeployRenderSpread(t *testing.T) {
+	const zone = "topology.kubernetes.io/zone"
+
+	uu := map[string]struct {
+		spec         v1.PodSpec
+		nodes        []string
+		spread, skew string
+	}{
+		"none": {
+			nodes: []string{"n1", "n1"},
+		},
+		"even": {
+			spec:   v1.PodSpec{TopologySpreadConstraints: []v1.TopologySpreadConstraint{spreadOn(zone, 1)}},
+			nodes:  []string{"n1", "n2"},
+			spread: "zone=a:1,b:1",
+		},
+		"skewed": {
+			spec:   v1.PodSpec{TopologySpreadConstraints: []v1.TopologySpreadConstraint{spreadOn(zone, 1)}},
+			nodes:  []string{"n1", "n1", "n3"},
+			spread: "zone=a:3,b:0",
+			skew:   "zone skew 3>1",
+		},
+		"anti-affinity": {
+			spec: v1.PodSpec{Affinity: &v1.Affinity{PodAntiAffinity: &v1.PodAntiAffinity{
+				RequiredDuringSchedulingIgnoredDuringExecution: []v1.PodAffinityTerm{
+					{TopologyKey: "kubernetes.io/hostname", LabelSelector: fredSelector()},
+				},
+			}}},
+			nodes:  []string{"n1", "n1", "n2"},
+			spread: "hostname=n1:2,n2:1,n3:0",
+			skew:   "hostname colocated 2",
+		},
+		"unscheduled": {
+			spec:   v1.PodSpec{TopologySpreadConstraints: []v1.TopologySpreadConstraint{spreadOn(zone, 0)}},
+			nodes:  []string{"n1", ""},
+			spread: "zone=a:1,b:0",
+			skew:   "zone skew 1>0",
+		},
+	}
+
+	var re xray.Deployment
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			f := makeFactory()
+			f.rows = map[string][]runtime.Object{
+				"v1/nodes": {
+					makeZoneNode(t, "n1", "a"),
+					makeZoneNode(t, "n2", "b"),
+					makeZoneNode(t, "n3", "a"),
+				},
+			}
+			for i, n := range u.nodes {
+				f.rows["v1/pods"] = append(f.rows["v1/pods"], toUnstructured(t, &v1.Pod{
+					TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Pod"},
+					ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("p%d", i), Namespace: "default", Labels: map[string]string{"app": "fred"}},
+					Spec:       v1.PodSpec{NodeName: n},
+				}))
+			}
+			root := xray.NewTreeNode("deployments", "deployments")
+			ctx := context.WithValue(context.Background(), xray.KeyParent, root)
+			ctx = context.WithValue(ctx, internal.KeyFactory, f)
+
+			assert.NoError(t, re.Render(ctx, "", toUnstructured(t, makeSpreadDP(u.spec))))
+			dp := root.Find("apps/v1/deployments", "default/fred")
+			assert.NotNil(t, dp)
+			assert.Equal(t, u.spread, dp.Extras[xray.SpreadKey])
+			assert.Equal(t, u.skew, dp.Extras[xray.SkewKey])
+			if u.skew != "" {
+				assert.Contains(t, dp.ExplainStatus(), "pods spread violates "+u.skew)
+			}
+		})
+	}
+}
+
+// Helpers...
+
+func spreadOn(key string, skew int32) v1.TopologySpreadConstraint {
+	return v1.TopologySpreadConstraint{
+		MaxSkew:           skew,
+		TopologyKey:       key,
+		WhenUnsatisfiable: v1.DoNotSchedule,
+		LabelSelector:     fredSelector(),
+	}
+}
+
+func fredSelector() *metav1.LabelSelector {
+	return &metav1.LabelSelector{MatchLabels: map[string]string{"app": "fred"}}
+}
+
+func makeSpreadDP(spec v1.PodSpec) *appsv1.Deployment {
+	return &appsv1.Deployment{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+		ObjectMeta: metav1.ObjectMeta{Name: "fred", Namespace: "default"},
+		Spec: appsv1.DeploymentSpec{
+			Selector: fredSelector(),
+			Template: v1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "fred"}},
+				Spec:       spec,
+			},
+		},
+	}
+}
+
+func makeZoneNode(t *testing.T, n, zone string) runtime.Object {
+	return toUnstructured(t, &v1.Node{
+		TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "Node"},
+		ObjectMeta: metav1.ObjectMeta{Name: n, Labels: map[string]string{
+			"kubernetes.io/hostname":      n,
+			"topology.kubernetes.io/zone": zone,
+		}},
+	})
+}
@@ -64,7 +64,15 @@ func (s *StatefulSet) Render(ctx context.Context, ns string, o interface{}) erro
 	}
 	nsn.Add(root)
 
-	return s.validate(root, sts)
+	if err := estimateCost(ctx, root, oo); err != nil {
+		return err
+	}
+
+	if err := s.validate(root, sts); err != nil {
+		return err
+	}
+
+	return checkSpread(ctx, root, sts.Spec.Template, oo)
 }
 
 func (*StatefulSet) validate(root *TreeNode, sts appsv1.StatefulSet) error {
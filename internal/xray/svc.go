@@ -12,12 +12,29 @@ import (
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
 	v1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	netv1 "k8s.io/api/networking/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
+const (
+	// IngressGVR represents an ingress resource.
+	IngressGVR = "networking.k8s.io/v1/ingresses"
+
+	// EndpointSliceGVR represents an endpoint slice resource.
+	EndpointSliceGVR = "discovery.k8s.io/v1/endpointslices"
+
+	// ExternalNameGVR represents an external name service DNS target.
+	ExternalNameGVR = "externalnames"
+
+	// AddressGVR represents a manually managed endpoint address.
+	AddressGVR = "addresses"
+)
+
 // Service represents an xray renderer.
 type Service struct{}
 
@@ -40,22 +57,25 @@ func (s *Service) Render(ctx context.Context, ns string, o interface{}) error {
 	}
 
 	root := NewTreeNode("v1/services", client.FQN(svc.Namespace, svc.Name))
-	oo, err := s.locatePods(ctx, svc.Namespace, svc.Spec.Selector)
-	if err != nil {
-		return err
-	}
-	ctx = context.WithValue(ctx, KeyParent, root)
-	var re Pod
-	for _, o := range oo {
-		p, ok := o.(*unstructured.Unstructured)
-		if !ok {
-			return fmt.Errorf("expecting *Unstructured but got %T", o)
+	switch {
+	case svc.Spec.Type == v1.ServiceTypeExternalName:
+		n := NewTreeNode(ExternalNameGVR, client.FQN(svc.Namespace, svc.Spec.ExternalName))
+		n.Extras[ExternalKey] = "true"
+		n.Extras[InfoKey] = "dns:" + svc.Spec.ExternalName
+		root.Add(n)
+	case len(svc.Spec.Selector) == 0:
+		if err := s.endpointRefs(ctx, root, svc); err != nil {
+			return err
 		}
-		if err := re.Render(ctx, ns, &render.PodWithMetrics{Raw: p}); err != nil {
+	default:
+		if err := s.podRefs(ctx, ns, root, svc); err != nil {
 			return err
 		}
 	}
 	root.Extras[StatusKey] = OkStatus
+	if err := s.ingressRefs(ctx, root, svc); err != nil {
+		return err
+	}
 
 	if root.IsLeaf() {
 		return nil
@@ -71,6 +91,26 @@ func (s *Service) Render(ctx context.Context, ns string, o interface{}) error {
 	return nil
 }
 
+func (s *Service) podRefs(ctx context.Context, ns string, root *TreeNode, svc v1.Service) error {
+	oo, err := s.locatePods(ctx, svc.Namespace, svc.Spec.Selector)
+	if err != nil {
+		return err
+	}
+	ctx = context.WithValue(ctx, KeyParent, root)
+	var re Pod
+	for _, o := range oo {
+		p, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return fmt.Errorf("expecting *Unstructured but got %T", o)
+		}
+		if err := re.Render(ctx, ns, &render.PodWithMetrics{Raw: p}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (s *Service) locatePods(ctx context.Context, ns string, sel map[string]string) ([]runtime.Object, error) {
 	f, ok := ctx.Value(internal.KeyFactory).(dao.Factory)
 	if !ok {
@@ -89,3 +129,200 @@ func (s *Service) locatePods(ctx context.Context, ns string, sel map[string]stri
 
 	return f.List("v1/pods", ns, false, fsel.AsSelector())
 }
+
+// ingressRefs adds the ingresses routing traffic to the service.
+func (*Service) ingressRefs(ctx context.Context, parent *TreeNode, svc v1.Service) error {
+	f, ok := ctx.Value(internal.KeyFactory).(dao.Factory)
+	if !ok {
+		return fmt.Errorf("Expecting a factory but got %T", ctx.Value(internal.KeyFactory))
+	}
+	oo, err := f.List(IngressGVR, svc.Namespace, false, labels.Everything())
+	if err != nil {
+		log.Warn().Err(err).Msgf("Unable to list ingresses in %q", svc.Namespace)
+		return nil
+	}
+
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return fmt.Errorf("expecting *Unstructured but got %T", o)
+		}
+		var ing netv1.Ingress
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &ing); err != nil {
+			return err
+		}
+		if ing.Namespace != svc.Namespace {
+			continue
+		}
+		rr := ingressRoutes(ing.Spec, svc.Name)
+		if len(rr) == 0 {
+			continue
+		}
+		n := NewTreeNode(IngressGVR, client.FQN(ing.Namespace, ing.Name))
+		n.Extras[StatusKey] = OkStatus
+		n.Extras[RoutesKey] = strings.Join(rr, ",")
+		n.Extras[InfoKey] = n.Extras[RoutesKey]
+		parent.Add(n)
+	}
+
+	return nil
+}
+
+// endpointRefs adds the manually managed endpoints addresses of a service
+// without selector. Addresses not backed by a pod are flagged as external.
+func (*Service) endpointRefs(ctx context.Context, parent *TreeNode, svc v1.Service) error {
+	f, ok := ctx.Value(internal.KeyFactory).(dao.Factory)
+	if !ok {
+		return fmt.Errorf("Expecting a factory but got %T", ctx.Value(internal.KeyFactory))
+	}
+	aa, err := endpointAddresses(f, svc)
+	if err != nil {
+		return err
+	}
+	if len(aa) == 0 {
+		return nil
+	}
+	pods, err := podsByIP(f, svc.Namespace)
+	if err != nil {
+		return err
+	}
+
+	for _, a := range aa {
+		n := NewTreeNode(AddressGVR, client.FQN(svc.Namespace, a.ip))
+		po := a.pod
+		if po == "" {
+			po = pods[a.ip]
+		}
+		if po != "" {
+			n.Extras[InfoKey] = "pod:" + po
+		} else {
+			n.Extras[ExternalKey] = "true"
+		}
+		parent.Add(n)
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+type endpointAddress struct {
+	ip, pod string
+}
+
+// endpointAddresses returns a service endpoints addresses, preferring endpoint
+// slices over the legacy endpoints resource.
+func endpointAddresses(f dao.Factory, svc v1.Service) ([]endpointAddress, error) {
+	sel := labels.SelectorFromSet(labels.Set{discoveryv1.LabelServiceName: svc.Name})
+	oo, err := f.List(EndpointSliceGVR, svc.Namespace, false, sel)
+	if err != nil {
+		log.Warn().Err(err).Msgf("Unable to list endpoint slices in %q", svc.Namespace)
+	}
+
+	var (
+		aa   []endpointAddress
+		seen = make(map[string]struct{})
+	)
+	add := func(ip string, ref *v1.ObjectReference) {
+		if _, ok := seen[ip]; ok {
+			return
+		}
+		seen[ip] = struct{}{}
+		a := endpointAddress{ip: ip}
+		if ref != nil && ref.Kind == "Pod" {
+			a.pod = ref.Name
+		}
+		aa = append(aa, a)
+	}
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return nil, fmt.Errorf("expecting *Unstructured but got %T", o)
+		}
+		var es discoveryv1.EndpointSlice
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &es); err != nil {
+			return nil, err
+		}
+		if es.Namespace != svc.Namespace || es.Labels[discoveryv1.LabelServiceName] != svc.Name {
+			continue
+		}
+		for _, e := range es.Endpoints {
+			for _, ip := range e.Addresses {
+				add(ip, e.TargetRef)
+			}
+		}
+	}
+	if len(aa) > 0 {
+		return aa, nil
+	}
+
+	o, err := f.Get("v1/endpoints", client.FQN(svc.Namespace, svc.Name), false, labels.Everything())
+	if err != nil || o == nil {
+		return nil, nil
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("expecting *Unstructured but got %T", o)
+	}
+	var ep v1.Endpoints
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &ep); err != nil {
+		return nil, err
+	}
+	for _, ss := range ep.Subsets {
+		for _, a := range ss.Addresses {
+			add(a.IP, a.TargetRef)
+		}
+		for _, a := range ss.NotReadyAddresses {
+			add(a.IP, a.TargetRef)
+		}
+	}
+
+	return aa, nil
+}
+
+// podsByIP returns a namespace pods names keyed by pod IP.
+func podsByIP(f dao.Factory, ns string) (map[string]string, error) {
+	oo, err := f.List("v1/pods", ns, false, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	pp := make(map[string]string, len(oo))
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return nil, fmt.Errorf("expecting *Unstructured but got %T", o)
+		}
+		if ip, _, _ := unstructured.NestedString(u.Object, "status", "podIP"); ip != "" {
+			pp[ip] = u.GetName()
+		}
+	}
+
+	return pp, nil
+}
+
+// ingressRoutes returns the host/path routes targeting a given service.
+// The default backend is reported as *.
+func ingressRoutes(spec netv1.IngressSpec, svc string) []string {
+	var rr []string
+	if b := spec.DefaultBackend; b != nil && b.Service != nil && b.Service.Name == svc {
+		rr = append(rr, "*")
+	}
+	for _, r := range spec.Rules {
+		if r.HTTP == nil {
+			continue
+		}
+		for _, p := range r.HTTP.Paths {
+			if p.Backend.Service == nil || p.Backend.Service.Name != svc {
+				continue
+			}
+			path := p.Path
+			if path == "" {
+				path = "/"
+			}
+			rr = append(rr, r.Host+path)
+		}
+	}
+
+	return rr
+}
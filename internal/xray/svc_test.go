@@ -10,6 +10,8 @@ import (
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/xray"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -45,3 +47,178 @@ func TestServiceRender(t *testing.T) {
 		})
 	}
 }
+
+func TestServiceRenderIngresses(t *testing.T) {
+	f := makeFactory()
+	f.rows = map[string][]runtime.Object{
+		"v1/pods": {load(t, "po")},
+		xray.IngressGVR: {
+			makeIngress("i1", "default", map[string]interface{}{
+				"defaultBackend": map[string]interface{}{
+					"service": map[string]interface{}{"name": "nginx"},
+				},
+				"rules": []interface{}{
+					map[string]interface{}{
+						"host": "fred.io",
+						"http": map[string]interface{}{
+							"paths": []interface{}{
+								map[string]interface{}{
+									"path":     "/blee",
+									"pathType": "Prefix",
+									"backend": map[string]interface{}{
+										"service": map[string]interface{}{"name": "nginx"},
+									},
+								},
+								map[string]interface{}{
+									"path":     "/zorg",
+									"pathType": "Prefix",
+									"backend": map[string]interface{}{
+										"service": map[string]interface{}{"name": "zorg"},
+									},
+								},
+							},
+						},
+					},
+				},
+			}),
+			makeIngress("i2", "default", map[string]interface{}{
+				"defaultBackend": map[string]interface{}{
+					"service": map[string]interface{}{"name": "zorg"},
+				},
+			}),
+			makeIngress("i3", "fred", map[string]interface{}{
+				"defaultBackend": map[string]interface{}{
+					"service": map[string]interface{}{"name": "nginx"},
+				},
+			}),
+		},
+	}
+	root := xray.NewTreeNode("services", "services")
+	ctx := context.WithValue(context.Background(), xray.KeyParent, root)
+	ctx = context.WithValue(ctx, internal.KeyFactory, f)
+
+	var re xray.Service
+	assert.Nil(t, re.Render(ctx, "", load(t, "svc")))
+	assert.Equal(t, 1, root.Count(xray.IngressGVR))
+	n := root.Find(xray.IngressGVR, "default/i1")
+	require.NotNil(t, n)
+	assert.Equal(t, "*,fred.io/blee", n.Extras[xray.RoutesKey])
+	assert.Nil(t, root.Find(xray.IngressGVR, "default/i2"))
+}
+
+func TestServiceRenderExternalName(t *testing.T) {
+	root := xray.NewTreeNode("services", "services")
+	ctx := context.WithValue(context.Background(), xray.KeyParent, root)
+	ctx = context.WithValue(ctx, internal.KeyFactory, makeFactory())
+
+	var re xray.Service
+	o := makeService("ext", "default", map[string]interface{}{
+		"type":         "ExternalName",
+		"externalName": "db.fred.io",
+	})
+	assert.Nil(t, re.Render(ctx, "", o))
+	n := root.Find(xray.ExternalNameGVR, "default/db.fred.io")
+	require.NotNil(t, n)
+	assert.Equal(t, "dns:db.fred.io", n.Extras[xray.InfoKey])
+	assert.Contains(t, n.Title(true, nil), "EXTERNAL")
+}
+
+func TestServiceRenderEndpoints(t *testing.T) {
+	uu := map[string]struct {
+		rows map[string][]runtime.Object
+	}{
+		"slices": {
+			rows: map[string][]runtime.Object{
+				xray.EndpointSliceGVR: {
+					makeEndpointSlice("s1", "default", "db", "172.17.0.6", "10.0.0.1"),
+					makeEndpointSlice("s2", "default", "zorg", "10.0.0.2"),
+				},
+			},
+		},
+		"endpoints": {
+			rows: map[string][]runtime.Object{
+				"v1/endpoints": {makeEndpoints("db", "default", "172.17.0.6", "10.0.0.1")},
+			},
+		},
+	}
+
+	var re xray.Service
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			f := makeFactory()
+			f.rows = u.rows
+			f.rows["v1/pods"] = []runtime.Object{load(t, "po")}
+			root := xray.NewTreeNode("services", "services")
+			ctx := context.WithValue(context.Background(), xray.KeyParent, root)
+			ctx = context.WithValue(ctx, internal.KeyFactory, f)
+
+			assert.Nil(t, re.Render(ctx, "", makeService("db", "default", map[string]interface{}{})))
+			assert.Equal(t, 0, root.Count("v1/pods"))
+			assert.Equal(t, 2, root.Count(xray.AddressGVR))
+			po := root.Find(xray.AddressGVR, "default/172.17.0.6")
+			require.NotNil(t, po)
+			assert.Equal(t, "pod:nginx", po.Extras[xray.InfoKey])
+			assert.NotContains(t, po.Extras, xray.ExternalKey)
+			ext := root.Find(xray.AddressGVR, "default/10.0.0.1")
+			require.NotNil(t, ext)
+			assert.Contains(t, ext.Extras, xray.ExternalKey)
+		})
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func makeService(n, ns string, spec map[string]interface{}) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Service",
+		"metadata":   map[string]interface{}{"name": n, "namespace": ns},
+		"spec":       spec,
+	}}
+}
+
+func makeEndpointSlice(n, ns, svc string, ips ...string) *unstructured.Unstructured {
+	ee := make([]interface{}, 0, len(ips))
+	for _, ip := range ips {
+		ee = append(ee, map[string]interface{}{"addresses": []interface{}{ip}})
+	}
+
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "discovery.k8s.io/v1",
+		"kind":       "EndpointSlice",
+		"metadata": map[string]interface{}{
+			"name":      n,
+			"namespace": ns,
+			"labels":    map[string]interface{}{"kubernetes.io/service-name": svc},
+		},
+		"addressType": "IPv4",
+		"endpoints":   ee,
+	}}
+}
+
+func makeEndpoints(n, ns string, ips ...string) *unstructured.Unstructured {
+	aa := make([]interface{}, 0, len(ips))
+	for _, ip := range ips {
+		aa = append(aa, map[string]interface{}{"ip": ip})
+	}
+
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Endpoints",
+		"metadata":   map[string]interface{}{"name": n, "namespace": ns},
+		"subsets": []interface{}{
+			map[string]interface{}{"addresses": aa},
+		},
+	}}
+}
+
+func makeIngress(n, ns string, spec map[string]interface{}) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "networking.k8s.io/v1",
+		"kind":       "Ingress",
+		"metadata":   map[string]interface{}{"name": n, "namespace": ns},
+		"spec":       spec,
+	}}
+}
@@ -8,6 +8,7 @@ import (
 	"reflect"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/dao"
@@ -22,6 +23,30 @@ const (
 	// KeySAAutomount indicates whether an automount sa token is active or not.
 	KeySAAutomount TreeRef = "automount"
 
+	// KeyStrictRefs indicates whether missing optional refs should be flagged.
+	KeyStrictRefs TreeRef = "strictRefs"
+
+	// KeyEvents indicates whether nodes should be annotated with warning events.
+	KeyEvents TreeRef = "events"
+
+	// KeySidecars tracks container names known to be sidecars.
+	KeySidecars TreeRef = "sidecars"
+
+	// KeyWebhooks indicates whether matching admission webhooks should be shown.
+	KeyWebhooks TreeRef = "webhooks"
+
+	// KeyPolicies indicates whether matching admission policies should be shown.
+	KeyPolicies TreeRef = "policies"
+
+	// KeyCache indicates whether resolved subtrees should be cached.
+	KeyCache TreeRef = "cache"
+
+	// KeyPricing tracks a price table used to estimate workloads cost.
+	KeyPricing TreeRef = "pricing"
+
+	// KeyQuotas indicates whether namespaces limit ranges and quotas should be shown.
+	KeyQuotas TreeRef = "quotas"
+
 	// PathSeparator represents a node path separator.
 	PathSeparator = "::"
 
@@ -31,6 +56,111 @@ const (
 	// InfoKey state map key.
 	InfoKey = "info"
 
+	// GenericOwnerKey marks an owner node resolved from a raw owner reference.
+	GenericOwnerKey = "genericOwner"
+
+	// ProbesKey tracks a container probes configuration and last known results.
+	ProbesKey = "probes"
+
+	// NoReadinessKey flags a container without a readiness probe.
+	NoReadinessKey = "noReadiness"
+
+	// EventReasonKey tracks the most recent warning event reason.
+	EventReasonKey = "eventReason"
+
+	// EventMessageKey tracks the most recent warning event message.
+	EventMessageKey = "eventMessage"
+
+	// QoSKey tracks a pod QoS class computed from its containers resources.
+	QoSKey = "qos"
+
+	// NodeAffinityKey tracks a pod node affinity terms ie required, preferred.
+	NodeAffinityKey = "nodeAffinity"
+
+	// TolerationsKey tracks the number of tolerations set on a pod.
+	TolerationsKey = "tolerations"
+
+	// AudienceKey tracks a projected service account token audience.
+	AudienceKey = "audience"
+
+	// ExpirationKey tracks a projected service account token expiration in seconds.
+	ExpirationKey = "expiration"
+
+	// OvercommitKey flags node resources whose pods requests exceed allocatable.
+	OvercommitKey = "overcommit"
+
+	// FinalizersKey tracks the finalizers still pending on a terminating resource.
+	FinalizersKey = "finalizers"
+
+	// StuckKey flags a resource terminating for longer than StuckThreshold.
+	StuckKey = "stuck"
+
+	// RoutesKey tracks an ingress host/path routes targeting a service.
+	RoutesKey = "routes"
+
+	// SpreadKey tracks a workload pods distribution per topology domain ie zone=a:2,b:1.
+	SpreadKey = "spread"
+
+	// SkewKey flags a workload pods spread violating its topology constraints ie zone skew 2>1.
+	SkewKey = "skew"
+
+	// CostKey tracks a workload estimated hourly cost based on its pods requests.
+	CostKey = "cost"
+
+	// DisruptionsKey tracks a disruption budget currently allowed disruptions.
+	DisruptionsKey = "disruptionsAllowed"
+
+	// NoPDBKey flags a running pod not covered by any disruption budget.
+	NoPDBKey = "noPDB"
+
+	// ExternalKey flags a service target living outside the cluster.
+	ExternalKey = "external"
+
+	// EnvOverrideKey tracks container env vars defined more than once and their winning source ie FOO:env.
+	EnvOverrideKey = "envOverride"
+
+	// CSIDriverKey tracks a persistent volume CSI driver name.
+	CSIDriverKey = "csi.driver"
+
+	// VolumeHandleKey tracks a persistent volume CSI volume handle.
+	VolumeHandleKey = "volumeHandle"
+
+	// SidecarKey flags a sidecar container and how it was detected ie native, istio, linkerd or name.
+	SidecarKey = "sidecar"
+
+	// RestartsKey tracks a container restart count.
+	RestartsKey = "restarts"
+
+	// LastReasonKey tracks a restarted container last termination reason.
+	LastReasonKey = "lastReason"
+
+	// ExitCodeKey tracks a restarted container last termination exit code.
+	ExitCodeKey = "exitCode"
+
+	// OOMKilledKey flags a container last terminated for running out of memory.
+	OOMKilledKey = "oomKilled"
+
+	// CrashLoopKey flags a container backing off restarts.
+	CrashLoopKey = "crashLoop"
+
+	// ActiveKey tracks a job active pods count.
+	ActiveKey = "active"
+
+	// SucceededKey tracks a job succeeded pods count.
+	SucceededKey = "succeeded"
+
+	// FailedKey tracks a job failed pods count.
+	FailedKey = "failed"
+
+	// BackoffLimitKey flags a job failed for exceeding its backoff limit.
+	BackoffLimitKey = "backoffLimit"
+
+	// CertExpiryKey tracks a TLS secret leaf certificate expiry.
+	CertExpiryKey = "certExpiry"
+
+	// CertIssuerKey tracks a TLS secret leaf certificate issuer.
+	CertIssuerKey = "certIssuer"
+
 	// OkStatus stands for all is cool.
 	OkStatus = "ok"
 
@@ -43,6 +173,20 @@ const (
 
 	// MissingRefStatus stands for a non existing resource reference.
 	MissingRefStatus = "noref"
+
+	// TerminatingStatus stands for a resource pending deletion.
+	TerminatingStatus = "terminating"
+
+	// ExpiringStatus stands for a certificate expired or about to expire.
+	ExpiringStatus = "expiring"
+
+	// StuckThreshold represents how long a resource may terminate before
+	// being flagged as stuck.
+	StuckThreshold = 5 * time.Minute
+
+	// CertExpiryThreshold represents how close to its expiry a certificate
+	// gets flagged as expiring.
+	CertExpiryThreshold = 30 * 24 * time.Hour
 )
 
 // ----------------------------------------------------------------------------
@@ -55,6 +199,13 @@ type TreeRef string
 // NodeSpec represents a node resource specification.
 type NodeSpec struct {
 	GVRs, Paths, Statuses []string
+	Event                 string
+	Reason                string
+}
+
+// HasEvent returns true if the node has a warning event.
+func (s NodeSpec) HasEvent() bool {
+	return s.Event != ""
 }
 
 // ParentGVR returns the parent GVR.
@@ -133,6 +284,13 @@ type TreeNode struct {
 	Children ChildNodes
 	Parent   *TreeNode
 	Extras   map[string]string
+
+	// Payload holds optional structured resolver data. Payloads implementing
+	// fmt.Stringer are formatted lazily as the node info.
+	Payload any
+
+	// OnAdd if set gets notified once a child is attached to this node.
+	OnAdd func(child *TreeNode)
 }
 
 // NewTreeNode returns a new instance.
@@ -171,7 +329,7 @@ func (t *TreeNode) Diff(d *TreeNode) bool {
 		return true
 	}
 
-	if t.ID != d.ID || t.GVR != d.GVR || !reflect.DeepEqual(t.Extras, d.Extras) {
+	if t.ID != d.ID || t.GVR != d.GVR || !reflect.DeepEqual(t.Extras, d.Extras) || !reflect.DeepEqual(t.Payload, d.Payload) {
 		return true
 	}
 	for i := 0; i < len(t.Children); i++ {
@@ -199,11 +357,17 @@ func (t *TreeNode) Spec() NodeSpec {
 		Statuses = append(Statuses, parent.Extras[StatusKey])
 	}
 
-	return NodeSpec{
+	spec := NodeSpec{
 		GVRs:     GVRs,
 		Paths:    Paths,
 		Statuses: Statuses,
+		Reason:   t.ExplainStatus(),
 	}
+	if r, ok := t.Extras[EventReasonKey]; ok {
+		spec.Event = r + ": " + t.Extras[EventMessageKey]
+	}
+
+	return spec
 }
 
 // Flatten returns a collection of node specs.
@@ -294,7 +458,71 @@ func (t *TreeNode) IsRoot() bool {
 
 // ShallowClone performs a shallow node clone.
 func (t *TreeNode) ShallowClone() *TreeNode {
-	return &TreeNode{GVR: t.GVR, ID: t.ID, Extras: t.Extras}
+	return &TreeNode{GVR: t.GVR, ID: t.ID, Extras: t.Extras, Payload: t.Payload}
+}
+
+// Info returns the node info or its formatted payload if none, followed by
+// any cost estimate.
+func (t *TreeNode) Info() string {
+	info := t.Extras[InfoKey]
+	if s, ok := t.Payload.(fmt.Stringer); ok && info == "" {
+		info = s.String()
+	}
+	if c := t.Extras[CostKey]; c != "" {
+		if info != "" {
+			info += " "
+		}
+		info += "est:" + c
+	}
+
+	return info
+}
+
+// PruneHealthy returns a copy of the tree retaining only paths leading to
+// unhealthy nodes. The root is always retained.
+func (t *TreeNode) PruneHealthy() *TreeNode {
+	n := t.ShallowClone()
+	for _, c := range t.Children {
+		if c.isHealthy() {
+			continue
+		}
+		n.Add(c.PruneHealthy())
+	}
+
+	return n
+}
+
+// isHealthy returns true if this node and all its descendants are ok.
+func (t *TreeNode) isHealthy() bool {
+	if !t.isHealthyNode() {
+		return false
+	}
+	for _, c := range t.Children {
+		if !c.isHealthy() {
+			return false
+		}
+	}
+
+	return true
+}
+
+// isHealthyNode returns true if this node is ok regardless of its descendants.
+func (t *TreeNode) isHealthyNode() bool {
+	if s := t.Extras[StatusKey]; s != "" && s != OkStatus && s != CompletedStatus {
+		return false
+	}
+	if _, ok := t.Extras[SkewKey]; ok {
+		return false
+	}
+	if _, ok := t.Extras[OOMKilledKey]; ok {
+		return false
+	}
+	if _, ok := t.Extras[CrashLoopKey]; ok {
+		return false
+	}
+	_, ok := t.Extras[OvercommitKey]
+
+	return !ok
 }
 
 // Filter filters the node based on query.
@@ -317,6 +545,9 @@ func (t *TreeNode) Filter(q string, filter func(q, path string) bool) *TreeNode
 func (t *TreeNode) Add(c *TreeNode) {
 	c.Parent = t
 	t.Children = append(t.Children, c)
+	if t.OnAdd != nil {
+		t.OnAdd(c)
+	}
 }
 
 // Clear delete all descendant nodes.
@@ -337,9 +568,22 @@ func (t *TreeNode) Find(gvr, id string) *TreeNode {
 	return nil
 }
 
-// Title computes the node title.
-func (t *TreeNode) Title(noIcons bool) string {
-	return t.computeTitle(noIcons)
+// Subtree returns a detached copy of a node and its descendants or nil if the
+// node is not part of this tree.
+func (t *TreeNode) Subtree(n *TreeNode) *TreeNode {
+	for p := n; p != nil; p = p.Parent {
+		if p == t {
+			return n.deepClone()
+		}
+	}
+
+	return nil
+}
+
+// Title computes the node title. Unhealthy statuses are prefixed with their
+// glyph when glyphs are given.
+func (t *TreeNode) Title(noIcons bool, gg Glyphs) string {
+	return t.computeTitle(noIcons, gg)
 }
 
 // ----------------------------------------------------------------------------
@@ -386,12 +630,12 @@ func category(gvr string) string {
 	return meta.SingularName
 }
 
-func (t TreeNode) computeTitle(noIcons bool) string {
+func (t TreeNode) computeTitle(noIcons bool, gg Glyphs) string {
 	if !noIcons {
-		return t.toEmojiTitle()
+		return t.toEmojiTitle(gg)
 	}
 
-	return t.toTitle()
+	return t.toTitle(gg)
 }
 
 const (
@@ -400,20 +644,12 @@ const (
 	toast       = "TOAST"
 )
 
-func (t TreeNode) toTitle() (title string) {
+func (t TreeNode) toTitle(gg Glyphs) (title string) {
 	_, n := client.Namespaced(t.ID)
-	color, status := "white", "OK"
-	if v, ok := t.Extras[StatusKey]; ok {
-		switch v {
-		case ToastStatus:
-			color, status = "orangered", toast
-		case MissingRefStatus:
-			color, status = "orange", toast+"_REF"
-		}
-	}
+	color, status := t.statusColor()
 	defer func() {
 		if status != "OK" {
-			title += fmt.Sprintf("  [gray::-][yellow:%s:b]%s[gray::-]", color, status)
+			title += fmt.Sprintf("  [gray::-][yellow:%s:b]%s[gray::-]", color, t.statusLabel(status, gg))
 		}
 	}()
 
@@ -428,8 +664,8 @@ func (t TreeNode) toTitle() (title string) {
 		title += fmt.Sprintf("[white::d](%d[-::d])[-::-]", t.CountChildren())
 	}
 
-	info, ok := t.Extras[InfoKey]
-	if !ok {
+	info := t.Info()
+	if info == "" {
 		return
 	}
 	title += fmt.Sprintf(" [antiquewhite::][%s][::]", info)
@@ -439,20 +675,60 @@ func (t TreeNode) toTitle() (title string) {
 
 const colorFmt = "%s [%s::b]%s[::]"
 
-func (t TreeNode) toEmojiTitle() (title string) {
-	_, n := client.Namespaced(t.ID)
+func (t TreeNode) statusColor() (string, string) {
 	color, status := "white", "OK"
+	if _, ok := t.Extras[GenericOwnerKey]; ok {
+		color = "gray"
+	}
 	if v, ok := t.Extras[StatusKey]; ok {
 		switch v {
 		case ToastStatus:
 			color, status = "orangered", toast
+			if _, ok := t.Extras[BackoffLimitKey]; ok {
+				color, status = "red", "BACKOFF_LIMIT"
+			}
 		case MissingRefStatus:
 			color, status = "orange", toast+"_REF"
+		case TerminatingStatus:
+			color, status = "purple", "TERMINATING"
+			if _, ok := t.Extras[StuckKey]; ok {
+				color, status = "red", "STUCK_TERMINATING"
+			}
+		case ExpiringStatus:
+			color, status = "darkorange", "EXPIRING"
 		}
 	}
+	if _, ok := t.Extras[NoReadinessKey]; ok && status == "OK" {
+		color, status = "yellow", "NO_READINESS"
+	}
+	if _, ok := t.Extras[OvercommitKey]; ok && status == "OK" {
+		color, status = "magenta", "OVERCOMMIT"
+	}
+	if _, ok := t.Extras[OOMKilledKey]; ok && status == "OK" {
+		color, status = "red", "OOM_KILLED"
+	}
+	if _, ok := t.Extras[CrashLoopKey]; ok && status == "OK" {
+		color, status = "orangered", "CRASH_LOOP"
+	}
+	if _, ok := t.Extras[SkewKey]; ok && status == "OK" {
+		color, status = "orange", "SKEWED"
+	}
+	if _, ok := t.Extras[NoPDBKey]; ok && status == "OK" {
+		color, status = "khaki", "NO_PDB"
+	}
+	if _, ok := t.Extras[ExternalKey]; ok && status == "OK" {
+		color, status = "cyan", "EXTERNAL"
+	}
+
+	return color, status
+}
+
+func (t TreeNode) toEmojiTitle(gg Glyphs) (title string) {
+	_, n := client.Namespaced(t.ID)
+	color, status := t.statusColor()
 	defer func() {
 		if status != "OK" {
-			title += fmt.Sprintf(" [gray::-][yellow:%s:b]%s[gray::-]", color, status)
+			title += fmt.Sprintf(" [gray::-][yellow:%s:b]%s[gray::-]", color, t.statusLabel(status, gg))
 		}
 	}()
 
@@ -461,8 +737,8 @@ func (t TreeNode) toEmojiTitle() (title string) {
 		title += fmt.Sprintf("[white::d](%d[-::d])[-::-]", t.CountChildren())
 	}
 
-	info, ok := t.Extras[InfoKey]
-	if !ok {
+	info := t.Info()
+	if info == "" {
 		return
 	}
 	title += fmt.Sprintf(" [antiquewhite::][%s][::]", info)
@@ -470,6 +746,16 @@ func (t TreeNode) toEmojiTitle() (title string) {
 	return
 }
 
+// statusLabel prefixes a status label with the node status glyph if any.
+func (t TreeNode) statusLabel(status string, gg Glyphs) string {
+	s, ok := t.Extras[StatusKey]
+	if gg == nil || !ok || s == OkStatus {
+		return status
+	}
+
+	return gg.Glyph(s) + " " + status
+}
+
 func toEmoji(gvr string) string {
 	if e := v1Emoji(gvr); e != "" {
 		return e
@@ -489,12 +775,38 @@ func toEmoji(gvr string) string {
 		return "👨🏻‍"
 	case "networking.k8s.io/v1/networkpolicies":
 		return "📕"
-	case "policy/v1/poddisruptionbudgets":
+	case IngressGVR:
+		return "🚪"
+	case GatewayGVR:
+		return "⛩ "
+	case GatewayClassGVR:
+		return "🏛 "
+	case HTTPRouteGVR:
+		return "🛣 "
+	case ListenerGVR:
+		return "👂"
+	case PDBGVR:
 		return "🏷 "
 	case "policy/v1beta1/podsecuritypolicies":
 		return "👮‍♂️"
 	case "containers":
 		return "🐳"
+	case TokenGVR:
+		return "🎫"
+	case LimitRangeGVR:
+		return "📏"
+	case ResourceQuotaGVR:
+		return "🧮"
+	case ExternalNameGVR:
+		return "🌐"
+	case AddressGVR:
+		return "📍"
+	case CSIDriverGVR:
+		return "🔌"
+	case ValidatingPolicyGVR:
+		return "📜"
+	case ValidatingPolicyBindingGVR:
+		return "🔗"
 	case "report":
 		return "🧼"
 	default:
@@ -552,6 +864,10 @@ func appsEmoji(gvr string) string {
 		return "😈"
 	case "apps/v1/replicasets":
 		return "👯‍♂️"
+	case JobGVR:
+		return "🏃"
+	case "batch/v1/cronjobs":
+		return "⏰"
 	default:
 		return ""
 	}
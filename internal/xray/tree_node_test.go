@@ -4,6 +4,7 @@
 package xray_test
 
 import (
+	"fmt"
 	"regexp"
 	"strings"
 	"testing"
@@ -265,6 +266,83 @@ func TestTreeNodeClone(t *testing.T) {
 	assert.Equal(t, n.GVR, c.GVR)
 }
 
+func TestTreeNodeSubtree(t *testing.T) {
+	root := xray.NewTreeNode("deployments", "deployments")
+	dp := xray.NewTreeNode("apps/v1/deployments", "default/dp1")
+	po := xray.NewTreeNode("v1/pods", "default/p1")
+	po.Extras[xray.InfoKey] = "1/1"
+	po.Add(xray.NewTreeNode("containers", "c1"))
+	dp.Add(po)
+	root.Add(dp)
+
+	sub := root.Subtree(po)
+	assert.NotNil(t, sub)
+	assert.True(t, sub.IsRoot())
+	assert.Equal(t, 2, sub.Count(""))
+	assert.Equal(t, sub, sub.Children[0].Parent)
+
+	sub.Extras[xray.InfoKey] = "0/1"
+	sub.Children[0].Add(xray.NewTreeNode("v1/secrets", "default/s1"))
+	assert.Equal(t, "1/1", po.Extras[xray.InfoKey])
+	assert.Equal(t, 4, root.Count(""))
+
+	assert.Nil(t, dp.Subtree(root))
+	assert.Nil(t, root.Subtree(xray.NewTreeNode("v1/pods", "default/p1")))
+	assert.Nil(t, root.Subtree(nil))
+}
+
+func TestTreeNodeInfo(t *testing.T) {
+	uu := map[string]struct {
+		info    string
+		cost    string
+		payload any
+		e       string
+	}{
+		"none": {},
+		"info": {
+			info: "1/1",
+			e:    "1/1",
+		},
+		"payload": {
+			payload: testPayload{cpu: 10},
+			e:       "cpu:10%",
+		},
+		"info-wins": {
+			info:    "1/1",
+			payload: testPayload{cpu: 10},
+			e:       "1/1",
+		},
+		"raw-payload": {
+			payload: map[string]int{"cpu": 10},
+		},
+		"cost": {
+			info: "1/1",
+			cost: "~$0.10/h",
+			e:    "1/1 est:~$0.10/h",
+		},
+		"cost-only": {
+			cost: "~$0.10/h",
+			e:    "est:~$0.10/h",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			n := xray.NewTreeNode("v1/pods", "default/p1")
+			if u.info != "" {
+				n.Extras[xray.InfoKey] = u.info
+			}
+			if u.cost != "" {
+				n.Extras[xray.CostKey] = u.cost
+			}
+			n.Payload = u.payload
+			assert.Equal(t, u.e, n.Info())
+			assert.Equal(t, u.payload, n.ShallowClone().Payload)
+		})
+	}
+}
+
 func TestTreeNodeRoot(t *testing.T) {
 	n := xray.NewTreeNode("v1/pods", "default/p1")
 	c1 := xray.NewTreeNode("containers", "c1")
@@ -304,6 +382,54 @@ func TestTreeNodeMaxDepth(t *testing.T) {
 	assert.Equal(t, 1, n.MaxDepth(0))
 }
 
+func TestTreeNodeOnAdd(t *testing.T) {
+	n := xray.NewTreeNode("v1/pods", "default/p1")
+	var added []*xray.TreeNode
+	n.OnAdd = func(c *xray.TreeNode) {
+		assert.Equal(t, n, c.Parent)
+		added = append(added, c)
+	}
+	c1 := xray.NewTreeNode("containers", "c1")
+	c2 := xray.NewTreeNode("containers", "c2")
+	n.Add(c1)
+	n.Add(c2)
+	c1.Add(xray.NewTreeNode("v1/secrets", "default/s1"))
+
+	assert.Equal(t, []*xray.TreeNode{c1, c2}, added)
+}
+
+func TestTreeNodePruneHealthy(t *testing.T) {
+	uu := map[string]struct {
+		root *xray.TreeNode
+		e    []string
+	}{
+		"faults": {
+			root: printRoot(),
+			e:    []string{"pods", "-/default", "default/p1", "default/s1"},
+		},
+		"healthy": {
+			root: root1(),
+			e:    []string{"default/p1"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var ids []string
+			var walk func(*xray.TreeNode)
+			walk = func(n *xray.TreeNode) {
+				ids = append(ids, n.ID)
+				for _, c := range n.Children {
+					walk(c)
+				}
+			}
+			walk(u.root.PruneHealthy())
+			assert.Equal(t, u.e, ids)
+		})
+	}
+}
+
 // ----------------------------------------------------------------------------
 // Helpers...
 
@@ -471,3 +597,11 @@ func diff3() *xray.TreeNode {
 	}
 	return n
 }
+
+type testPayload struct {
+	cpu int
+}
+
+func (p testPayload) String() string {
+	return fmt.Sprintf("cpu:%d%%", p.cpu)
+}
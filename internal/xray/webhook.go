@@ -0,0 +1,144 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package xray
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/rs/zerolog/log"
+	admv1 "k8s.io/api/admissionregistration/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	validatingWebhooksGVR = "admissionregistration.k8s.io/v1/validatingwebhookconfigurations"
+	mutatingWebhooksGVR   = "admissionregistration.k8s.io/v1/mutatingwebhookconfigurations"
+
+	// FailurePolicyKey tracks matching webhooks failure policies.
+	FailurePolicyKey = "failurePolicy"
+)
+
+type webhook struct {
+	rules  []admv1.RuleWithOperations
+	policy *admv1.FailurePolicyType
+}
+
+// AddWebhooks renders admission webhook configurations with rules matching
+// a given resource.
+func AddWebhooks(ctx context.Context, f dao.Factory, root *TreeNode, gvr client.GVR) {
+	if on, _ := ctx.Value(KeyWebhooks).(bool); !on {
+		return
+	}
+
+	for _, wgvr := range []string{validatingWebhooksGVR, mutatingWebhooksGVR} {
+		oo, err := f.List(wgvr, client.ClusterScope, false, labels.Everything())
+		if err != nil {
+			log.Debug().Err(err).Msgf("Unable to list %q", wgvr)
+			continue
+		}
+		for _, o := range oo {
+			name, hh, err := toWebhooks(wgvr, o)
+			if err != nil {
+				log.Warn().Err(err).Msgf("Unable to convert %q", wgvr)
+				continue
+			}
+			addWebhookNode(root, wgvr, name, hh, gvr)
+		}
+	}
+}
+
+func addWebhookNode(root *TreeNode, wgvr, name string, hh []webhook, gvr client.GVR) {
+	var ops, policies []string
+	for _, h := range hh {
+		matched := false
+		for _, r := range h.rules {
+			if !ruleMatches(r.Rule, gvr) {
+				continue
+			}
+			matched = true
+			for _, op := range r.Operations {
+				ops = appendUnique(ops, string(op))
+			}
+		}
+		if !matched {
+			continue
+		}
+		policy := admv1.Fail
+		if h.policy != nil {
+			policy = *h.policy
+		}
+		policies = appendUnique(policies, string(policy))
+	}
+	if len(policies) == 0 {
+		return
+	}
+
+	n := NewTreeNode(wgvr, client.FQN(client.ClusterScope, name))
+	n.Extras[StatusKey] = OkStatus
+	n.Extras[InfoKey] = strings.Join(ops, ",")
+	n.Extras[FailurePolicyKey] = strings.Join(policies, ",")
+	root.Add(n)
+}
+
+func toWebhooks(gvr string, o runtime.Object) (string, []webhook, error) {
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return "", nil, nil
+	}
+	var hh []webhook
+	switch gvr {
+	case validatingWebhooksGVR:
+		var cfg admv1.ValidatingWebhookConfiguration
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &cfg); err != nil {
+			return "", nil, err
+		}
+		for _, w := range cfg.Webhooks {
+			hh = append(hh, webhook{rules: w.Rules, policy: w.FailurePolicy})
+		}
+	default:
+		var cfg admv1.MutatingWebhookConfiguration
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &cfg); err != nil {
+			return "", nil, err
+		}
+		for _, w := range cfg.Webhooks {
+			hh = append(hh, webhook{rules: w.Rules, policy: w.FailurePolicy})
+		}
+	}
+
+	return u.GetName(), hh, nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func ruleMatches(r admv1.Rule, gvr client.GVR) bool {
+	if !matchAny(r.APIGroups, gvr.G()) || !matchAny(r.APIVersions, gvr.V()) {
+		return false
+	}
+	for _, res := range r.Resources {
+		if res == "*" || res == "*/*" || res == gvr.R() {
+			return true
+		}
+	}
+
+	return false
+}
+
+func matchAny(ss []string, s string) bool {
+	return slices.Contains(ss, "*") || slices.Contains(ss, s)
+}
+
+func appendUnique(ss []string, s string) []string {
+	if slices.Contains(ss, s) {
+		return ss
+	}
+
+	return append(ss, s)
+}
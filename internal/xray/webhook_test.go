@@ -0,0 +1,116 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of K9s
+
+package xray_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/xray"
+	"github.com/stretchr/testify/assert"
+	admv1 "k8s.io/api/admissionregistration/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestAddWebhooks(t *testing.T) {
+	ignore := admv1.Ignore
+	f := makeFactory()
+	f.rows = map[string][]runtime.Object{
+		"admissionregistration.k8s.io/v1/validatingwebhookconfigurations": {
+			toUnstructured(t, &admv1.ValidatingWebhookConfiguration{
+				ObjectMeta: metav1.ObjectMeta{Name: "v-pods"},
+				Webhooks: []admv1.ValidatingWebhook{
+					{Name: "w1", Rules: []admv1.RuleWithOperations{makeRule([]string{""}, []string{"v1"}, []string{"pods"}, admv1.Create)}},
+					{Name: "w2", FailurePolicy: &ignore, Rules: []admv1.RuleWithOperations{makeRule([]string{"*"}, []string{"*"}, []string{"*"}, admv1.Update)}},
+				},
+			}),
+			toUnstructured(t, &admv1.ValidatingWebhookConfiguration{
+				ObjectMeta: metav1.ObjectMeta{Name: "v-subs"},
+				Webhooks: []admv1.ValidatingWebhook{
+					{Name: "w1", Rules: []admv1.RuleWithOperations{makeRule([]string{""}, []string{"v1"}, []string{"pods/exec"}, admv1.Connect)}},
+				},
+			}),
+		},
+		"admissionregistration.k8s.io/v1/mutatingwebhookconfigurations": {
+			toUnstructured(t, &admv1.MutatingWebhookConfiguration{
+				ObjectMeta: metav1.ObjectMeta{Name: "m-deps"},
+				Webhooks: []admv1.MutatingWebhook{
+					{Name: "w1", Rules: []admv1.RuleWithOperations{makeRule([]string{"apps"}, []string{"v1"}, []string{"deployments"}, admv1.Create)}},
+				},
+			}),
+		},
+	}
+
+	uu := map[string]struct {
+		gvr    string
+		off    bool
+		ids    []string
+		infos  []string
+		policy []string
+	}{
+		"disabled": {
+			gvr: "v1/pods",
+			off: true,
+		},
+		"pods": {
+			gvr:    "v1/pods",
+			ids:    []string{"-/v-pods"},
+			infos:  []string{"CREATE,UPDATE"},
+			policy: []string{"Fail,Ignore"},
+		},
+		"deployments": {
+			gvr:    "apps/v1/deployments",
+			ids:    []string{"-/v-pods", "-/m-deps"},
+			infos:  []string{"UPDATE", "CREATE"},
+			policy: []string{"Ignore", "Fail"},
+		},
+		"wildcard": {
+			gvr:    "batch/v1/jobs",
+			ids:    []string{"-/v-pods"},
+			infos:  []string{"UPDATE"},
+			policy: []string{"Ignore"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			root := xray.NewTreeNode("root", "root")
+			ctx := context.WithValue(context.Background(), xray.KeyWebhooks, !u.off)
+			xray.AddWebhooks(ctx, f, root, client.NewGVR(u.gvr))
+
+			assert.Equal(t, len(u.ids), len(root.Children))
+			for i, c := range root.Children {
+				assert.Equal(t, u.ids[i], c.ID)
+				if i < len(u.infos) {
+					assert.Equal(t, u.infos[i], c.Extras[xray.InfoKey])
+					assert.Equal(t, u.policy[i], c.Extras[xray.FailurePolicyKey])
+				}
+			}
+		})
+	}
+}
+
+// Helpers...
+
+func makeRule(gg, vv, rr []string, op admv1.OperationType) admv1.RuleWithOperations {
+	return admv1.RuleWithOperations{
+		Operations: []admv1.OperationType{op},
+		Rule: admv1.Rule{
+			APIGroups:   gg,
+			APIVersions: vv,
+			Resources:   rr,
+		},
+	}
+}
+
+func toUnstructured(t *testing.T, o runtime.Object) *unstructured.Unstructured {
+	m, err := runtime.DefaultUnstructuredConverter.ToUnstructured(o)
+	assert.NoError(t, err)
+
+	return &unstructured.Unstructured{Object: m}
+}